@@ -33,6 +33,8 @@ type HeadFetcher interface {
 	HeadRoot(ctx context.Context) ([]byte, error)
 	HeadBlock() *ethpb.SignedBeaconBlock
 	HeadState(ctx context.Context) (*pb.BeaconState, error)
+	HeadStateReadOnly(ctx context.Context) (*pb.BeaconState, error)
+	HeadRootAndStateReadOnly(ctx context.Context) ([]byte, *pb.BeaconState, error)
 	HeadValidatorsIndices(epoch uint64) ([]uint64, error)
 	HeadSeed(epoch uint64) ([32]byte, error)
 }
@@ -114,6 +116,11 @@ func (s *Service) HeadRoot(ctx context.Context) ([]byte, error) {
 	s.headLock.RLock()
 	defer s.headLock.RUnlock()
 
+	return s.headRoot(ctx)
+}
+
+// headRoot returns the root of the head of the chain. The caller must hold the head lock.
+func (s *Service) headRoot(ctx context.Context) ([]byte, error) {
 	root := s.canonicalRoots[s.headSlot]
 	if len(root) != 0 {
 		return root, nil
@@ -157,6 +164,42 @@ func (s *Service) HeadState(ctx context.Context) (*pb.BeaconState, error) {
 	return proto.Clone(s.headState).(*pb.BeaconState), nil
 }
 
+// HeadStateReadOnly returns the head state of the chain without copying it. A new head
+// replaces the head state rather than modifying it, so the returned state stays consistent
+// while the head moves and can be shared by concurrent readers. It must not be modified,
+// use HeadState to get a copy that can be.
+func (s *Service) HeadStateReadOnly(ctx context.Context) (*pb.BeaconState, error) {
+	s.headLock.RLock()
+	defer s.headLock.RUnlock()
+
+	if s.headState == nil {
+		return s.beaconDB.HeadState(ctx)
+	}
+
+	return s.headState, nil
+}
+
+// HeadRootAndStateReadOnly returns the root and the state of the head of the chain, read together
+// so that both belong to the same head even if it moves concurrently. Like HeadStateReadOnly,
+// the state is not copied and must not be modified.
+func (s *Service) HeadRootAndStateReadOnly(ctx context.Context) ([]byte, *pb.BeaconState, error) {
+	s.headLock.RLock()
+	defer s.headLock.RUnlock()
+
+	root, err := s.headRoot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.headState == nil {
+		st, err := s.beaconDB.HeadState(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return root, st, nil
+	}
+	return root, s.headState, nil
+}
+
 // HeadValidatorsIndices returns a list of active validator indices from the head view of a given epoch.
 func (s *Service) HeadValidatorsIndices(epoch uint64) ([]uint64, error) {
 	if s.headState == nil {
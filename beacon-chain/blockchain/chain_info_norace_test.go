@@ -77,3 +77,20 @@ func TestHeadState_DataRace(t *testing.T) {
 	}()
 	s.HeadState(context.Background())
 }
+
+func TestHeadStateReadOnly_DataRace(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	s := &Service{
+		beaconDB:       db,
+		canonicalRoots: make(map[uint64][]byte),
+	}
+	go func() {
+		s.saveHead(
+			context.Background(),
+			&ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 777}},
+			[32]byte{},
+		)
+	}()
+	s.HeadStateReadOnly(context.Background())
+}
@@ -164,6 +164,23 @@ func TestHeadState_CanRetrieve(t *testing.T) {
 	}
 }
 
+func TestHeadRootAndStateReadOnly_CanRetrieve(t *testing.T) {
+	s := &pb.BeaconState{Slot: 100}
+	c := &Service{canonicalRoots: make(map[uint64][]byte), headState: s}
+	c.headSlot = 100
+	c.canonicalRoots[c.headSlot] = []byte{'A'}
+	headRoot, headState, err := c.HeadRootAndStateReadOnly(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal([]byte{'A'}, headRoot) {
+		t.Errorf("Wanted head root: %v, got: %d", []byte{'A'}, headRoot)
+	}
+	if headState != s {
+		t.Error("incorrect head state received")
+	}
+}
+
 func TestGenesisTime_CanRetrieve(t *testing.T) {
 	c := &Service{genesisTime: time.Unix(999, 0)}
 	wanted := time.Unix(999, 0)
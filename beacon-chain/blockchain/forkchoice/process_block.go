@@ -81,7 +81,11 @@ func (s *Store) OnBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock) er
 		"slot": b.Slot,
 		"root": fmt.Sprintf("0x%s...", hex.EncodeToString(root[:])[:8]),
 	}).Info("Executing state transition on block")
-	postState, err := state.ExecuteStateTransition(ctx, preState, signed)
+	executeStateTransition := state.ExecuteStateTransition
+	if featureconfig.Get().BatchVerifyBlockSignatures {
+		executeStateTransition = state.ExecuteStateTransitionBatchVerify
+	}
+	postState, err := executeStateTransition(ctx, preState, signed)
 	if err != nil {
 		return errors.Wrap(err, "could not execute state transition")
 	}
@@ -116,6 +120,16 @@ func (s *Store) OnBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock) er
 			}
 		}
 
+		if err := s.archiveFinalizedState(ctx, postState.FinalizedCheckpoint); err != nil {
+			return errors.Wrap(err, "could not archive finalized state")
+		}
+
+		// Pruning is best effort once the finalized checkpoint is saved, failing to prune must
+		// not reject the block.
+		if err := s.pruneOrphanedBlocks(ctx, s.finalizedCheckpt, postState.FinalizedCheckpoint); err != nil {
+			log.WithError(err).Error("Could not prune orphaned blocks")
+		}
+
 		s.prevFinalizedCheckpt = s.finalizedCheckpt
 		s.finalizedCheckpt = postState.FinalizedCheckpoint
 	}
@@ -237,11 +251,20 @@ func (s *Store) OnBlockInitialSyncStateTransition(ctx context.Context, signed *e
 		if err := s.saveInitState(ctx, postState); err != nil {
 			return errors.Wrap(err, "could not save init sync finalized state")
 		}
+		if err := s.archiveFinalizedState(ctx, postState.FinalizedCheckpoint); err != nil {
+			return errors.Wrap(err, "could not archive finalized state")
+		}
 
 		if err := s.db.SaveFinalizedCheckpoint(ctx, postState.FinalizedCheckpoint); err != nil {
 			return errors.Wrap(err, "could not save finalized checkpoint")
 		}
 
+		// Pruning is best effort once the finalized checkpoint is saved, failing to prune must
+		// not reject the block.
+		if err := s.pruneOrphanedBlocks(ctx, s.finalizedCheckpt, postState.FinalizedCheckpoint); err != nil {
+			log.WithError(err).Error("Could not prune orphaned blocks")
+		}
+
 		s.prevFinalizedCheckpt = s.finalizedCheckpt
 		s.finalizedCheckpt = postState.FinalizedCheckpoint
 	}
@@ -566,6 +589,20 @@ func (s *Store) saveInitState(ctx context.Context, state *pb.BeaconState) error
 	return nil
 }
 
+// This saves the finalized state as the archived point of its archive period, if the period has none
+// yet, so the finalized states pruned from DB can be regenerated from it by replaying blocks.
+func (s *Store) archiveFinalizedState(ctx context.Context, cp *ethpb.Checkpoint) error {
+	fs, err := s.db.State(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	// Nothing to archive if the finalized state was never saved.
+	if fs == nil {
+		return nil
+	}
+	return s.db.SaveArchivedPointState(ctx, fs)
+}
+
 // This filters block roots that are not known as head root and finalized root in DB.
 // It serves as the last line of defence before we prune states.
 func (s *Store) filterBlockRoots(ctx context.Context, roots [][32]byte) ([][32]byte, error) {
@@ -527,6 +527,34 @@ func TestSaveInitState_CanSaveDelete(t *testing.T) {
 	}
 }
 
+func TestArchiveFinalizedState_KeepsEarliestOfPeriod(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDBWithSlotsPerArchivedPoint(t, 64)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	for _, slot := range []uint64{70, 100, 130} {
+		r, _ := ssz.HashTreeRoot(&ethpb.BeaconBlock{Slot: slot})
+		if err := db.SaveState(ctx, &pb.BeaconState{Slot: slot}, r); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.archiveFinalizedState(ctx, &ethpb.Checkpoint{Root: r[:]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for slot, wanted := range map[uint64]uint64{127: 70, 130: 130} {
+		archived, err := db.NearestArchivedState(ctx, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if archived == nil || archived.Slot != wanted {
+			t.Errorf("Slot %d: wanted archived state at slot %d, received %v", slot, wanted, archived)
+		}
+	}
+}
+
 func TestUpdateJustified_CouldUpdateBest(t *testing.T) {
 	ctx := context.Background()
 	db := testDB.SetupDB(t)
@@ -0,0 +1,148 @@
+package forkchoice
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// pruneOrphanedBlocks deletes the blocks and states of the forks orphaned by a new finalized
+// checkpoint. These are the blocks between the previous and the new finalized blocks that are
+// not ancestors of the new finalized block, and the blocks above the new finalized block that
+// do not descend from it. They can never become canonical again, while the canonical chain up to
+// the new finalized block and its descendants are left untouched. The votes for the pruned blocks
+// are dropped as well so fork choice does not look them up.
+func (s *Store) pruneOrphanedBlocks(ctx context.Context, prevFinalized *ethpb.Checkpoint, finalized *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "forkchoice.pruneOrphanedBlocks")
+	defer span.End()
+
+	finalizedBlk, err := s.db.Block(ctx, bytesutil.ToBytes32(finalized.Root))
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlk == nil || finalizedBlk.Block == nil {
+		return nil
+	}
+
+	orphaned, err := s.orphanedBeforeFinalized(ctx, prevFinalized, bytesutil.ToBytes32(finalized.Root), finalizedBlk.Block)
+	if err != nil {
+		return err
+	}
+	nonDescendants, err := s.nonDescendantsOfFinalized(ctx, bytesutil.ToBytes32(finalized.Root), finalizedBlk.Block.Slot)
+	if err != nil {
+		return err
+	}
+	orphaned = append(orphaned, nonDescendants...)
+	orphaned, err = s.filterBlockRoots(ctx, orphaned)
+	if err != nil {
+		return errors.Wrap(err, "could not filter block roots")
+	}
+	if len(orphaned) == 0 {
+		return nil
+	}
+
+	if err := s.db.DeleteStates(ctx, orphaned); err != nil {
+		return errors.Wrap(err, "could not delete orphaned states")
+	}
+	if err := s.db.DeleteBlocks(ctx, orphaned); err != nil {
+		return errors.Wrap(err, "could not delete orphaned blocks")
+	}
+
+	pruned := make(map[[32]byte]bool, len(orphaned))
+	for _, r := range orphaned {
+		pruned[r] = true
+	}
+	s.voteLock.Lock()
+	for i, vote := range s.latestVoteMap {
+		if pruned[bytesutil.ToBytes32(vote.Root)] {
+			delete(s.latestVoteMap, i)
+		}
+	}
+	s.voteLock.Unlock()
+
+	log.WithField("count", len(orphaned)).Debug("Pruned orphaned blocks")
+	return nil
+}
+
+// orphanedBeforeFinalized returns the roots of the blocks between the previous finalized block and
+// the new finalized block which are not ancestors of the new finalized block.
+func (s *Store) orphanedBeforeFinalized(ctx context.Context, prevFinalized *ethpb.Checkpoint, finalizedRoot [32]byte, finalizedBlk *ethpb.BeaconBlock) ([][32]byte, error) {
+	startSlot := uint64(0)
+	prevFinalizedBlk, err := s.db.Block(ctx, bytesutil.ToBytes32(prevFinalized.Root))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get previous finalized block")
+	}
+	if prevFinalizedBlk != nil && prevFinalizedBlk.Block != nil {
+		startSlot = prevFinalizedBlk.Block.Slot
+	}
+	endSlot := finalizedBlk.Slot
+	if endSlot <= startSlot {
+		return nil, nil
+	}
+
+	// Walk the canonical chain back from the new finalized block to the previous finalized slot.
+	canonical := make(map[[32]byte]bool)
+	root := finalizedRoot
+	b := finalizedBlk
+	for {
+		canonical[root] = true
+		if b.Slot <= startSlot {
+			break
+		}
+		root = bytesutil.ToBytes32(b.ParentRoot)
+		parent, err := s.db.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get ancestor block")
+		}
+		// Without the full canonical chain, the orphaned blocks can't be told apart.
+		if parent == nil || parent.Block == nil {
+			log.WithField("slot", b.Slot).Debug("Missing ancestor of finalized block, skipping pruning of orphaned blocks")
+			return nil, nil
+		}
+		b = parent.Block
+	}
+
+	roots, err := s.db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block roots")
+	}
+	orphaned := make([][32]byte, 0, len(roots))
+	for _, r := range roots {
+		if !canonical[r] {
+			orphaned = append(orphaned, r)
+		}
+	}
+	return orphaned, nil
+}
+
+// nonDescendantsOfFinalized returns the roots of the blocks above the finalized slot which do not
+// descend from the finalized block.
+func (s *Store) nonDescendantsOfFinalized(ctx context.Context, finalizedRoot [32]byte, finalizedSlot uint64) ([][32]byte, error) {
+	blks, err := s.db.Blocks(ctx, filters.NewFilter().SetStartSlot(finalizedSlot+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get blocks above finalized slot")
+	}
+	// A parent has a lower slot than its children, so visiting the blocks by slot visits every
+	// parent before its children.
+	sort.Slice(blks, func(i, j int) bool { return blks[i].Block.Slot < blks[j].Block.Slot })
+	descendants := map[[32]byte]bool{finalizedRoot: true}
+	var nonDescendants [][32]byte
+	for _, b := range blks {
+		r, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute block root")
+		}
+		if descendants[bytesutil.ToBytes32(b.Block.ParentRoot)] {
+			descendants[r] = true
+			continue
+		}
+		nonDescendants = append(nonDescendants, r)
+	}
+	return nonDescendants, nil
+}
@@ -0,0 +1,101 @@
+package forkchoice
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+func TestPruneOrphanedBlocks_PrunesForksOfFinalizedChain(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	roots, err := blockTree1(db, []byte{'g'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	//    /- B1
+	// B0           /- B5 - B7
+	//    \- B3 - B4 - B6 - B8
+	// Finalizing B6 orphans B1 and B5, and B7 above it which does not descend from B6.
+	if err := db.SaveHeadBlockRoot(ctx, bytesutil.ToBytes32(roots[8])); err != nil {
+		t.Fatal(err)
+	}
+	finalized := &ethpb.Checkpoint{Epoch: 1, Root: roots[6]}
+	if err := db.SaveFinalizedCheckpoint(ctx, finalized); err != nil {
+		t.Fatal(err)
+	}
+	store.latestVoteMap[0] = &pb.ValidatorLatestVote{Root: roots[5]}
+	store.latestVoteMap[1] = &pb.ValidatorLatestVote{Root: roots[8]}
+	store.latestVoteMap[2] = &pb.ValidatorLatestVote{Root: roots[7]}
+
+	if err := store.pruneOrphanedBlocks(ctx, &ethpb.Checkpoint{Root: roots[0]}, finalized); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, i := range []int{1, 5, 7} {
+		r := bytesutil.ToBytes32(roots[i])
+		if db.HasBlock(ctx, r) {
+			t.Errorf("Orphaned block B%d should have been pruned", i)
+		}
+		if db.HasState(ctx, r) {
+			t.Errorf("State of orphaned block B%d should have been pruned", i)
+		}
+	}
+	for _, i := range []int{0, 3, 4, 6, 8} {
+		r := bytesutil.ToBytes32(roots[i])
+		if !db.HasBlock(ctx, r) {
+			t.Errorf("Canonical block B%d should not have been pruned", i)
+		}
+		if !db.HasState(ctx, r) {
+			t.Errorf("State of canonical block B%d should not have been pruned", i)
+		}
+	}
+	for _, i := range []uint64{0, 2} {
+		if _, ok := store.latestVoteMap[i]; ok {
+			t.Errorf("Vote %d for an orphaned block should have been removed", i)
+		}
+	}
+	if _, ok := store.latestVoteMap[1]; !ok {
+		t.Error("Vote for a canonical block should not have been removed")
+	}
+}
+
+func TestPruneOrphanedBlocks_MissingAncestorSkipsPruning(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	roots, err := blockTree1(db, []byte{'g'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, bytesutil.ToBytes32(roots[8])); err != nil {
+		t.Fatal(err)
+	}
+	finalized := &ethpb.Checkpoint{Epoch: 1, Root: roots[6]}
+	if err := db.SaveFinalizedCheckpoint(ctx, finalized); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteBlock(ctx, bytesutil.ToBytes32(roots[3])); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.pruneOrphanedBlocks(ctx, &ethpb.Checkpoint{Root: roots[0]}, finalized); err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []int{1, 5} {
+		if !db.HasBlock(ctx, bytesutil.ToBytes32(roots[i])) {
+			t.Errorf("Block B%d should not be pruned without the full canonical chain", i)
+		}
+	}
+}
@@ -166,16 +166,14 @@ func (s *Store) ancestor(ctx context.Context, root []byte, slot uint64) ([]byte,
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get ancestor block")
 	}
-	if signed == nil || signed.Block == nil {
-		return nil, errors.New("nil block")
-	}
-	b := signed.Block
 
 	// If we dont have the ancestor in the DB, simply return nil so rest of fork choice
-	// operation can proceed. This is not an error condition.
-	if b == nil || b.Slot < slot {
+	// operation can proceed. This is not an error condition, as the blocks of forks
+	// orphaned by finalization are pruned from the DB.
+	if signed == nil || signed.Block == nil || signed.Block.Slot < slot {
 		return nil, nil
 	}
+	b := signed.Block
 
 	if b.Slot == slot {
 		return root, nil
@@ -299,16 +297,19 @@ func (s *Store) Head(ctx context.Context) ([]byte, error) {
 			if err != nil {
 				return nil, errors.Wrap(err, "could not get latest balance")
 			}
-			for _, child := range children[1:] {
-				balance, err := s.latestAttestingBalance(ctx, child[:])
+			// The children are indexed rather than ranged over by value, as the head must
+			// not alias the loop variable which is overwritten on the next iteration.
+			for i := 1; i < len(children); i++ {
+				child := children[i][:]
+				balance, err := s.latestAttestingBalance(ctx, child)
 				if err != nil {
 					return nil, errors.Wrap(err, "could not get latest balance")
 				}
 				// When there's a tie, it's broken lexicographically to favor the higher one.
 				if balance > highest ||
-					balance == highest && bytes.Compare(child[:], head) > 0 {
+					balance == highest && bytes.Compare(child, head) > 0 {
 					highest = balance
-					head = child[:]
+					head = child
 				}
 			}
 		}
@@ -345,6 +345,60 @@ func TestStore_GetHead(t *testing.T) {
 	}
 }
 
+func TestStore_GetHead_TieBrokenByHigherRoot(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	store := NewForkChoiceService(ctx, db)
+
+	roots, err := blockTree1(db, []byte{'g'})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validators := make([]*ethpb.Validator, 100)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{ExitEpoch: 2, EffectiveBalance: 1e9}
+	}
+	s := &pb.BeaconState{Validators: validators, RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector)}
+	store.justifiedCheckpt = &ethpb.Checkpoint{Root: roots[0]}
+	store.finalizedCheckpt = &ethpb.Checkpoint{Root: roots[0]}
+	if err := store.checkpointState.AddCheckpointState(&cache.CheckpointState{
+		Checkpoint: store.justifiedCheckpt,
+		State:      s,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	//    /- B1 (50 votes)
+	// B0           /- B5 - B7
+	//    \- B3 - B4 - B6 - B8 (50 votes)
+	for i := 0; i < len(validators); i++ {
+		if i < 50 {
+			store.latestVoteMap[uint64(i)] = &pb.ValidatorLatestVote{Root: roots[1]}
+		} else {
+			store.latestVoteMap[uint64(i)] = &pb.ValidatorLatestVote{Root: roots[8]}
+		}
+	}
+
+	// B1 and B3 have the same weight, so the head is on the branch with the higher root.
+	want := roots[8]
+	if bytes.Compare(roots[1], roots[3]) > 0 {
+		want = roots[1]
+	}
+	for i := 0; i < 10; i++ {
+		head, err := store.Head(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(head, want) {
+			t.Fatalf("Wanted head %#x, received %#x", want, head)
+		}
+	}
+}
+
 func TestCacheGenesisState_Correct(t *testing.T) {
 	ctx := context.Background()
 	db := testDB.SetupDB(t)
@@ -22,6 +22,11 @@ type AttestationReceiver interface {
 	ReceiveAttestationNoPubsub(ctx context.Context, att *ethpb.Attestation) error
 }
 
+// HeadUpdater defines a method to recompute the head of the chain from fork choice on demand.
+type HeadUpdater interface {
+	UpdateHead(ctx context.Context) ([]byte, error)
+}
+
 // ReceiveAttestationNoPubsub is a function that defines the operations that are preformed on
 // attestation that is received from regular sync. The operations consist of:
 //  1. Validate attestation, update validator's latest vote
@@ -37,30 +42,42 @@ func (s *Service) ReceiveAttestationNoPubsub(ctx context.Context, att *ethpb.Att
 	}
 
 	// Run fork choice for head block after updating fork choice store.
+	if _, err := s.UpdateHead(ctx); err != nil {
+		return err
+	}
+
+	processedAttNoPubsub.Inc()
+	return nil
+}
+
+// UpdateHead runs fork choice and saves its head block as the head of the chain, along with its
+// state, if it differs from the current head. It returns the root of the head block.
+func (s *Service) UpdateHead(ctx context.Context) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.UpdateHead")
+	defer span.End()
+
 	headRoot, err := s.forkChoiceStore.Head(ctx)
 	if err != nil {
-		return errors.Wrap(err, "could not get head from fork choice service")
+		return nil, errors.Wrap(err, "could not get head from fork choice service")
 	}
 	// Only save head if it's different than the current head.
 	cachedHeadRoot, err := s.HeadRoot(ctx)
 	if err != nil {
-		return errors.Wrap(err, "could not get head root from cache")
+		return nil, errors.Wrap(err, "could not get head root from cache")
 	}
 	if !bytes.Equal(headRoot, cachedHeadRoot) {
 		signed, err := s.beaconDB.Block(ctx, bytesutil.ToBytes32(headRoot))
 		if err != nil {
-			return errors.Wrap(err, "could not compute state from block head")
+			return nil, errors.Wrap(err, "could not compute state from block head")
 		}
 		if signed == nil || signed.Block == nil {
-			return errors.New("nil head block")
+			return nil, errors.New("nil head block")
 		}
 		if err := s.saveHead(ctx, signed, bytesutil.ToBytes32(headRoot)); err != nil {
-			return errors.Wrap(err, "could not save head")
+			return nil, errors.Wrap(err, "could not save head")
 		}
 	}
-
-	processedAttNoPubsub.Inc()
-	return nil
+	return headRoot, nil
 }
 
 // This processes attestations from the attestation pool to account for validator votes and fork choice.
@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"golang.org/x/net/context"
@@ -61,3 +63,89 @@ func TestVerifyCheckpointEpoch_Ok(t *testing.T) {
 		t.Error("Wanted false, got true")
 	}
 }
+
+// voteStore is a fork choice store whose head is the block root with the most attestation votes.
+type voteStore struct {
+	store
+	votes map[[32]byte]int
+}
+
+func (s *voteStore) OnAttestation(ctx context.Context, a *ethpb.Attestation) error {
+	s.votes[bytesutil.ToBytes32(a.Data.BeaconBlockRoot)]++
+	return nil
+}
+
+func (s *voteStore) Head(ctx context.Context) ([]byte, error) {
+	head := s.headRoot
+	most := 0
+	for r, v := range s.votes {
+		if v > most {
+			root := r
+			head, most = root[:], v
+		}
+	}
+	return head, nil
+}
+
+func TestUpdateHead_MovesToHeaviestBlock(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, db)
+	var roots [][32]byte
+	for _, slot := range []uint64{1, 2} {
+		b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}}
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		r, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveState(ctx, &pb.BeaconState{Slot: slot}, r); err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, r)
+	}
+	fc := &voteStore{store: store{headRoot: roots[0][:]}, votes: make(map[[32]byte]int)}
+	chainService.forkChoiceStore = fc
+	if err := chainService.saveHead(ctx, &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1}}, roots[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	// The second block receives more votes than the first one, without a head update.
+	for i := 0; i < 2; i++ {
+		if err := fc.OnAttestation(ctx, &ethpb.Attestation{Data: &ethpb.AttestationData{BeaconBlockRoot: roots[1][:]}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fc.OnAttestation(ctx, &ethpb.Attestation{Data: &ethpb.AttestationData{BeaconBlockRoot: roots[0][:]}}); err != nil {
+		t.Fatal(err)
+	}
+
+	headRoot, err := chainService.UpdateHead(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(headRoot, roots[1][:]) {
+		t.Errorf("Wanted head root %#x, received %#x", roots[1], headRoot)
+	}
+	cachedRoot, err := chainService.HeadRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(cachedRoot, roots[1][:]) {
+		t.Errorf("Wanted cached head root %#x, received %#x", roots[1], cachedRoot)
+	}
+	if chainService.HeadSlot() != 2 {
+		t.Errorf("Wanted head slot 2, received %d", chainService.HeadSlot())
+	}
+	headState, err := chainService.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headState.Slot != 2 {
+		t.Errorf("Wanted head state at slot 2, received slot %d", headState.Slot)
+	}
+}
@@ -4,6 +4,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"runtime"
@@ -237,15 +238,114 @@ func (s *Service) Status() error {
 	return nil
 }
 
+// This returns the reorg data if the new head block does not descend from the current head, or nil
+// if it does or if there is no head yet.
+func (s *Service) headReorg(ctx context.Context, newHead *ethpb.BeaconBlock, newRoot [32]byte) (*statefeed.ReorgData, error) {
+	s.headLock.RLock()
+	oldHead := s.headBlock
+	oldRoot := bytesutil.ToBytes32(s.canonicalRoots[s.headSlot])
+	s.headLock.RUnlock()
+	if oldHead == nil || oldHead.Block == nil {
+		return nil, nil
+	}
+	if oldRoot == [32]byte{} {
+		var err error
+		oldRoot, err = ssz.HashTreeRoot(oldHead.Block)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if oldRoot == newRoot || bytes.Equal(newHead.ParentRoot, oldRoot[:]) {
+		return nil, nil
+	}
+
+	ancestorRoot, ancestorSlot, err := s.commonAncestor(ctx, oldRoot, oldHead.Block, newRoot, newHead)
+	if err != nil {
+		return nil, err
+	}
+	if ancestorRoot == oldRoot {
+		return nil, nil
+	}
+	return &statefeed.ReorgData{
+		OldHeadRoot:        oldRoot,
+		NewHeadRoot:        newRoot,
+		CommonAncestorSlot: ancestorSlot,
+	}, nil
+}
+
+// This walks back the chains of the two blocks until they meet, and returns the root and slot of the
+// latest block both of them descend from.
+func (s *Service) commonAncestor(
+	ctx context.Context,
+	rootA [32]byte,
+	blockA *ethpb.BeaconBlock,
+	rootB [32]byte,
+	blockB *ethpb.BeaconBlock,
+) ([32]byte, uint64, error) {
+	var err error
+	for rootA != rootB {
+		if blockA.Slot >= blockB.Slot {
+			rootA = bytesutil.ToBytes32(blockA.ParentRoot)
+			blockA, err = s.ancestorBlock(ctx, rootA)
+		} else {
+			rootB = bytesutil.ToBytes32(blockB.ParentRoot)
+			blockB, err = s.ancestorBlock(ctx, rootB)
+		}
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+	}
+	return rootA, blockA.Slot, nil
+}
+
+// This retrieves an ancestor block from DB, returning an error if it is missing.
+func (s *Service) ancestorBlock(ctx context.Context, root [32]byte) (*ethpb.BeaconBlock, error) {
+	signed, err := s.beaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve ancestor block")
+	}
+	if signed == nil || signed.Block == nil {
+		return nil, fmt.Errorf("ancestor block %#x not found", root)
+	}
+	return signed.Block, nil
+}
+
 // This gets called to update canonical root mapping.
 func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock, r [32]byte) error {
-	s.headLock.Lock()
-	defer s.headLock.Unlock()
-
 	if signed == nil || signed.Block == nil {
 		return errors.New("cannot save nil head block")
 	}
 
+	// Failing to detect a reorg must not prevent the head from being updated.
+	reorg, err := s.headReorg(ctx, signed.Block, r)
+	if err != nil {
+		log.WithError(err).Warn("Could not check for reorg")
+	}
+	if err := s.updateHead(ctx, signed, r); err != nil {
+		return err
+	}
+
+	// The reorg is notified once the head is updated and unlocked, so subscribers can read it.
+	if reorg != nil {
+		log.WithFields(logrus.Fields{
+			"oldHeadRoot":        fmt.Sprintf("%#x", reorg.OldHeadRoot),
+			"newHeadRoot":        fmt.Sprintf("%#x", reorg.NewHeadRoot),
+			"commonAncestorSlot": reorg.CommonAncestorSlot,
+		}).Info("Chain reorg occurred")
+		s.stateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.Reorg,
+			Data: reorg,
+		})
+	}
+	return nil
+}
+
+// This updates the head block, slot, state and canonical root of the service and saves the head
+// block root in DB.
+func (s *Service) updateHead(ctx context.Context, signed *ethpb.SignedBeaconBlock, r [32]byte) error {
+	s.headLock.Lock()
+	defer s.headLock.Unlock()
+
 	s.headSlot = signed.Block.Slot
 
 	s.canonicalRoots[signed.Block.Slot] = r[:]
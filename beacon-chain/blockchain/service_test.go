@@ -91,6 +91,11 @@ func (mb *mockBroadcaster) Broadcast(_ context.Context, _ proto.Message) error {
 	return nil
 }
 
+func (mb *mockBroadcaster) BroadcastAttestation(_ context.Context, _ uint64, _ *ethpb.Attestation) error {
+	mb.broadcastCalled = true
+	return nil
+}
+
 var _ = p2p.Broadcaster(&mockBroadcaster{})
 
 func setupBeaconChain(t *testing.T, beaconDB db.Database) *Service {
@@ -366,3 +371,83 @@ func TestChainService_SaveHeadNoDB(t *testing.T) {
 		t.Error("head block should not be equal")
 	}
 }
+
+func TestChainService_SaveHead_NotifiesReorg(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	s := setupBeaconChain(t, db)
+
+	// G - C1 - A2 - A3
+	//        \- B3 - B4 - B5
+	roots := make(map[string][32]byte)
+	blks := make(map[string]*ethpb.SignedBeaconBlock)
+	for _, tt := range []struct {
+		name   string
+		slot   uint64
+		parent string
+	}{
+		{name: "G", slot: 0},
+		{name: "C1", slot: 1, parent: "G"},
+		{name: "A2", slot: 2, parent: "C1"},
+		{name: "A3", slot: 3, parent: "A2"},
+		{name: "B3", slot: 3, parent: "C1"},
+		{name: "B4", slot: 4, parent: "B3"},
+		{name: "B5", slot: 5, parent: "B4"},
+	} {
+		parentRoot := roots[tt.parent]
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: tt.slot, ParentRoot: parentRoot[:]}}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveState(ctx, &pb.BeaconState{Slot: tt.slot}, root); err != nil {
+			t.Fatal(err)
+		}
+		roots[tt.name] = root
+		blks[tt.name] = blk
+	}
+
+	for _, name := range []string{"G", "C1", "A2", "A3"} {
+		if err := s.saveHead(ctx, blks[name], roots[name]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	if err := s.saveHead(ctx, blks["B4"], roots["B4"]); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-stateChannel:
+		if event.Type != statefeed.Reorg {
+			t.Fatalf("Wanted reorg event, received event of type %d", event.Type)
+		}
+		want := &statefeed.ReorgData{
+			OldHeadRoot:        roots["A3"],
+			NewHeadRoot:        roots["B4"],
+			CommonAncestorSlot: 1,
+		}
+		if !reflect.DeepEqual(event.Data, want) {
+			t.Errorf("Wanted reorg data %+v, received %+v", want, event.Data)
+		}
+	default:
+		t.Fatal("Expected a reorg event")
+	}
+
+	// Extending the new head is not a reorg.
+	if err := s.saveHead(ctx, blks["B5"], roots["B5"]); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-stateChannel:
+		t.Errorf("Did not expect an event, received event of type %d", event.Type)
+	default:
+	}
+}
@@ -133,6 +133,11 @@ func (ms *ChainService) HeadRoot(ctx context.Context) ([]byte, error) {
 
 }
 
+// UpdateHead mocks UpdateHead method in chain service.
+func (ms *ChainService) UpdateHead(ctx context.Context) ([]byte, error) {
+	return ms.Root, nil
+}
+
 // HeadBlock mocks HeadBlock method in chain service.
 func (ms *ChainService) HeadBlock() *ethpb.SignedBeaconBlock {
 	return ms.Block
@@ -143,6 +148,16 @@ func (ms *ChainService) HeadState(context.Context) (*pb.BeaconState, error) {
 	return ms.State, nil
 }
 
+// HeadStateReadOnly mocks HeadStateReadOnly method in chain service.
+func (ms *ChainService) HeadStateReadOnly(context.Context) (*pb.BeaconState, error) {
+	return ms.State, nil
+}
+
+// HeadRootAndStateReadOnly mocks HeadRootAndStateReadOnly method in chain service.
+func (ms *ChainService) HeadRootAndStateReadOnly(context.Context) ([]byte, *pb.BeaconState, error) {
+	return ms.Root, ms.State, nil
+}
+
 // CurrentFork mocks HeadState method in chain service.
 func (ms *ChainService) CurrentFork() *pb.Fork {
 	return ms.Fork
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"math/big"
 	"sort"
 	"sync"
@@ -11,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	log "github.com/sirupsen/logrus"
@@ -54,7 +56,9 @@ func NewDepositCache() *DepositCache {
 }
 
 // InsertDeposit into the database. If deposit or block number are nil
-// then this method does nothing.
+// then this method does nothing. A deposit already cached with the same
+// index and deposit data root, such as a deposit log processed again
+// after an eth1 reorg, is ignored.
 func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNum uint64, index int64, depositRoot [32]byte) {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertDeposit")
 	defer span.End()
@@ -67,10 +71,21 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 		}).Warn("Ignoring nil deposit insertion")
 		return
 	}
+	dataRoot, dataRootErr := depositDataRoot(d)
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 	// keep the slice sorted on insertion in order to avoid costly sorting on retrival.
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
+	for i := heightIdx; dataRootErr == nil && i < len(dc.deposits) && dc.deposits[i].Index == index; i++ {
+		if r, err := depositDataRoot(dc.deposits[i].Deposit); err == nil && r == dataRoot {
+			log.WithFields(log.Fields{
+				"block":     blockNum,
+				"index":     index,
+				"data root": hex.EncodeToString(dataRoot[:]),
+			}).Debug("Ignoring duplicate deposit insertion")
+			return
+		}
+	}
 	newDeposits := append([]*dbpb.DepositContainer{{Deposit: d, Eth1BlockHeight: blockNum, DepositRoot: depositRoot[:], Index: index}}, dc.deposits[heightIdx:]...)
 	dc.deposits = append(dc.deposits[:heightIdx], newDeposits...)
 	historicalDepositsCount.Inc()
@@ -172,3 +187,11 @@ func (dc *DepositCache) DepositByPubkey(ctx context.Context, pubKey []byte) (*et
 	}
 	return deposit, blockNum
 }
+
+// depositDataRoot returns the hash tree root of the deposit data of the deposit.
+func depositDataRoot(d *ethpb.Deposit) ([32]byte, error) {
+	if d == nil || d.Data == nil {
+		return [32]byte{}, errors.New("nil deposit data")
+	}
+	return ssz.HashTreeRoot(d.Data)
+}
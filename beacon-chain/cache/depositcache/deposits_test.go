@@ -72,6 +72,39 @@ func TestBeaconDB_InsertDeposit_MaintainsSortedOrderByIndex(t *testing.T) {
 	}
 }
 
+func TestBeaconDB_InsertDeposit_IgnoresDuplicateDeposit(t *testing.T) {
+	dc := DepositCache{}
+	ctx := context.Background()
+
+	newDeposit := func(b byte) *ethpb.Deposit {
+		pubKey := make([]byte, 48)
+		pubKey[0] = b
+		return &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             pubKey,
+				WithdrawalCredentials: make([]byte, 32),
+				Amount:                32,
+				Signature:             make([]byte, 96),
+			},
+		}
+	}
+	dc.InsertDeposit(ctx, newDeposit('a'), 10, 0, [32]byte{'A'})
+	dc.InsertDeposit(ctx, newDeposit('b'), 11, 1, [32]byte{'B'})
+	// The deposit of index 0 is processed again, such as after an eth1 reorg.
+	dc.InsertDeposit(ctx, newDeposit('a'), 12, 0, [32]byte{'C'})
+
+	if len(dc.deposits) != 2 {
+		t.Fatalf("Wanted 2 cached deposits, received %d", len(dc.deposits))
+	}
+	n, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(12))
+	if n != 2 {
+		t.Errorf("Wanted 2 deposits at height 12, received %d", n)
+	}
+	if root != [32]byte{'B'} {
+		t.Errorf("Wanted deposit root %#x, received %#x", [32]byte{'B'}, root)
+	}
+}
+
 func TestBeaconDB_AllDeposits_ReturnsAllDeposits(t *testing.T) {
 	dc := DepositCache{}
 
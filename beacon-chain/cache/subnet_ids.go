@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// SubnetIDs keeps the attestation subnets validators asked the node to subscribe to, each until
+// the end of the latest slot a validator needs it for.
+type SubnetIDs struct {
+	expiries map[uint64]time.Time
+	lock     sync.Mutex
+}
+
+// NewSubnetIDs creates an empty set of subnet subscriptions.
+func NewSubnetIDs() *SubnetIDs {
+	return &SubnetIDs{
+		expiries: make(map[uint64]time.Time),
+	}
+}
+
+// AddSubnetID subscribes to the subnet until the given expiry. A subscription to the same subnet
+// expiring later is kept as it is.
+func (s *SubnetIDs) AddSubnetID(subnet uint64, expiry time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if current, ok := s.expiries[subnet]; ok && !current.Before(expiry) {
+		return
+	}
+	s.expiries[subnet] = expiry
+}
+
+// ActiveSubnetIDs returns the sorted subnets whose subscription has not expired at the given
+// time. The expired subscriptions are removed.
+func (s *SubnetIDs) ActiveSubnetIDs(now time.Time) []uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	subnets := make([]uint64, 0, len(s.expiries))
+	for subnet, expiry := range s.expiries {
+		if !now.Before(expiry) {
+			delete(s.expiries, subnet)
+			continue
+		}
+		subnets = append(subnets, subnet)
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i] < subnets[j]
+	})
+	return subnets
+}
@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSubnetIDs_SubscriptionsExpire(t *testing.T) {
+	s := NewSubnetIDs()
+	now := time.Unix(1000, 0)
+	s.AddSubnetID(5, now.Add(12*time.Second))
+	s.AddSubnetID(1, now.Add(24*time.Second))
+	// An earlier expiry does not shorten the subscription.
+	s.AddSubnetID(1, now.Add(6*time.Second))
+
+	if got := s.ActiveSubnetIDs(now); !reflect.DeepEqual(got, []uint64{1, 5}) {
+		t.Errorf("Wanted active subnets %v, received %v", []uint64{1, 5}, got)
+	}
+	if got := s.ActiveSubnetIDs(now.Add(12 * time.Second)); !reflect.DeepEqual(got, []uint64{1}) {
+		t.Errorf("Wanted active subnets %v, received %v", []uint64{1}, got)
+	}
+
+	// A later expiry extends the subscription.
+	s.AddSubnetID(1, now.Add(36*time.Second))
+	if got := s.ActiveSubnetIDs(now.Add(30 * time.Second)); !reflect.DeepEqual(got, []uint64{1}) {
+		t.Errorf("Wanted active subnets %v, received %v", []uint64{1}, got)
+	}
+	if got := s.ActiveSubnetIDs(now.Add(36 * time.Second)); len(got) != 0 {
+		t.Errorf("Wanted no active subnet, received %v", got)
+	}
+}
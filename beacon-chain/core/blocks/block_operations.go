@@ -158,6 +158,39 @@ func Eth1DataHasEnoughSupport(beaconState *pb.BeaconState, data *ethpb.Eth1Data)
 	return voteCount*2 > params.BeaconConfig().SlotsPerEth1VotingPeriod, nil
 }
 
+// Eth1DataMajorityVote returns the eth1 data with the most votes in the current eth1 voting period
+// of the state. As in the spec's get_eth1_vote, a tie is broken in favor of the vote cast first
+// and the current eth1 data of the state is returned by default when there are no votes.
+func Eth1DataMajorityVote(beaconState *pb.BeaconState) (*ethpb.Eth1Data, error) {
+	if beaconState == nil {
+		return nil, errors.New("nil state")
+	}
+	hashes := make([][32]byte, len(beaconState.Eth1DataVotes))
+	counts := make(map[[32]byte]uint64, len(beaconState.Eth1DataVotes))
+	for i, vote := range beaconState.Eth1DataVotes {
+		h, err := hashutil.HashProto(vote)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash eth1data vote")
+		}
+		hashes[i] = h
+		counts[h]++
+	}
+	// Votes are walked in order and only a strictly higher count takes over, so the vote cast
+	// first wins a tie.
+	var majority *ethpb.Eth1Data
+	var majorityCount uint64
+	for i, vote := range beaconState.Eth1DataVotes {
+		if counts[hashes[i]] > majorityCount {
+			majority = vote
+			majorityCount = counts[hashes[i]]
+		}
+	}
+	if majority == nil {
+		return beaconState.Eth1Data, nil
+	}
+	return majority, nil
+}
+
 // ProcessBlockHeader validates a block by its header.
 //
 // Spec pseudocode definition:
@@ -301,11 +334,7 @@ func ProcessRandao(
 	proposerPub := beaconState.Validators[proposerIdx].PublicKey
 
 	currentEpoch := helpers.CurrentEpoch(beaconState)
-	buf := make([]byte, 32)
-	binary.LittleEndian.PutUint64(buf, currentEpoch)
-
-	domain := helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainRandao)
-	if err := verifySignature(buf, proposerPub, body.RandaoReveal, domain); err != nil {
+	if err := VerifyRandaoReveal(beaconState.Fork, currentEpoch, proposerPub, body.RandaoReveal); err != nil {
 		return nil, errors.Wrap(err, "could not verify block randao")
 	}
 
@@ -316,6 +345,16 @@ func ProcessRandao(
 	return beaconState, nil
 }
 
+// VerifyRandaoReveal verifies the randao reveal of a block proposed during the given epoch is the
+// signature of the epoch by the proposer public key, under the randao domain of the given fork.
+func VerifyRandaoReveal(fork *pb.Fork, epoch uint64, proposerPub []byte, randaoReveal []byte) error {
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+
+	domain := helpers.Domain(fork, epoch, params.BeaconConfig().DomainRandao)
+	return verifySignature(buf, proposerPub, randaoReveal, domain)
+}
+
 // ProcessRandaoNoVerify generates a new randao mix to update
 // in the beacon state's latest randao mixes slice.
 //
@@ -365,12 +404,22 @@ func ProcessRandaoNoVerify(
 //
 //    slash_validator(state, proposer_slashing.proposer_index)
 func ProcessProposerSlashings(ctx context.Context, beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody) (*pb.BeaconState, error) {
+	return processProposerSlashings(beaconState, body, true /* verifySignatures */)
+}
+
+// ProcessProposerSlashingsNoVerify processes the proposer slashings of a block like
+// ProcessProposerSlashings, without verifying the BLS signatures of the slashed headers.
+func ProcessProposerSlashingsNoVerify(ctx context.Context, beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody) (*pb.BeaconState, error) {
+	return processProposerSlashings(beaconState, body, false /* verifySignatures */)
+}
+
+func processProposerSlashings(beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody, verifySignatures bool) (*pb.BeaconState, error) {
 	var err error
 	for idx, slashing := range body.ProposerSlashings {
 		if int(slashing.ProposerIndex) >= len(beaconState.Validators) {
 			return nil, fmt.Errorf("invalid proposer index given in slashing %d", slashing.ProposerIndex)
 		}
-		if err = VerifyProposerSlashing(beaconState, slashing); err != nil {
+		if err = verifyProposerSlashing(beaconState, slashing, verifySignatures); err != nil {
 			return nil, errors.Wrapf(err, "could not verify proposer slashing %d", idx)
 		}
 		beaconState, err = v.SlashValidator(
@@ -387,6 +436,14 @@ func ProcessProposerSlashings(ctx context.Context, beaconState *pb.BeaconState,
 func VerifyProposerSlashing(
 	beaconState *pb.BeaconState,
 	slashing *ethpb.ProposerSlashing,
+) error {
+	return verifyProposerSlashing(beaconState, slashing, true /* verifySignatures */)
+}
+
+func verifyProposerSlashing(
+	beaconState *pb.BeaconState,
+	slashing *ethpb.ProposerSlashing,
+	verifySignatures bool,
 ) error {
 	proposer := beaconState.Validators[slashing.ProposerIndex]
 
@@ -399,6 +456,9 @@ func VerifyProposerSlashing(
 	if !helpers.IsSlashableValidator(proposer, helpers.CurrentEpoch(beaconState)) {
 		return fmt.Errorf("validator with key %#x is not slashable", proposer.PublicKey)
 	}
+	if !verifySignatures {
+		return nil
+	}
 	// Using headerEpoch1 here because both of the headers should have the same epoch.
 	domain := helpers.Domain(beaconState.Fork, helpers.StartSlot(slashing.Header_1.Header.Slot), params.BeaconConfig().DomainBeaconProposer)
 	headers := []*ethpb.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2}
@@ -430,8 +490,18 @@ func VerifyProposerSlashing(
 //            slashed_any = True
 //    assert slashed_any
 func ProcessAttesterSlashings(ctx context.Context, beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody) (*pb.BeaconState, error) {
+	return processAttesterSlashings(ctx, beaconState, body, true /* verifySignatures */)
+}
+
+// ProcessAttesterSlashingsNoVerify processes the attester slashings of a block like
+// ProcessAttesterSlashings, without verifying the BLS signatures of the slashed attestations.
+func ProcessAttesterSlashingsNoVerify(ctx context.Context, beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody) (*pb.BeaconState, error) {
+	return processAttesterSlashings(ctx, beaconState, body, false /* verifySignatures */)
+}
+
+func processAttesterSlashings(ctx context.Context, beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody, verifySignatures bool) (*pb.BeaconState, error) {
 	for idx, slashing := range body.AttesterSlashings {
-		if err := VerifyAttesterSlashing(ctx, beaconState, slashing); err != nil {
+		if err := verifyAttesterSlashing(ctx, beaconState, slashing, verifySignatures); err != nil {
 			return nil, errors.Wrapf(err, "could not verify attester slashing %d", idx)
 		}
 		slashableIndices := slashableAttesterIndices(slashing)
@@ -460,6 +530,10 @@ func ProcessAttesterSlashings(ctx context.Context, beaconState *pb.BeaconState,
 
 // VerifyAttesterSlashing validates the attestation data in both attestations in the slashing object.
 func VerifyAttesterSlashing(ctx context.Context, beaconState *pb.BeaconState, slashing *ethpb.AttesterSlashing) error {
+	return verifyAttesterSlashing(ctx, beaconState, slashing, true /* verifySignatures */)
+}
+
+func verifyAttesterSlashing(ctx context.Context, beaconState *pb.BeaconState, slashing *ethpb.AttesterSlashing, verifySignatures bool) error {
 	att1 := slashing.Attestation_1
 	att2 := slashing.Attestation_2
 	data1 := att1.Data
@@ -467,10 +541,10 @@ func VerifyAttesterSlashing(ctx context.Context, beaconState *pb.BeaconState, sl
 	if !IsSlashableAttestationData(data1, data2) {
 		return errors.New("attestations are not slashable")
 	}
-	if err := VerifyIndexedAttestation(ctx, beaconState, att1); err != nil {
+	if err := verifyIndexedAttestation(ctx, beaconState, att1, verifySignatures); err != nil {
 		return errors.Wrap(err, "could not validate indexed attestation")
 	}
-	if err := VerifyIndexedAttestation(ctx, beaconState, att2); err != nil {
+	if err := verifyIndexedAttestation(ctx, beaconState, att2, verifySignatures); err != nil {
 		return errors.Wrap(err, "could not validate indexed attestation")
 	}
 	return nil
@@ -712,6 +786,10 @@ func ConvertToIndexed(ctx context.Context, attestation *ethpb.Attestation, commi
 //        return False
 //    return True
 func VerifyIndexedAttestation(ctx context.Context, beaconState *pb.BeaconState, indexedAtt *ethpb.IndexedAttestation) error {
+	return verifyIndexedAttestation(ctx, beaconState, indexedAtt, true /* verifySignature */)
+}
+
+func verifyIndexedAttestation(ctx context.Context, beaconState *pb.BeaconState, indexedAtt *ethpb.IndexedAttestation, verifySignature bool) error {
 	ctx, span := trace.StartSpan(ctx, "core.VerifyIndexedAttestation")
 	defer span.End()
 
@@ -736,6 +814,9 @@ func VerifyIndexedAttestation(ctx context.Context, beaconState *pb.BeaconState,
 	if !reflect.DeepEqual(setIndices, indices) {
 		return errors.New("attesting indices is not uniquely sorted")
 	}
+	if !verifySignature {
+		return nil
+	}
 
 	domain := helpers.Domain(beaconState.Fork, indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
 	var pubkey *bls.PublicKey
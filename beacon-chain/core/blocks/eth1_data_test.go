@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -112,3 +113,53 @@ func TestEth1DataHasEnoughSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestEth1DataMajorityVote(t *testing.T) {
+	a := &ethpb.Eth1Data{DepositCount: 1, DepositRoot: []byte("a"), BlockHash: []byte("a")}
+	b := &ethpb.Eth1Data{DepositCount: 2, DepositRoot: []byte("b"), BlockHash: []byte("b")}
+	c := &ethpb.Eth1Data{DepositCount: 3, DepositRoot: []byte("c"), BlockHash: []byte("c")}
+	current := &ethpb.Eth1Data{DepositRoot: []byte("current"), BlockHash: []byte("current")}
+
+	tests := []struct {
+		name  string
+		votes []*ethpb.Eth1Data
+		want  *ethpb.Eth1Data
+	}{
+		{
+			name:  "no votes returns the state eth1 data",
+			votes: []*ethpb.Eth1Data{},
+			want:  current,
+		},
+		{
+			name:  "clear majority",
+			votes: []*ethpb.Eth1Data{a, b, c, b, a, b},
+			want:  b,
+		},
+		{
+			name:  "tie goes to the first vote cast",
+			votes: []*ethpb.Eth1Data{c, b, b, c, a},
+			want:  c,
+		},
+		{
+			name:  "tie goes to the first vote cast when the other vote leads first",
+			votes: []*ethpb.Eth1Data{a, b, b, a},
+			want:  a,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Votes are copied so the majority is found by value rather than by pointer.
+			votes := make([]*ethpb.Eth1Data, len(tt.votes))
+			for i, v := range tt.votes {
+				votes[i] = proto.Clone(v).(*ethpb.Eth1Data)
+			}
+			got, err := blocks.Eth1DataMajorityVote(&pb.BeaconState{Eth1Data: current, Eth1DataVotes: votes})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("Wanted eth1 data %v, received %v", tt.want, got)
+			}
+		})
+	}
+}
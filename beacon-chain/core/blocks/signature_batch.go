@@ -0,0 +1,143 @@
+package blocks
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// SignatureBatch holds signatures to be verified together, along with the public key, message
+// and domain each of them is verified against.
+type SignatureBatch struct {
+	signatures   []*bls.Signature
+	publicKeys   []*bls.PublicKey
+	messages     [][32]byte
+	domains      []uint64
+	descriptions []string
+}
+
+// Len returns the number of signatures in the batch.
+func (s *SignatureBatch) Len() int {
+	return len(s.signatures)
+}
+
+func (s *SignatureBatch) add(signature []byte, pub *bls.PublicKey, msg [32]byte, domain uint64, description string) error {
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return errors.Wrapf(err, "could not convert bytes to %s", description)
+	}
+	s.signatures = append(s.signatures, sig)
+	s.publicKeys = append(s.publicKeys, pub)
+	s.messages = append(s.messages, msg)
+	s.domains = append(s.domains, domain)
+	s.descriptions = append(s.descriptions, description)
+	return nil
+}
+
+// Verify checks all the signatures of the batch in one aggregate check. If the aggregate check
+// fails, the signatures are verified one by one and the error names the first invalid one.
+func (s *SignatureBatch) Verify() error {
+	if s.Len() == 0 {
+		return nil
+	}
+	verified, err := bls.VerifyMultipleSignatures(s.signatures, s.messages, s.publicKeys, s.domains)
+	if err != nil {
+		return errors.Wrap(err, "could not verify signature batch")
+	}
+	if verified {
+		return nil
+	}
+	for i, sig := range s.signatures {
+		if !sig.Verify(s.messages[i][:], s.publicKeys[i], s.domains[i]) {
+			return errors.Wrapf(ErrSigFailedToVerify, "invalid %s", s.descriptions[i])
+		}
+	}
+	return errors.Wrap(ErrSigFailedToVerify, "invalid signature batch")
+}
+
+// BlockSignatureBatch collects the proposer signature, the randao reveal and the attestation
+// signatures of a block into a batch, using the state the block is applied to once its slot has
+// been processed. These are the bulk of the signatures of a block. Slashings and voluntary exits
+// are rare and still verified one by one when the block is processed, and deposits are left out
+// as an invalid deposit signature does not invalidate the block.
+func BlockSignatureBatch(ctx context.Context, beaconState *pb.BeaconState, signed *ethpb.SignedBeaconBlock) (*SignatureBatch, error) {
+	ctx, span := trace.StartSpan(ctx, "core.BlockSignatureBatch")
+	defer span.End()
+
+	if signed == nil || signed.Block == nil || signed.Block.Body == nil {
+		return nil, errors.New("nil block")
+	}
+	batch := &SignatureBatch{}
+
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get beacon proposer index")
+	}
+	proposerPub, err := bls.PublicKeyFromBytes(beaconState.Validators[proposerIdx].PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not deserialize proposer public key")
+	}
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+
+	blockRoot, err := ssz.HashTreeRoot(signed.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signing root")
+	}
+	domain := helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainBeaconProposer)
+	if err := batch.add(signed.Signature, proposerPub, blockRoot, domain, "block signature"); err != nil {
+		return nil, err
+	}
+
+	var epochMsg [32]byte
+	binary.LittleEndian.PutUint64(epochMsg[:], currentEpoch)
+	domain = helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainRandao)
+	if err := batch.add(signed.Block.Body.RandaoReveal, proposerPub, epochMsg, domain, "randao reveal"); err != nil {
+		return nil, err
+	}
+
+	for i, att := range signed.Block.Body.Attestations {
+		if att == nil || att.Data == nil || att.Data.Target == nil {
+			return nil, fmt.Errorf("nil attestation %d", i)
+		}
+		committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get committee of attestation %d", i)
+		}
+		indices, err := helpers.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get attesting indices of attestation %d", i)
+		}
+		if len(indices) == 0 {
+			continue
+		}
+		pub, err := bls.PublicKeyFromBytes(beaconState.Validators[indices[0]].PublicKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not deserialize validator public key")
+		}
+		for _, idx := range indices[1:] {
+			pk, err := bls.PublicKeyFromBytes(beaconState.Validators[idx].PublicKey)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not deserialize validator public key")
+			}
+			pub.Aggregate(pk)
+		}
+		dataRoot, err := ssz.HashTreeRoot(att.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not tree hash att data")
+		}
+		domain := helpers.Domain(beaconState.Fork, att.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+		if err := batch.add(att.Signature, pub, dataRoot, domain, fmt.Sprintf("signature of attestation %d", i)); err != nil {
+			return nil, err
+		}
+	}
+	return batch, nil
+}
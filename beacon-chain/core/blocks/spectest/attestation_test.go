@@ -12,9 +12,11 @@ import (
 )
 
 func runAttestationTest(t *testing.T, config string) {
-	if err := spectest.SetConfig(config); err != nil {
+	restoreConfig, err := spectest.SetConfig(config)
+	if err != nil {
 		t.Fatal(err)
 	}
+	defer restoreConfig()
 
 	testFolders, testsFolderPath := testutil.TestFolders(t, config, "operations/attestation/pyspec_tests")
 	for _, folder := range testFolders {
@@ -9,6 +9,8 @@ const (
 	ChainStarted
 	// Initialized is sent when the internal beacon node's state is ready to be accessed.
 	Initialized
+	// Reorg is sent when the head switches to a block which does not descend from the previous head.
+	Reorg
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -30,3 +32,13 @@ type InitializedData struct {
 	// StartTime is the time at which the chain started.
 	StartTime time.Time
 }
+
+// ReorgData is the data sent with Reorg events.
+type ReorgData struct {
+	// OldHeadRoot is the root of the head block before the reorg.
+	OldHeadRoot [32]byte
+	// NewHeadRoot is the root of the head block after the reorg.
+	NewHeadRoot [32]byte
+	// CommonAncestorSlot is the slot of the latest block both heads descend from.
+	CommonAncestorSlot uint64
+}
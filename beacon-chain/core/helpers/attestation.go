@@ -17,6 +17,9 @@ var (
 	// ErrAttestationAggregationBitsOverlap is returned when two attestations aggregation
 	// bits overlap with each other.
 	ErrAttestationAggregationBitsOverlap = errors.New("overlapping aggregation bits")
+	// ErrAttestationDataMismatch is returned when two attestations to be merged do not
+	// attest to the same data.
+	ErrAttestationDataMismatch = errors.New("attestation data mismatch")
 )
 
 // AggregateAttestations such that the minimal number of attestations are returned.
@@ -106,6 +109,37 @@ func AggregateAttestation(a1 *ethpb.Attestation, a2 *ethpb.Attestation) (*ethpb.
 	return baseAtt, nil
 }
 
+// MergeAggregates merges the aggregates a and b, which must attest to identical data with
+// disjoint aggregation bits, into a single aggregate covering the attesters of both and
+// carrying their aggregated BLS signature. Neither input is modified.
+func MergeAggregates(a *ethpb.Attestation, b *ethpb.Attestation) (*ethpb.Attestation, error) {
+	if a == nil || b == nil || a.Data == nil || b.Data == nil {
+		return nil, errors.New("nil attestation or attestation data")
+	}
+	if !proto.Equal(a.Data, b.Data) {
+		return nil, ErrAttestationDataMismatch
+	}
+	if a.AggregationBits.Len() != b.AggregationBits.Len() {
+		return nil, errors.Errorf("aggregation bits length mismatch, %d != %d", a.AggregationBits.Len(), b.AggregationBits.Len())
+	}
+	if a.AggregationBits.Overlaps(b.AggregationBits) {
+		return nil, ErrAttestationAggregationBitsOverlap
+	}
+
+	merged := proto.Clone(a).(*ethpb.Attestation)
+	aSig, err := signatureFromBytes(a.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not deserialize signature")
+	}
+	bSig, err := signatureFromBytes(b.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not deserialize signature")
+	}
+	merged.AggregationBits = a.AggregationBits.Or(b.AggregationBits)
+	merged.Signature = aggregateSignatures([]*bls.Signature{aSig, bSig}).Marshal()
+	return merged, nil
+}
+
 // SlotSignature returns the signed signature of the hash tree root of input slot.
 //
 // Spec pseudocode definition:
@@ -121,19 +155,20 @@ func SlotSignature(state *pb.BeaconState, slot uint64, privKey *bls.SecretKey) (
 	return privKey.Sign(s[:], d), nil
 }
 
-// IsAggregator returns true if the signature is from the input validator. The committee
-// count is provided as an argument rather than direct implementation from spec. Having
-// committee count as an argument allows cheaper computation at run time.
+// IsAggregator returns true if the slot signature selects its signer as an aggregator of a
+// committee with the given length. The committee length is provided as an argument rather than
+// the committee being computed from a state as in the spec, which allows callers that already
+// know the committee to skip recomputing it.
 //
 // Spec pseudocode definition:
 //   def is_aggregator(state: BeaconState, slot: Slot, index: CommitteeIndex, slot_signature: BLSSignature) -> bool:
 //    committee = get_beacon_committee(state, slot, index)
 //    modulo = max(1, len(committee) // TARGET_AGGREGATORS_PER_COMMITTEE)
 //    return bytes_to_int(hash(slot_signature)[0:8]) % modulo == 0
-func IsAggregator(committeeCount uint64, slot uint64, index uint64, slotSig []byte) (bool, error) {
+func IsAggregator(committeeLength uint64, slotSig []byte) (bool, error) {
 	modulo := uint64(1)
-	if committeeCount/params.BeaconConfig().TargetAggregatorsPerCommittee > 1 {
-		modulo = committeeCount / params.BeaconConfig().TargetAggregatorsPerCommittee
+	if committeeLength/params.BeaconConfig().TargetAggregatorsPerCommittee > 1 {
+		modulo = committeeLength / params.BeaconConfig().TargetAggregatorsPerCommittee
 	}
 
 	b := hashutil.Hash(slotSig)
@@ -163,3 +198,18 @@ func AggregateSignature(attestations []*ethpb.Attestation) (*bls.Signature, erro
 func IsAggregated(attestation *ethpb.Attestation) bool {
 	return attestation.AggregationBits.Count() > 1
 }
+
+// ComputeSubnetForAttestation returns the attestation subnet of the committee of the given index
+// at the slot. The committees are numbered from the start of the slot's epoch, so the committees
+// of an epoch are spread over all the subnets, wrapping around at the subnet count.
+//
+// Spec pseudocode definition:
+//   def compute_subnet_for_attestation(state: BeaconState, attestation: Attestation) -> uint64:
+//    slots_since_epoch_start = attestation.data.slot % SLOTS_PER_EPOCH
+//    committees_since_epoch_start = get_committee_count_at_slot(state, attestation.data.slot) * slots_since_epoch_start
+//    return (committees_since_epoch_start + attestation.data.index) % ATTESTATION_SUBNET_COUNT
+func ComputeSubnetForAttestation(committeesPerSlot uint64, slot uint64, committeeIndex uint64) uint64 {
+	slotsSinceEpochStart := slot % params.BeaconConfig().SlotsPerEpoch
+	committeesSinceEpochStart := committeesPerSlot * slotsSinceEpochStart
+	return (committeesSinceEpochStart + committeeIndex) % params.BeaconConfig().AttestationSubnetCount
+}
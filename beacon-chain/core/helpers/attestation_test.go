@@ -61,6 +61,79 @@ func TestAggregateAttestation_OverlapFails(t *testing.T) {
 	}
 }
 
+func TestMergeAggregates(t *testing.T) {
+	data := &ethpb.AttestationData{Slot: 1, BeaconBlockRoot: []byte{'A', 31: 0}}
+	msg := []byte("hello")
+	keys := make([]*bls.SecretKey, 4)
+	pubkeys := make([]*bls.PublicKey, len(keys))
+	sigs := make([]*bls.Signature, len(keys))
+	for i := range keys {
+		keys[i] = bls.RandKey()
+		pubkeys[i] = keys[i].PublicKey()
+		sigs[i] = keys[i].Sign(msg, 0)
+	}
+	a := &ethpb.Attestation{
+		Data:            data,
+		AggregationBits: bitfield.Bitlist{0x13},
+		Signature:       bls.AggregateSignatures(sigs[:2]).Marshal(),
+	}
+	b := &ethpb.Attestation{
+		Data:            data,
+		AggregationBits: bitfield.Bitlist{0x1C},
+		Signature:       bls.AggregateSignatures(sigs[2:]).Marshal(),
+	}
+
+	merged, err := helpers.MergeAggregates(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(merged.AggregationBits, bitfield.Bitlist{0x1F}) {
+		t.Errorf("Wanted aggregation bits %#b, received %#b", bitfield.Bitlist{0x1F}, merged.AggregationBits)
+	}
+	if !ssz.DeepEqual(merged.Data, data) {
+		t.Errorf("Wanted data %v, received %v", data, merged.Data)
+	}
+	sig, err := bls.SignatureFromBytes(merged.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.VerifyAggregateCommon(pubkeys, bytesutil.ToBytes32(msg), 0) {
+		t.Error("Merged signature did not verify")
+	}
+	if !bytes.Equal(a.AggregationBits, bitfield.Bitlist{0x13}) {
+		t.Error("Input aggregate was modified")
+	}
+}
+
+func TestMergeAggregates_Fails(t *testing.T) {
+	sig := bls.RandKey().Sign([]byte("hello"), 0).Marshal()
+	data := &ethpb.AttestationData{Slot: 1}
+	tests := []struct {
+		name    string
+		a       *ethpb.Attestation
+		b       *ethpb.Attestation
+		wantErr error
+	}{
+		{
+			name:    "overlapping bits",
+			a:       &ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0x13}, Signature: sig},
+			b:       &ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0x16}, Signature: sig},
+			wantErr: helpers.ErrAttestationAggregationBitsOverlap,
+		},
+		{
+			name:    "different data",
+			a:       &ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0x13}, Signature: sig},
+			b:       &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0x1C}, Signature: sig},
+			wantErr: helpers.ErrAttestationDataMismatch,
+		},
+	}
+	for _, tt := range tests {
+		if _, err := helpers.MergeAggregates(tt.a, tt.b); err != tt.wantErr {
+			t.Errorf("%s: wanted error %v, received %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
 func bitlistWithAllBitsSet(length uint64) bitfield.Bitlist {
 	b := bitfield.NewBitlist(length)
 	for i := uint64(0); i < length; i++ {
@@ -233,7 +306,7 @@ func TestIsAggregator_True(t *testing.T) {
 		t.Fatal(err)
 	}
 	sig := privKeys[0].Sign([]byte{}, 0)
-	agg, err := helpers.IsAggregator(uint64(len(committee)), 0, 0, sig.Marshal())
+	agg, err := helpers.IsAggregator(uint64(len(committee)), sig.Marshal())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -252,7 +325,7 @@ func TestIsAggregator_False(t *testing.T) {
 		t.Fatal(err)
 	}
 	sig := privKeys[0].Sign([]byte{}, 0)
-	agg, err := helpers.IsAggregator(uint64(len(committee)), 0, 0, sig.Marshal())
+	agg, err := helpers.IsAggregator(uint64(len(committee)), sig.Marshal())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -261,6 +334,93 @@ func TestIsAggregator_False(t *testing.T) {
 	}
 }
 
+func TestIsAggregator_KnownSignatures(t *testing.T) {
+	target := params.BeaconConfig().TargetAggregatorsPerCommittee
+	signature := func(b byte) []byte {
+		return bytes.Repeat([]byte{b}, 96)
+	}
+	tests := []struct {
+		name            string
+		committeeLength uint64
+		slotSig         []byte
+		want            bool
+	}{
+		{
+			name:            "small committee always aggregates",
+			committeeLength: target,
+			slotSig:         signature(0),
+			want:            true,
+		},
+		{
+			name:            "empty committee always aggregates",
+			committeeLength: 0,
+			slotSig:         signature(1),
+			want:            true,
+		},
+		{
+			// The first 8 bytes of the hash of the signature are 0 modulo 8.
+			name:            "selected signature",
+			committeeLength: 8 * target,
+			slotSig:         signature(2),
+			want:            true,
+		},
+		{
+			// The first 8 bytes of the hash of the signature are 6 modulo 8.
+			name:            "unselected signature",
+			committeeLength: 8 * target,
+			slotSig:         signature(0),
+			want:            false,
+		},
+		{
+			// The first 8 bytes of the hash of the signature are 7 modulo 8.
+			name:            "unselected signature with committee length rounded down",
+			committeeLength: 9*target - 1,
+			slotSig:         signature(9),
+			want:            false,
+		},
+	}
+	for _, tt := range tests {
+		got, err := helpers.IsAggregator(tt.committeeLength, tt.slotSig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: wanted aggregator %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestComputeSubnetForAttestation(t *testing.T) {
+	params.UseMainnetConfig()
+	tests := []struct {
+		committeesPerSlot uint64
+		slot              uint64
+		committeeIndex    uint64
+		want              uint64
+	}{
+		{committeesPerSlot: 1, slot: 0, committeeIndex: 0, want: 0},
+		{committeesPerSlot: 4, slot: 3, committeeIndex: 2, want: 14},
+		{committeesPerSlot: 4, slot: 35, committeeIndex: 2, want: 14},
+		{committeesPerSlot: 2, slot: 31, committeeIndex: 1, want: 63},
+		{committeesPerSlot: 2, slot: 31, committeeIndex: 2, want: 0},
+		{committeesPerSlot: 64, slot: 1, committeeIndex: 5, want: 5},
+		{committeesPerSlot: 64, slot: 31, committeeIndex: 63, want: 63},
+	}
+	for _, tt := range tests {
+		got := helpers.ComputeSubnetForAttestation(tt.committeesPerSlot, tt.slot, tt.committeeIndex)
+		if got != tt.want {
+			t.Errorf(
+				"ComputeSubnetForAttestation(%d, %d, %d) = %d, wanted %d",
+				tt.committeesPerSlot,
+				tt.slot,
+				tt.committeeIndex,
+				got,
+				tt.want,
+			)
+		}
+	}
+}
+
 func TestAggregateSignature_True(t *testing.T) {
 	pubkeys := make([]*bls.PublicKey, 0, 100)
 	atts := make([]*ethpb.Attestation, 0, 100)
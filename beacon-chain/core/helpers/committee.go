@@ -19,6 +19,10 @@ import (
 
 var committeeCache = cache.NewCommitteesCache()
 
+// ErrNoActiveValidators is returned when there is no active validator to form the committees of
+// an epoch from.
+var ErrNoActiveValidators = errors.New("no active validators to form committees")
+
 // SlotCommitteeCount returns the number of crosslink committees of a slot. The
 // active validator count is provided as an argument rather than a direct implementation
 // from the spec definition. Having the active validator count as an argument allows for
@@ -49,6 +53,20 @@ func SlotCommitteeCount(activeValidatorCount uint64) uint64 {
 	return committeePerSlot
 }
 
+// checkCommitteeCount returns an error if the committees of an epoch can't be computed from the
+// given number of active validators with the configured committee parameters, as it happens on
+// testnets configured with a zero target committee size. A validator set smaller than the
+// committees is fine, as the committee count of a slot is at least one.
+func checkCommitteeCount(activeValidatorCount uint64) error {
+	if params.BeaconConfig().SlotsPerEpoch == 0 || params.BeaconConfig().TargetCommitteeSize == 0 {
+		return errors.New("slots per epoch and target committee size must be greater than zero")
+	}
+	if activeValidatorCount == 0 {
+		return ErrNoActiveValidators
+	}
+	return nil
+}
+
 // BeaconCommitteeFromState returns the crosslink committee of a given slot and committee index. This
 // is a spec implementation where state is used as an argument. In case of state retrieval
 // becomes expensive, consider using BeaconCommittee below.
@@ -159,14 +177,15 @@ func ComputeCommittee(
 	start := sliceutil.SplitOffset(validatorCount, count, index)
 	end := sliceutil.SplitOffset(validatorCount, count, index+1)
 
-	// Save the shuffled indices in cache, this is only needed once per epoch or once per new committee index.
+	// The whole list is shuffled once per seed and validator count, then every committee of the
+	// epoch is read out of it.
+	positions, err := shuffledPositions(validatorCount, seed)
+	if err != nil {
+		return []uint64{}, errors.Wrap(err, "could not shuffle indices")
+	}
 	shuffledIndices := make([]uint64, end-start)
 	for i := start; i < end; i++ {
-		permutedIndex, err := ShuffledIndex(i, validatorCount, seed)
-		if err != nil {
-			return []uint64{}, errors.Wrapf(err, "could not get shuffled index at index %d", i)
-		}
-		shuffledIndices[i-start] = indices[permutedIndex]
+		shuffledIndices[i-start] = indices[positions[i]]
 	}
 
 	return shuffledIndices, nil
@@ -222,6 +241,14 @@ func CommitteeAssignments(state *pb.BeaconState, epoch uint64) (map[uint64]*Comm
 		)
 	}
 
+	activeValidatorIndices, err := ActiveValidatorIndices(state, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkCommitteeCount(uint64(len(activeValidatorIndices))); err != nil {
+		return nil, nil, err
+	}
+
 	// Track which slot has which proposer.
 	startSlot := StartSlot(epoch)
 	proposerIndexToSlot := make(map[uint64]uint64)
@@ -234,10 +261,6 @@ func CommitteeAssignments(state *pb.BeaconState, epoch uint64) (map[uint64]*Comm
 		proposerIndexToSlot[i] = slot
 	}
 
-	activeValidatorIndices, err := ActiveValidatorIndices(state, epoch)
-	if err != nil {
-		return nil, nil, err
-	}
 	// Each slot in an epoch has a different set of committees. This value is derived from the
 	// active validator set, which does not change.
 	numCommitteesPerSlot := SlotCommitteeCount(uint64(len(activeValidatorIndices)))
@@ -321,26 +344,52 @@ func CommitteeAssignment(
 		proposerIndexToSlot[i] = slot
 	}
 
+	committee, committeeIndex, attesterSlot, err := ComputeCommitteeAssignment(state, epoch, validatorIndex)
+	if err != nil {
+		return committee, 0, 0, 0, err
+	}
+	proposerSlot, _ := proposerIndexToSlot[validatorIndex]
+	return committee, committeeIndex, attesterSlot, proposerSlot, nil
+}
+
+// ComputeCommitteeAssignment returns the committee the validator with the given index is assigned
+// to in the given epoch, along with the index of the committee within its slot and the slot at
+// which the committee attests. The epoch can't be greater than the next epoch of the state, and
+// the state is not modified.
+func ComputeCommitteeAssignment(
+	state *pb.BeaconState,
+	epoch uint64,
+	validatorIndex uint64,
+) ([]uint64, uint64, uint64, error) {
+	if epoch > NextEpoch(state) {
+		return nil, 0, 0, fmt.Errorf(
+			"epoch %d can't be greater than next epoch %d",
+			epoch, NextEpoch(state))
+	}
+
 	activeValidatorIndices, err := ActiveValidatorIndices(state, epoch)
 	if err != nil {
-		return nil, 0, 0, 0, err
+		return nil, 0, 0, err
 	}
+	if err := checkCommitteeCount(uint64(len(activeValidatorIndices))); err != nil {
+		return nil, 0, 0, err
+	}
+	startSlot := StartSlot(epoch)
 	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
 		countAtSlot := SlotCommitteeCount(uint64(len(activeValidatorIndices)))
 		for i := uint64(0); i < countAtSlot; i++ {
 			committee, err := BeaconCommitteeFromState(state, slot, i)
 			if err != nil {
-				return nil, 0, 0, 0, errors.Wrapf(err, "could not get crosslink committee at slot %d", slot)
+				return nil, 0, 0, errors.Wrapf(err, "could not get crosslink committee at slot %d", slot)
 			}
 			for _, v := range committee {
 				if validatorIndex == v {
-					proposerSlot, _ := proposerIndexToSlot[v]
-					return committee, i, slot, proposerSlot, nil
+					return committee, i, slot, nil
 				}
 			}
 		}
 	}
-	return []uint64{}, 0, 0, 0, fmt.Errorf("validator with index %d not found in assignments", validatorIndex)
+	return []uint64{}, 0, 0, fmt.Errorf("validator with index %d not found in assignments", validatorIndex)
 }
 
 // VerifyBitfieldLength verifies that a bitfield length matches the given committee size.
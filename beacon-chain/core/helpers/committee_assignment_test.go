@@ -0,0 +1,102 @@
+package helpers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestComputeCommitteeAssignment_DeterministicGenesisState(t *testing.T) {
+	helpers.ClearCache()
+	state, _ := testutil.DeterministicGenesisState(t, 64)
+
+	for epoch := uint64(0); epoch < 2; epoch++ {
+		assignments, _, err := helpers.CommitteeAssignments(state, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// CommitteeAssignments moves the slot of the state through the epoch.
+		state.Slot = 0
+		for i := uint64(0); i < uint64(len(state.Validators)); i++ {
+			committee, committeeIndex, attesterSlot, err := helpers.ComputeCommitteeAssignment(state, epoch, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if helpers.SlotToEpoch(attesterSlot) != epoch {
+				t.Errorf("Expected attester slot of validator %d in epoch %d, received slot %d", i, epoch, attesterSlot)
+			}
+			if attesterSlot != assignments[i].AttesterSlot {
+				t.Errorf("Expected attester slot %d for validator %d, received %d", assignments[i].AttesterSlot, i, attesterSlot)
+			}
+			if committeeIndex != assignments[i].CommitteeIndex {
+				t.Errorf("Expected committee index %d for validator %d, received %d", assignments[i].CommitteeIndex, i, committeeIndex)
+			}
+			wantedCommittee, err := helpers.BeaconCommitteeFromState(state, attesterSlot, committeeIndex)
+			if err != nil {
+				t.Fatal(err)
+			}
+			found := false
+			for j, v := range committee {
+				if v != wantedCommittee[j] {
+					t.Errorf("Expected committee %v for validator %d, received %v", wantedCommittee, i, committee)
+					break
+				}
+				found = found || v == i
+			}
+			if !found {
+				t.Errorf("Expected validator %d in its committee %v", i, committee)
+			}
+		}
+		if state.Slot != 0 {
+			t.Errorf("Expected state to stay at slot 0, received slot %d", state.Slot)
+		}
+	}
+}
+
+func TestComputeCommitteeAssignment_Errors(t *testing.T) {
+	helpers.ClearCache()
+	state, _ := testutil.DeterministicGenesisState(t, 64)
+
+	if _, _, _, err := helpers.ComputeCommitteeAssignment(state, 2, 0); err == nil || !strings.Contains(err.Error(), "can't be greater than next epoch") {
+		t.Errorf("Expected error for an epoch after the next epoch, received %v", err)
+	}
+	index := uint64(len(state.Validators)) + params.BeaconConfig().SlotsPerEpoch
+	if _, _, _, err := helpers.ComputeCommitteeAssignment(state, 0, index); err == nil || !strings.Contains(err.Error(), "not found in assignments") {
+		t.Errorf("Expected error for an unknown validator, received %v", err)
+	}
+}
+
+func TestCommitteeAssignments_FewerValidatorsThanSlots(t *testing.T) {
+	helpers.ClearCache()
+	state, _ := testutil.DeterministicGenesisState(t, 2)
+
+	assignments, _, err := helpers.CommitteeAssignments(state, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 2 {
+		t.Errorf("Expected an assignment for each validator, received %d", len(assignments))
+	}
+	if _, _, _, err := helpers.ComputeCommitteeAssignment(state, 0, 0); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCommitteeAssignments_NoActiveValidators(t *testing.T) {
+	helpers.ClearCache()
+	state, _ := testutil.DeterministicGenesisState(t, 2)
+	for _, v := range state.Validators {
+		v.ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+	}
+
+	if _, _, err := helpers.CommitteeAssignments(state, 0); errors.Cause(err) != helpers.ErrNoActiveValidators {
+		t.Errorf("Expected %v, received %v", helpers.ErrNoActiveValidators, err)
+	}
+	if _, _, _, err := helpers.ComputeCommitteeAssignment(state, 0, 0); errors.Cause(err) != helpers.ErrNoActiveValidators {
+		t.Errorf("Expected %v, received %v", helpers.ErrNoActiveValidators, err)
+	}
+}
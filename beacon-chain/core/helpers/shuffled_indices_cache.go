@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// maxShuffledIndicesCacheSize is the number of shuffled lists kept in memory. Committees are
+// mostly computed for the previous, current and next epoch, which each have their own seed.
+const maxShuffledIndicesCacheSize = 4
+
+// shuffledIndicesCache keeps the shuffled positions of the active validator list by seed and
+// list size. As the shuffling is a pure function of the two, entries never need invalidation
+// beyond being evicted by newer ones.
+var shuffledIndicesCache, _ = lru.New(maxShuffledIndicesCacheSize)
+
+var (
+	shuffledIndicesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "shuffled_indices_cache_hit",
+		Help: "The total number of cache hits on the shuffled indices cache.",
+	})
+	shuffledIndicesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "shuffled_indices_cache_miss",
+		Help: "The total number of cache misses on the shuffled indices cache.",
+	})
+)
+
+type shuffledIndicesCacheKey struct {
+	seed  [32]byte
+	count uint64
+}
+
+// shuffledPositions returns the list of shuffled positions of a list of the given size, where the
+// i-th item is ShuffledIndex(i, count, seed). The list is shared between callers and must be
+// treated as read only.
+func shuffledPositions(count uint64, seed [32]byte) ([]uint64, error) {
+	key := shuffledIndicesCacheKey{seed: seed, count: count}
+	if positions, ok := shuffledIndicesCache.Get(key); ok {
+		shuffledIndicesCacheHit.Inc()
+		return positions.([]uint64), nil
+	}
+	shuffledIndicesCacheMiss.Inc()
+
+	positions := make([]uint64, count)
+	for i := range positions {
+		positions[i] = uint64(i)
+	}
+	// Un-shuffling the identity list puts ShuffledIndex(i) at position i, as shuffling it does
+	// the opposite.
+	positions, err := UnshuffleList(positions, seed)
+	if err != nil {
+		return nil, err
+	}
+	shuffledIndicesCache.Add(key, positions)
+	return positions, nil
+}
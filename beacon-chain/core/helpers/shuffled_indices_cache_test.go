@@ -0,0 +1,82 @@
+package helpers
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestShuffledPositions_MatchesShuffledIndex(t *testing.T) {
+	shuffledIndicesCache.Purge()
+	seed := [32]byte{'a', 'b'}
+	count := uint64(1000)
+	positions, err := shuffledPositions(count, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := uint64(0); i < count; i++ {
+		want, err := ShuffledIndex(i, count, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if positions[i] != want {
+			t.Fatalf("Wanted shuffled index %d at position %d, received %d", want, i, positions[i])
+		}
+	}
+	if !shuffledIndicesCache.Contains(shuffledIndicesCacheKey{seed: seed, count: count}) {
+		t.Error("Expected shuffled positions to be cached")
+	}
+
+	cached, err := shuffledPositions(count, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if &cached[0] != &positions[0] {
+		t.Error("Expected shuffled positions to be served from the cache")
+	}
+}
+
+func benchmarkCommitteeAssignmentsState(validatorCount int) *pb.BeaconState {
+	validators := make([]*ethpb.Validator, validatorCount)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	return &pb.BeaconState{
+		Validators:  validators,
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+}
+
+func BenchmarkCommitteeAssignments_SameEpochCacheHit(b *testing.B) {
+	ClearCache()
+	state := benchmarkCommitteeAssignmentsState(16384)
+	if _, _, err := CommitteeAssignments(state, 0); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, _, err := CommitteeAssignments(state, 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCommitteeAssignments_SameEpochCacheMiss(b *testing.B) {
+	ClearCache()
+	state := benchmarkCommitteeAssignmentsState(16384)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		shuffledIndicesCache.Purge()
+		b.StartTimer()
+		if _, _, err := CommitteeAssignments(state, 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
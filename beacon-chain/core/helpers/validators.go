@@ -177,6 +177,36 @@ func BeaconProposerIndex(state *pb.BeaconState) (uint64, error) {
 	return ComputeProposerIndex(state.Validators, indices, seedWithSlotHash)
 }
 
+// ProposerAssignments returns a map of the slots of the given epoch to the index of the validator
+// assigned to propose a block at that slot, as defined by get_beacon_proposer_index. Proposers
+// of an epoch ahead of the state depend on a RANDAO mix which is not final yet, so future epochs
+// are rejected.
+func ProposerAssignments(state *pb.BeaconState, epoch uint64) (map[uint64]uint64, error) {
+	if epoch > CurrentEpoch(state) {
+		return nil, errors.Errorf(
+			"epoch %d can't be greater than current epoch %d",
+			epoch,
+			CurrentEpoch(state),
+		)
+	}
+	originalSlot := state.Slot
+	defer func() {
+		state.Slot = originalSlot
+	}()
+
+	startSlot := StartSlot(epoch)
+	proposers := make(map[uint64]uint64, params.BeaconConfig().SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		state.Slot = slot
+		i, err := BeaconProposerIndex(state)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not check proposer at slot %d", slot)
+		}
+		proposers[slot] = i
+	}
+	return proposers, nil
+}
+
 // ComputeProposerIndex returns the index sampled by effective balance, which is used to calculate proposer.
 //
 // Note: This method signature deviates slightly from the spec recommended definition. The full
@@ -176,6 +176,63 @@ func TestBeaconProposerIndex_OK(t *testing.T) {
 	}
 }
 
+func TestProposerAssignments_OK(t *testing.T) {
+	c := params.BeaconConfig()
+	c.MinGenesisActiveValidatorCount = 16384
+	params.OverrideBeaconConfig(c)
+	validators := make([]*ethpb.Validator, params.BeaconConfig().MinGenesisActiveValidatorCount/8)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	state := &pb.BeaconState{
+		Validators:  validators,
+		Slot:        3,
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+
+	ClearCache()
+	proposers, err := ProposerAssignments(state, 0 /* genesis epoch */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Slot != 3 {
+		t.Errorf("Wanted state slot to be restored to 3, received %d", state.Slot)
+	}
+	if uint64(len(proposers)) != params.BeaconConfig().SlotsPerEpoch {
+		t.Fatalf("Wanted %d proposers, received %d", params.BeaconConfig().SlotsPerEpoch, len(proposers))
+	}
+	// Same expectations as TestBeaconProposerIndex_OK.
+	wanted := map[uint64]uint64{1: 505, 5: 798, 19: 1956, 30: 991}
+	for slot, index := range wanted {
+		if proposers[slot] != index {
+			t.Errorf("Wanted proposer %d at slot %d, received %d", index, slot, proposers[slot])
+		}
+	}
+	for slot, index := range proposers {
+		ClearCache()
+		s := &pb.BeaconState{Validators: state.Validators, Slot: slot, RandaoMixes: state.RandaoMixes}
+		want, err := BeaconProposerIndex(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if index != want {
+			t.Errorf("Wanted proposer %d at slot %d, received %d", want, slot, index)
+		}
+	}
+}
+
+func TestProposerAssignments_FutureEpoch(t *testing.T) {
+	state := &pb.BeaconState{
+		Validators:  []*ethpb.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}},
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+	if _, err := ProposerAssignments(state, 1); err == nil {
+		t.Error("Expected an error for an epoch ahead of the state")
+	}
+}
+
 func TestDelayedActivationExitEpoch_OK(t *testing.T) {
 	epoch := uint64(9999)
 	got := DelayedActivationExitEpoch(epoch)
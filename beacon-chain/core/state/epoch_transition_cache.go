@@ -0,0 +1,57 @@
+package state
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+)
+
+// epochTransitionCache holds the post states of the latest epoch transitions keyed by the root
+// of their pre state. An epoch transition is fully determined by its pre state, so an entry can
+// never be stale: after a reorg, the states of the new branch have different roots and miss, while
+// the entries of the abandoned branch are evicted as new ones are added.
+var epochTransitionCache, _ = lru.New(4)
+
+var (
+	epochTransitionCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "epoch_transition_cache_hit",
+		Help: "The total number of cache hits on the epoch transition cache.",
+	})
+	epochTransitionCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "epoch_transition_cache_miss",
+		Help: "The total number of cache misses on the epoch transition cache.",
+	})
+)
+
+// processEpochCached processes the epoch transition of the state, reusing the cached post state
+// if the same pre state was already transitioned.
+func processEpochCached(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState, error) {
+	if !featureconfig.Get().EnableEpochTransitionCache {
+		return ProcessEpochPrecompute(ctx, state)
+	}
+
+	key, err := stateutil.HashTreeRootState(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create cache key")
+	}
+	if cached, ok := epochTransitionCache.Get(key); ok {
+		epochTransitionCacheHit.Inc()
+		// Clone the cached state so that the cache is not mutated.
+		return proto.Clone(cached.(*pb.BeaconState)).(*pb.BeaconState), nil
+	}
+	epochTransitionCacheMiss.Inc()
+
+	state, err = ProcessEpochPrecompute(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	epochTransitionCache.Add(key, proto.Clone(state).(*pb.BeaconState))
+	return state, nil
+}
@@ -0,0 +1,151 @@
+package state
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+)
+
+func enableEpochTransitionCache() func() {
+	previous := *featureconfig.Get()
+	cfg := previous
+	cfg.EnableEpochTransitionCache = true
+	featureconfig.Init(&cfg)
+	epochTransitionCache.Purge()
+	return func() {
+		featureconfig.Init(&previous)
+		epochTransitionCache.Purge()
+	}
+}
+
+// epochBoundaryState returns a state of active validators at the last slot of the first epoch.
+// It is built by hand, as the test state helpers depend on this package.
+func epochBoundaryState(numValidators int) *pb.BeaconState {
+	validators := make([]*ethpb.Validator, numValidators)
+	balances := make([]uint64, numValidators)
+	for i := 0; i < numValidators; i++ {
+		pubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+		binary.LittleEndian.PutUint64(pubKey, uint64(i))
+		validators[i] = &ethpb.Validator{
+			PublicKey:                  pubKey,
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           params.BeaconConfig().MaxEffectiveBalance,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	stateRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = make([]byte, 32)
+		stateRoots[i] = make([]byte, 32)
+	}
+	randaoMixes := make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector)
+	for i := range randaoMixes {
+		randaoMixes[i] = make([]byte, 32)
+	}
+	return &pb.BeaconState{
+		Slot:                        params.BeaconConfig().SlotsPerEpoch - 1,
+		Fork:                        &pb.Fork{PreviousVersion: make([]byte, 4), CurrentVersion: make([]byte, 4)},
+		LatestBlockHeader:           &ethpb.BeaconBlockHeader{ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)},
+		BlockRoots:                  blockRoots,
+		StateRoots:                  stateRoots,
+		Eth1Data:                    &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+		Validators:                  validators,
+		Balances:                    balances,
+		RandaoMixes:                 randaoMixes,
+		Slashings:                   make([]uint64, params.BeaconConfig().EpochsPerSlashingsVector),
+		JustificationBits:           bitfield.Bitvector4{0x00},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: make([]byte, 32)},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+}
+
+func TestEpochTransitionCache_SecondTransitionHits(t *testing.T) {
+	defer enableEpochTransitionCache()()
+	ctx := context.Background()
+	preState := epochBoundaryState(64)
+	key, err := stateutil.HashTreeRootState(preState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := processEpochCached(ctx, proto.Clone(preState).(*pb.BeaconState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cached, ok := epochTransitionCache.Get(key)
+	if !ok {
+		t.Fatal("Expected the post state to be cached by pre state root")
+	}
+
+	second, err := processEpochCached(ctx, proto.Clone(preState).(*pb.BeaconState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ssz.DeepEqual(first, second) {
+		t.Error("Cached epoch transition leads to a different state")
+	}
+	if second == cached.(*pb.BeaconState) {
+		t.Error("Expected a copy of the cached state to be returned")
+	}
+
+	want, err := ProcessEpochPrecompute(ctx, proto.Clone(preState).(*pb.BeaconState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ssz.DeepEqual(want, second) {
+		t.Error("Cached epoch transition differs from the uncached one")
+	}
+}
+
+func BenchmarkEpochTransitionCache_Miss(b *testing.B) {
+	defer enableEpochTransitionCache()()
+	ctx := context.Background()
+	preState := epochBoundaryState(64)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		epochTransitionCache.Purge()
+		if _, err := processEpochCached(ctx, proto.Clone(preState).(*pb.BeaconState)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEpochTransitionCache_Hit(b *testing.B) {
+	defer enableEpochTransitionCache()()
+	ctx := context.Background()
+	preState := epochBoundaryState(64)
+	key, err := stateutil.HashTreeRootState(preState)
+	if err != nil {
+		b.Fatal(err)
+	}
+	// The first transition populates the cache, so every following one is a hit.
+	if _, err := processEpochCached(ctx, proto.Clone(preState).(*pb.BeaconState)); err != nil {
+		b.Fatal(err)
+	}
+	if !epochTransitionCache.Contains(key) {
+		b.Fatal("Expected the post state to be cached by pre state root")
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := processEpochCached(ctx, proto.Clone(preState).(*pb.BeaconState)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package spectest
+
+import (
+	"testing"
+)
+
+func TestGenesisValidityMinimal(t *testing.T) {
+	runGenesisValidityTests(t, "minimal")
+}
@@ -0,0 +1,49 @@
+package spectest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params/spectest"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func runGenesisValidityTests(t *testing.T, config string) {
+	restoreConfig, err := spectest.SetConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restoreConfig()
+
+	testFolders, testsFolderPath := testutil.TestFolders(t, config, "genesis/validity/pyspec_tests")
+
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			genesisFile, err := testutil.BazelFileBytes(testsFolderPath, folder.Name(), "genesis.ssz")
+			if err != nil {
+				t.Fatal(err)
+			}
+			genesisState := &pb.BeaconState{}
+			if err := ssz.Unmarshal(genesisFile, genesisState); err != nil {
+				t.Fatalf("Failed to unmarshal: %v", err)
+			}
+
+			file, err := testutil.BazelFileBytes(testsFolderPath, folder.Name(), "is_valid.yaml")
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantValid := strings.TrimSpace(string(file)) == "true"
+
+			valid, err := state.IsValidGenesisBeaconState(genesisState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if valid != wantValid {
+				t.Fatalf("Wanted valid genesis state %v, received %v", wantValid, valid)
+			}
+		})
+	}
+}
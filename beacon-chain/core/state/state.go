@@ -10,6 +10,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
@@ -236,3 +237,22 @@ func IsValidGenesisState(chainStartDepositCount uint64, currentTime uint64) bool
 	}
 	return true
 }
+
+// IsValidGenesisBeaconState checks whether a full beacon state is a valid genesis state, which
+// is the case once the minimum genesis time has passed and enough validators are active at the
+// genesis epoch.
+//
+// Spec pseudocode definition:
+//  def is_valid_genesis_state(state: BeaconState) -> bool:
+//     if state.genesis_time < MIN_GENESIS_TIME:
+//         return False
+//     if len(get_active_validator_indices(state, GENESIS_EPOCH)) < MIN_GENESIS_ACTIVE_VALIDATOR_COUNT:
+//         return False
+//     return True
+func IsValidGenesisBeaconState(state *pb.BeaconState) (bool, error) {
+	activeCount, err := helpers.ActiveValidatorCount(state, 0 /* genesis epoch */)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get active validator count")
+	}
+	return IsValidGenesisState(activeCount, state.GenesisTime), nil
+}
@@ -179,3 +179,40 @@ func TestGenesisState_FailsWithoutEth1data(t *testing.T) {
 		t.Errorf("Did not receive eth1data error with nil eth1data, got %v", err)
 	}
 }
+
+func TestIsValidGenesisBeaconState(t *testing.T) {
+	minCount := params.BeaconConfig().MinGenesisActiveValidatorCount
+	minTime := params.BeaconConfig().MinGenesisTime
+	tests := []struct {
+		name           string
+		validatorCount uint64
+		genesisTime    uint64
+		wantValid      bool
+	}{
+		{name: "below validator count", validatorCount: minCount - 1, genesisTime: minTime, wantValid: false},
+		{name: "below genesis time", validatorCount: minCount, genesisTime: minTime - 1, wantValid: false},
+		{name: "at thresholds", validatorCount: minCount, genesisTime: minTime, wantValid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validators := make([]*ethpb.Validator, tt.validatorCount)
+			for i := 0; i < len(validators); i++ {
+				validators[i] = &ethpb.Validator{
+					ActivationEpoch: 0,
+					ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+				}
+			}
+			s := &pb.BeaconState{
+				GenesisTime: tt.genesisTime,
+				Validators:  validators,
+			}
+			valid, err := state.IsValidGenesisBeaconState(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if valid != tt.wantValid {
+				t.Errorf("Wanted valid genesis state %v, received %v", tt.wantValid, valid)
+			}
+		})
+	}
+}
@@ -129,6 +129,74 @@ func ExecuteStateTransitionNoVerify(
 	return state, nil
 }
 
+// ExecuteStateTransitionBatchVerify defines the procedure for a state transition function, in which
+// the proposer signature, the randao reveal and the attestation signatures of the block are verified
+// in one aggregate check rather than one by one, which is considerably faster for full blocks. If the
+// check fails, the returned error names the invalid signature.
+//
+// Spec pseudocode definition:
+//  def state_transition(state: BeaconState, block: BeaconBlock, validate_state_root: bool=False) -> BeaconState:
+//    # Process slots (including those with no blocks) since block
+//    process_slots(state, block.slot)
+//    # Process block
+//    process_block(state, block)
+//    # Validate state root (`validate_state_root == True` in production)
+//    if validate_state_root:
+//        assert block.state_root == hash_tree_root(state)
+//    # Return post-state
+//    return state
+func ExecuteStateTransitionBatchVerify(
+	ctx context.Context,
+	state *pb.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*pb.BeaconState, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if signed == nil || signed.Block == nil {
+		return nil, errors.New("nil block")
+	}
+
+	b.ClearEth1DataVoteCache()
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.ExecuteStateTransitionBatchVerify")
+	defer span.End()
+	var err error
+	// Execute per slots transition.
+	state, err = ProcessSlots(ctx, state, signed.Block.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slot")
+	}
+
+	// The signatures are collected before the block mutates the state they are verified against.
+	batch, err := b.BlockSignatureBatch(ctx, state, signed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not collect signatures of block in slot %d", signed.Block.Slot)
+	}
+
+	// Execute per block transition.
+	state, err = processBlockBatchVerify(ctx, state, signed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process block in slot %d", signed.Block.Slot)
+	}
+	if err := batch.Verify(); err != nil {
+		return nil, errors.Wrapf(err, "could not verify signatures of block in slot %d", signed.Block.Slot)
+	}
+
+	interop.WriteBlockToDisk(signed, false)
+	interop.WriteStateToDisk(state)
+
+	postStateRoot, err := stateutil.HashTreeRootState(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not tree hash processed state")
+	}
+	if !bytes.Equal(postStateRoot[:], signed.Block.StateRoot) {
+		return state, fmt.Errorf("validate state root failed, wanted: %#x, received: %#x",
+			postStateRoot[:], signed.Block.StateRoot)
+	}
+
+	return state, nil
+}
+
 // CalculateStateRoot defines the procedure for a state transition function.
 // This does not validate any BLS signatures in a block, it is used for calculating the
 // state root of the state for the block proposer to use.
@@ -293,7 +361,7 @@ func ProcessSlots(ctx context.Context, state *pb.BeaconState, slot uint64) (*pb.
 			return nil, errors.Wrap(err, "could not process slot")
 		}
 		if CanProcessEpoch(state) {
-			state, err = ProcessEpochPrecompute(ctx, state)
+			state, err = processEpochCached(ctx, state)
 			if err != nil {
 				traceutil.AnnotateError(span, err)
 				return nil, errors.Wrap(err, "could not process epoch with optimizations")
@@ -408,6 +476,84 @@ func processBlockNoVerify(
 	return state, nil
 }
 
+// ProcessBlockForStateRegen processes a block to regenerate a state from past accepted blocks. It
+// applies the same state changes as ProcessBlock, including slashings and balance changes, but does
+// not validate any of the BLS signatures of the block, slashing signatures included.
+//
+// WARNING: This method does not verify any signature. It must only be used to replay blocks which
+// were already validated, such as the blocks the state generator replays from the database.
+func ProcessBlockForStateRegen(
+	ctx context.Context,
+	state *pb.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessBlockForStateRegen")
+	defer span.End()
+
+	state, err := b.ProcessBlockHeaderNoVerify(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process block header")
+	}
+
+	state, err = b.ProcessRandaoNoVerify(state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process randao")
+	}
+
+	state, err = b.ProcessEth1DataInBlock(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process eth1 data")
+	}
+
+	state, err = processOperationsForStateRegen(ctx, state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process block operation")
+	}
+
+	return state, nil
+}
+
+// processBlockBatchVerify processes the block like ProcessBlock, except that the proposer signature,
+// the randao reveal and the attestation signatures are left to be verified in a batch.
+func processBlockBatchVerify(
+	ctx context.Context,
+	state *pb.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessBlock")
+	defer span.End()
+
+	state, err := b.ProcessBlockHeaderNoVerify(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process block header")
+	}
+
+	state, err = b.ProcessRandaoNoVerify(state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process randao")
+	}
+
+	state, err = b.ProcessEth1DataInBlock(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process eth1 data")
+	}
+
+	state, err = processOperationsBatchVerify(ctx, state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process block operation")
+	}
+
+	return state, nil
+}
+
 // ProcessOperations processes the operations in the beacon block and updates beacon state
 // with the operations in block.
 //
@@ -525,6 +671,80 @@ func processOperationsNoVerify(
 	return state, nil
 }
 
+// processOperationsForStateRegen processes the operations in the beacon block like ProcessOperations,
+// without verifying the slashing, attestation or voluntary exit signatures.
+func processOperationsForStateRegen(
+	ctx context.Context,
+	state *pb.BeaconState,
+	body *ethpb.BeaconBlockBody) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessOperations")
+	defer span.End()
+
+	if err := verifyOperationLengths(state, body); err != nil {
+		return nil, errors.Wrap(err, "could not verify operation lengths")
+	}
+
+	state, err := b.ProcessProposerSlashingsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block proposer slashings")
+	}
+	state, err = b.ProcessAttesterSlashingsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attester slashings")
+	}
+	state, err = b.ProcessAttestationsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attestations")
+	}
+	state, err = b.ProcessDeposits(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block validator deposits")
+	}
+	state, err = b.ProcessVoluntaryExitsNoVerify(state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process validator exits")
+	}
+
+	return state, nil
+}
+
+// processOperationsBatchVerify processes the operations in the beacon block like ProcessOperations,
+// except that the attestation signatures are left to be verified in a batch.
+func processOperationsBatchVerify(
+	ctx context.Context,
+	state *pb.BeaconState,
+	body *ethpb.BeaconBlockBody) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessOperations")
+	defer span.End()
+
+	if err := verifyOperationLengths(state, body); err != nil {
+		return nil, errors.Wrap(err, "could not verify operation lengths")
+	}
+
+	state, err := b.ProcessProposerSlashings(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block proposer slashings")
+	}
+	state, err = b.ProcessAttesterSlashings(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attester slashings")
+	}
+	state, err = b.ProcessAttestationsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attestations")
+	}
+	state, err = b.ProcessDeposits(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block validator deposits")
+	}
+	state, err = b.ProcessVoluntaryExits(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process validator exits")
+	}
+
+	return state, nil
+}
+
 func verifyOperationLengths(state *pb.BeaconState, body *ethpb.BeaconBlockBody) error {
 	if uint64(len(body.ProposerSlashings)) > params.BeaconConfig().MaxProposerSlashings {
 		return fmt.Errorf(
@@ -8,6 +8,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
@@ -101,6 +103,145 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	}
 }
 
+func TestExecuteStateTransitionBatchVerify_ValidBlock(t *testing.T) {
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+
+	conf := &testutil.BlockGenConfig{NumAttestations: 2}
+	for i := 0; i < 3; i++ {
+		block, err := testutil.GenerateFullBlock(beaconState, privKeys, conf, beaconState.Slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(block.Block.Body.Attestations) == 0 {
+			t.Fatal("Expected the block to carry attestations")
+		}
+		beaconState, err = state.ExecuteStateTransitionBatchVerify(context.Background(), beaconState, block)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestExecuteStateTransitionBatchVerify_InvalidAttestationSignature(t *testing.T) {
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumAttestations: 2}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(block.Block.Body.Attestations) != 2 {
+		t.Fatalf("Expected 2 attestations, received %d", len(block.Block.Body.Attestations))
+	}
+	// Replace the second attestation signature with a well-formed signature over another message,
+	// and sign the block again so the attestation signature is the only invalid one.
+	block.Block.Body.Attestations[1].Signature = privKeys[0].Sign([]byte("bad"), 0).Marshal()
+	sig, err := testutil.BlockSignature(beaconState, block.Block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Signature = sig.Marshal()
+
+	_, err = state.ExecuteStateTransitionBatchVerify(context.Background(), beaconState, block)
+	if err == nil {
+		t.Fatal("Expected the block to be rejected")
+	}
+	if errors.Cause(err) != blocks.ErrSigFailedToVerify {
+		t.Errorf("Expected %v, received %v", blocks.ErrSigFailedToVerify, err)
+	}
+	want := "invalid signature of attestation 1"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error to contain %q, received %v", want, err)
+	}
+}
+
+func TestExecuteStateTransitionBatchVerify_InvalidSlashingSignature(t *testing.T) {
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	ctx := context.Background()
+
+	conf := &testutil.BlockGenConfig{NumAttestations: 1, NumProposerSlashings: 1}
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, conf, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Slashings are left out of the batch, only the block signature, the randao reveal and the
+	// attestation signatures are collected.
+	preState, _ := testutil.DeterministicGenesisState(t, 64)
+	preState, err = state.ProcessSlots(ctx, preState, block.Block.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch, err := blocks.BlockSignatureBatch(ctx, preState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wanted := 2 + len(block.Block.Body.Attestations); batch.Len() != wanted {
+		t.Errorf("Wanted %d signatures in the batch, received %d", wanted, batch.Len())
+	}
+
+	// They are still verified one by one as the block is processed.
+	block.Block.Body.ProposerSlashings[0].Header_2.Signature = privKeys[0].Sign([]byte("bad"), 0).Marshal()
+	sig, err := testutil.BlockSignature(beaconState, block.Block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.Signature = sig.Marshal()
+
+	_, err = state.ExecuteStateTransitionBatchVerify(ctx, beaconState, block)
+	if err == nil {
+		t.Fatal("Expected the block to be rejected")
+	}
+	if errors.Cause(err) != blocks.ErrSigFailedToVerify {
+		t.Errorf("Expected %v, received %v", blocks.ErrSigFailedToVerify, err)
+	}
+	want := "could not verify proposer slashing 0"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error to contain %q, received %v", want, err)
+	}
+}
+
+func TestProcessBlockForStateRegen_MatchesFullVerify(t *testing.T) {
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	tests := []struct {
+		name string
+		conf *testutil.BlockGenConfig
+	}{
+		{name: "attestations", conf: &testutil.BlockGenConfig{NumAttestations: 2}},
+		{name: "proposer slashing", conf: &testutil.BlockGenConfig{NumProposerSlashings: 1}},
+		{name: "attester slashing", conf: &testutil.BlockGenConfig{NumAttesterSlashings: 1}},
+	}
+	for _, tt := range tests {
+		beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+		block, err := testutil.GenerateFullBlock(beaconState, privKeys, tt.conf, beaconState.Slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		verified, err := state.ExecuteStateTransition(context.Background(), proto.Clone(beaconState).(*pb.BeaconState), block)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		notVerified, err := state.ProcessSlots(context.Background(), proto.Clone(beaconState).(*pb.BeaconState), block.Block.Slot)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		notVerified, err = state.ProcessBlockForStateRegen(context.Background(), notVerified, block)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !proto.Equal(verified, notVerified) {
+			t.Errorf("%s: wanted the same post state with and without signature verification", tt.name)
+		}
+	}
+}
+
 func TestProcessBlock_IncorrectProposerSlashing(t *testing.T) {
 	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
 
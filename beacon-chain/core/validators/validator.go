@@ -5,6 +5,8 @@
 package validators
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -41,44 +43,85 @@ func InitiateValidatorExit(state *pb.BeaconState, idx uint64) (*pb.BeaconState,
 	if validator.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
 		return state, nil
 	}
+	exitEpoch, err := exitQueueEpoch(state, helpers.CurrentEpoch(state))
+	if err != nil {
+		return nil, err
+	}
+	state.Validators[idx].ExitEpoch = exitEpoch
+	state.Validators[idx].WithdrawableEpoch = exitEpoch + params.BeaconConfig().MinValidatorWithdrawabilityDelay
+	return state, nil
+}
+
+// ExitEpochAndUpdateChurn returns the epoch at which the validator with the given index exits if it
+// submits a voluntary exit as soon as it is allowed to, which is once it has been active for
+// PersistentCommitteePeriod epochs, and sets the exit and withdrawable epochs of the validator in the
+// state so the exit counts towards the churn of the following ones. The exit epoch of a validator
+// which already initiated its exit is returned as is. Callers which only want to know the exit
+// epoch should pass a copy of the state.
+func ExitEpochAndUpdateChurn(state *pb.BeaconState, idx uint64) (uint64, error) {
+	if idx >= uint64(len(state.Validators)) {
+		return 0, fmt.Errorf("validator index %d out of range", idx)
+	}
+	validator := state.Validators[idx]
+	if validator.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+		return validator.ExitEpoch, nil
+	}
+	if validator.ActivationEpoch == params.BeaconConfig().FarFutureEpoch {
+		return 0, fmt.Errorf("validator %d is not activated", idx)
+	}
+	earliestEpoch := mathutil.Max(
+		helpers.CurrentEpoch(state),
+		validator.ActivationEpoch+params.BeaconConfig().PersistentCommitteePeriod,
+	)
+	exitEpoch, err := exitQueueEpoch(state, earliestEpoch)
+	if err != nil {
+		return 0, err
+	}
+	validator.ExitEpoch = exitEpoch
+	validator.WithdrawableEpoch = exitEpoch + params.BeaconConfig().MinValidatorWithdrawabilityDelay
+	return exitEpoch, nil
+}
+
+// exitQueueEpoch returns the epoch the next exit initiated at the given epoch is queued for: the
+// latest exit epoch of the state, or the activation exit epoch of the given epoch if later, moved to
+// the next epoch if its churn limit is already reached.
+func exitQueueEpoch(state *pb.BeaconState, epoch uint64) (uint64, error) {
 	exitEpochs := []uint64{}
 	for _, val := range state.Validators {
 		if val.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
 			exitEpochs = append(exitEpochs, val.ExitEpoch)
 		}
 	}
-	exitEpochs = append(exitEpochs, helpers.DelayedActivationExitEpoch(helpers.CurrentEpoch(state)))
+	exitEpochs = append(exitEpochs, helpers.DelayedActivationExitEpoch(epoch))
 
 	// Obtain the exit queue epoch as the maximum number in the exit epochs array.
-	exitQueueEpoch := uint64(0)
+	queueEpoch := uint64(0)
 	for _, i := range exitEpochs {
-		if exitQueueEpoch < i {
-			exitQueueEpoch = i
+		if queueEpoch < i {
+			queueEpoch = i
 		}
 	}
 
 	// We use the exit queue churn to determine if we have passed a churn limit.
 	exitQueueChurn := 0
 	for _, val := range state.Validators {
-		if val.ExitEpoch == exitQueueEpoch {
+		if val.ExitEpoch == queueEpoch {
 			exitQueueChurn++
 		}
 	}
 	activeValidatorCount, err := helpers.ActiveValidatorCount(state, helpers.CurrentEpoch(state))
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get active validator count")
+		return 0, errors.Wrap(err, "could not get active validator count")
 	}
 	churn, err := helpers.ValidatorChurnLimit(activeValidatorCount)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get churn limit")
+		return 0, errors.Wrap(err, "could not get churn limit")
 	}
 
 	if uint64(exitQueueChurn) >= churn {
-		exitQueueEpoch++
+		queueEpoch++
 	}
-	state.Validators[idx].ExitEpoch = exitQueueEpoch
-	state.Validators[idx].WithdrawableEpoch = exitQueueEpoch + params.BeaconConfig().MinValidatorWithdrawabilityDelay
-	return state, nil
+	return queueEpoch, nil
 }
 
 // SlashValidator slashes the malicious validator's balance and awards
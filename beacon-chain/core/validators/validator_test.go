@@ -336,3 +336,95 @@ func TestExitedValidatorIndices(t *testing.T) {
 		}
 	}
 }
+
+func TestExitEpochAndUpdateChurn_SpreadsExitsAcrossEpochs(t *testing.T) {
+	currentEpoch := params.BeaconConfig().PersistentCommitteePeriod
+	validators := make([]*ethpb.Validator, 64)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	state := &pb.BeaconState{
+		Slot:       helpers.StartSlot(currentEpoch),
+		Validators: validators,
+	}
+	churn, err := helpers.ValidatorChurnLimit(uint64(len(validators)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	firstExitEpoch := helpers.DelayedActivationExitEpoch(currentEpoch)
+	for i := uint64(0); i < 2*churn+2; i++ {
+		exitEpoch, err := ExitEpochAndUpdateChurn(state, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Every epoch of the queue takes as many exits as the churn limit allows.
+		wanted := firstExitEpoch + i/churn
+		if exitEpoch != wanted {
+			t.Errorf("Validator %d: wanted exit epoch %d, received %d", i, wanted, exitEpoch)
+		}
+		if state.Validators[i].ExitEpoch != wanted {
+			t.Errorf("Validator %d: wanted exit epoch %d in state, received %d", i, wanted, state.Validators[i].ExitEpoch)
+		}
+		wantedWithdrawable := wanted + params.BeaconConfig().MinValidatorWithdrawabilityDelay
+		if state.Validators[i].WithdrawableEpoch != wantedWithdrawable {
+			t.Errorf("Validator %d: wanted withdrawable epoch %d, received %d", i, wantedWithdrawable, state.Validators[i].WithdrawableEpoch)
+		}
+	}
+
+	// A validator which already initiated its exit keeps its exit epoch.
+	exitEpoch, err := ExitEpochAndUpdateChurn(state, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exitEpoch != firstExitEpoch {
+		t.Errorf("Wanted exit epoch %d, received %d", firstExitEpoch, exitEpoch)
+	}
+}
+
+func TestExitEpochAndUpdateChurn_WaitsForMinimumActivePeriod(t *testing.T) {
+	currentEpoch := params.BeaconConfig().PersistentCommitteePeriod
+	activationEpoch := currentEpoch - 10
+	state := &pb.BeaconState{
+		Slot: helpers.StartSlot(currentEpoch),
+		Validators: []*ethpb.Validator{
+			{
+				ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+				WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+			},
+			{
+				ActivationEpoch:   activationEpoch,
+				ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+				WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+			},
+		},
+	}
+	exitEpoch, err := ExitEpochAndUpdateChurn(state, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := helpers.DelayedActivationExitEpoch(activationEpoch + params.BeaconConfig().PersistentCommitteePeriod)
+	if exitEpoch != wanted {
+		t.Errorf("Wanted exit epoch %d, received %d", wanted, exitEpoch)
+	}
+}
+
+func TestExitEpochAndUpdateChurn_NotActivated(t *testing.T) {
+	state := &pb.BeaconState{
+		Validators: []*ethpb.Validator{
+			{
+				ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+				ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			},
+		},
+	}
+	if _, err := ExitEpochAndUpdateChurn(state, 0); err == nil {
+		t.Error("Expected error for a validator which is not activated")
+	}
+	if _, err := ExitEpochAndUpdateChurn(state, 1); err == nil {
+		t.Error("Expected error for an unknown validator index")
+	}
+}
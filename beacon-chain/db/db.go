@@ -2,7 +2,7 @@ package db
 
 import "github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 
-// NewDB initializes a new DB.
-func NewDB(dirPath string) (Database, error) {
-	return kv.NewKVStore(dirPath)
+// NewDB initializes a new DB which archives a state every slots per archived point.
+func NewDB(dirPath string, slotsPerArchivedPoint uint64) (Database, error) {
+	return kv.NewKVStore(dirPath, slotsPerArchivedPoint)
 }
@@ -5,9 +5,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 )
 
-// NewDB initializes a new DB with kafka wrapper.
-func NewDB(dirPath string) (Database, error) {
-	db, err := kv.NewKVStore(dirPath)
+// NewDB initializes a new DB with kafka wrapper, which archives a state every slots per archived point.
+func NewDB(dirPath string, slotsPerArchivedPoint uint64) (Database, error) {
+	db, err := kv.NewKVStore(dirPath, slotsPerArchivedPoint)
 	if err != nil {
 		return nil, err
 	}
@@ -29,6 +29,7 @@ type ReadOnlyDatabase interface {
 	// Validator related methods.
 	ValidatorIndex(ctx context.Context, publicKey []byte) (uint64, bool, error)
 	HasValidatorIndex(ctx context.Context, publicKey []byte) bool
+	PublicKeyForIndex(ctx context.Context, validatorIdx uint64) ([48]byte, error)
 	// State related methods.
 	State(ctx context.Context, blockRoot [32]byte) (*ethereum_beacon_p2p_v1.BeaconState, error)
 	GenesisState(ctx context.Context) (*ethereum_beacon_p2p_v1.BeaconState, error)
@@ -38,6 +39,7 @@ type ReadOnlyDatabase interface {
 	AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.AttesterSlashing, error)
 	HasProposerSlashing(ctx context.Context, slashingRoot [32]byte) bool
 	HasAttesterSlashing(ctx context.Context, slashingRoot [32]byte) bool
+	HasProposedAt(ctx context.Context, validatorIdx uint64, slot uint64) (bool, error)
 	// Block operations.
 	VoluntaryExit(ctx context.Context, exitRoot [32]byte) (*eth.VoluntaryExit, error)
 	HasVoluntaryExit(ctx context.Context, exitRoot [32]byte) bool
@@ -49,8 +51,10 @@ type ReadOnlyDatabase interface {
 	ArchivedCommitteeInfo(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedCommitteeInfo, error)
 	ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error)
 	ArchivedValidatorParticipation(ctx context.Context, epoch uint64) (*eth.ValidatorParticipation, error)
+	NearestArchivedState(ctx context.Context, slot uint64) (*ethereum_beacon_p2p_v1.BeaconState, error)
 	// Deposit contract related handlers.
 	DepositContractAddress(ctx context.Context) ([]byte, error)
+	DepositTrieNode(ctx context.Context, layer uint64, index uint64) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
 }
@@ -73,6 +77,7 @@ type NoHeadAccessDatabase interface {
 	// Validator related methods.
 	DeleteValidatorIndex(ctx context.Context, publicKey []byte) error
 	SaveValidatorIndex(ctx context.Context, publicKey []byte, validatorIdx uint64) error
+	SaveValidatorIndexStrict(ctx context.Context, publicKey []byte, validatorIdx uint64) error
 	SaveValidatorIndices(ctx context.Context, publicKeys [][]byte, validatorIndices []uint64) error
 	// State related methods.
 	SaveState(ctx context.Context, state *ethereum_beacon_p2p_v1.BeaconState, blockRoot [32]byte) error
@@ -83,6 +88,7 @@ type NoHeadAccessDatabase interface {
 	SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error
 	DeleteProposerSlashing(ctx context.Context, slashingRoot [32]byte) error
 	DeleteAttesterSlashing(ctx context.Context, slashingRoot [32]byte) error
+	SaveProposalHistory(ctx context.Context, validatorIdx uint64, slot uint64, signingRoot [32]byte) error
 	// Block operations.
 	SaveVoluntaryExit(ctx context.Context, exit *eth.VoluntaryExit) error
 	DeleteVoluntaryExit(ctx context.Context, exitRoot [32]byte) error
@@ -94,8 +100,10 @@ type NoHeadAccessDatabase interface {
 	SaveArchivedCommitteeInfo(ctx context.Context, epoch uint64, info *ethereum_beacon_p2p_v1.ArchivedCommitteeInfo) error
 	SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error
 	SaveArchivedValidatorParticipation(ctx context.Context, epoch uint64, part *eth.ValidatorParticipation) error
+	SaveArchivedPointState(ctx context.Context, state *ethereum_beacon_p2p_v1.BeaconState) error
 	// Deposit contract related handlers.
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
+	SaveDepositTrieNodes(ctx context.Context, layer uint64, startIndex uint64, nodes [][]byte) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
 }
@@ -109,6 +117,7 @@ type HeadAccessDatabase interface {
 	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	HeadState(ctx context.Context) (*ethereum_beacon_p2p_v1.BeaconState, error)
+	SaveStateFromSSZ(ctx context.Context, enc []byte) error
 }
 
 // Database -- See github.com/prysmaticlabs/prysm/beacon-chain/db.Database
@@ -96,6 +96,11 @@ func (e Exporter) HasValidatorIndex(ctx context.Context, publicKey []byte) bool
 	return e.db.HasValidatorIndex(ctx, publicKey)
 }
 
+// PublicKeyForIndex -- passthrough.
+func (e Exporter) PublicKeyForIndex(ctx context.Context, validatorIdx uint64) ([48]byte, error) {
+	return e.db.PublicKeyForIndex(ctx, validatorIdx)
+}
+
 // DeleteValidatorIndex -- passthrough.
 func (e Exporter) DeleteValidatorIndex(ctx context.Context, publicKey []byte) error {
 	return e.db.DeleteValidatorIndex(ctx, publicKey)
@@ -146,6 +151,11 @@ func (e Exporter) DeleteAttesterSlashing(ctx context.Context, slashingRoot [32]b
 	return e.db.DeleteAttesterSlashing(ctx, slashingRoot)
 }
 
+// HasProposedAt -- passthrough.
+func (e Exporter) HasProposedAt(ctx context.Context, validatorIdx uint64, slot uint64) (bool, error) {
+	return e.db.HasProposedAt(ctx, validatorIdx, slot)
+}
+
 // VoluntaryExit -- passthrough.
 func (e Exporter) VoluntaryExit(ctx context.Context, exitRoot [32]byte) (*eth.VoluntaryExit, error) {
 	return e.db.VoluntaryExit(ctx, exitRoot)
@@ -191,11 +201,21 @@ func (e Exporter) ArchivedValidatorParticipation(ctx context.Context, epoch uint
 	return e.db.ArchivedValidatorParticipation(ctx, epoch)
 }
 
+// NearestArchivedState -- passthrough.
+func (e Exporter) NearestArchivedState(ctx context.Context, slot uint64) (*ethereum_beacon_p2p_v1.BeaconState, error) {
+	return e.db.NearestArchivedState(ctx, slot)
+}
+
 // DepositContractAddress -- passthrough.
 func (e Exporter) DepositContractAddress(ctx context.Context) ([]byte, error) {
 	return e.db.DepositContractAddress(ctx)
 }
 
+// DepositTrieNode -- passthrough.
+func (e Exporter) DepositTrieNode(ctx context.Context, layer uint64, index uint64) ([]byte, error) {
+	return e.db.DepositTrieNode(ctx, layer, index)
+}
+
 // SaveHeadBlockRoot -- passthrough.
 func (e Exporter) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	return e.db.SaveHeadBlockRoot(ctx, blockRoot)
@@ -216,6 +236,11 @@ func (e Exporter) SaveValidatorIndex(ctx context.Context, publicKey []byte, vali
 	return e.db.SaveValidatorIndex(ctx, publicKey, validatorIdx)
 }
 
+// SaveValidatorIndexStrict -- passthrough.
+func (e Exporter) SaveValidatorIndexStrict(ctx context.Context, publicKey []byte, validatorIdx uint64) error {
+	return e.db.SaveValidatorIndexStrict(ctx, publicKey, validatorIdx)
+}
+
 // SaveValidatorIndices -- passthrough.
 func (e Exporter) SaveValidatorIndices(ctx context.Context, publicKeys [][]byte, validatorIndices []uint64) error {
 	return e.db.SaveValidatorIndices(ctx, publicKeys, validatorIndices)
@@ -226,6 +251,11 @@ func (e Exporter) SaveState(ctx context.Context, state *ethereum_beacon_p2p_v1.B
 	return e.db.SaveState(ctx, state, blockRoot)
 }
 
+// SaveStateFromSSZ -- passthrough.
+func (e Exporter) SaveStateFromSSZ(ctx context.Context, enc []byte) error {
+	return e.db.SaveStateFromSSZ(ctx, enc)
+}
+
 // SaveProposerSlashing -- passthrough.
 func (e Exporter) SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error {
 	return e.db.SaveProposerSlashing(ctx, slashing)
@@ -236,6 +266,11 @@ func (e Exporter) SaveAttesterSlashing(ctx context.Context, slashing *eth.Attest
 	return e.db.SaveAttesterSlashing(ctx, slashing)
 }
 
+// SaveProposalHistory -- passthrough.
+func (e Exporter) SaveProposalHistory(ctx context.Context, validatorIdx uint64, slot uint64, signingRoot [32]byte) error {
+	return e.db.SaveProposalHistory(ctx, validatorIdx, slot, signingRoot)
+}
+
 // SaveVoluntaryExit -- passthrough.
 func (e Exporter) SaveVoluntaryExit(ctx context.Context, exit *eth.VoluntaryExit) error {
 	return e.db.SaveVoluntaryExit(ctx, exit)
@@ -271,11 +306,21 @@ func (e Exporter) SaveArchivedValidatorParticipation(ctx context.Context, epoch
 	return e.db.SaveArchivedValidatorParticipation(ctx, epoch, part)
 }
 
+// SaveArchivedPointState -- passthrough.
+func (e Exporter) SaveArchivedPointState(ctx context.Context, state *ethereum_beacon_p2p_v1.BeaconState) error {
+	return e.db.SaveArchivedPointState(ctx, state)
+}
+
 // SaveDepositContractAddress -- passthrough.
 func (e Exporter) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
 	return e.db.SaveDepositContractAddress(ctx, addr)
 }
 
+// SaveDepositTrieNodes -- passthrough.
+func (e Exporter) SaveDepositTrieNodes(ctx context.Context, layer uint64, startIndex uint64, nodes [][]byte) error {
+	return e.db.SaveDepositTrieNodes(ctx, layer, startIndex, nodes)
+}
+
 // DeleteState -- passthrough.
 func (e Exporter) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 	return e.db.DeleteState(ctx, blockRoot)
@@ -0,0 +1,69 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"go.opencensus.io/trace"
+)
+
+// SaveArchivedPointState stores the state as the archived point of the archive period its slot
+// falls into. Every period of slots per archived point keeps the earliest state saved for it, so
+// saving a state for a period which already has an earlier or equal one is a no-op.
+func (k *Store) SaveArchivedPointState(ctx context.Context, state *pb.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedPointState")
+	defer span.End()
+	if state == nil {
+		return errors.New("nil state")
+	}
+	enc, err := encode(state)
+	if err != nil {
+		return err
+	}
+	buf := uint64ToBytes(state.Slot / k.slotsPerArchivedPoint)
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedPointStateBucket)
+		if existing := bucket.Get(buf); existing != nil {
+			s, err := createState(existing)
+			if err != nil {
+				return err
+			}
+			if s.Slot <= state.Slot {
+				return nil
+			}
+		}
+		return bucket.Put(buf, enc)
+	})
+}
+
+// NearestArchivedState returns the archived state with the highest slot lower than or equal to
+// the given slot, from which the state at that slot can be regenerated by replaying blocks. It
+// returns nil if no such state was archived.
+func (k *Store) NearestArchivedState(ctx context.Context, slot uint64) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.NearestArchivedState")
+	defer span.End()
+	var s *pb.BeaconState
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedPointStateBucket)
+		// The archived state of the slot's period may come after the slot if the period starts
+		// with skipped slots, in which case the previous periods are looked up.
+		for index := int64(slot / k.slotsPerArchivedPoint); index >= 0; index-- {
+			enc := bucket.Get(uint64ToBytes(uint64(index)))
+			if enc == nil {
+				continue
+			}
+			archived, err := createState(enc)
+			if err != nil {
+				return err
+			}
+			if archived.Slot <= slot {
+				s = archived
+				return nil
+			}
+		}
+		return nil
+	})
+	return s, err
+}
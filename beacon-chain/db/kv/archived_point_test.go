@@ -0,0 +1,135 @@
+package kv
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestStore_ArchivedPointState_KeepsEarliestStateOfPeriod(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	db.slotsPerArchivedPoint = 32
+
+	for _, slot := range []uint64{40, 35, 50, 70} {
+		if err := db.SaveArchivedPointState(ctx, &pb.BeaconState{Slot: slot}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		slot     uint64
+		wantSlot uint64
+		wantNil  bool
+	}{
+		{slot: 0, wantNil: true},
+		{slot: 34, wantNil: true},
+		{slot: 35, wantSlot: 35},
+		{slot: 69, wantSlot: 35},
+		{slot: 70, wantSlot: 70},
+		{slot: 1000, wantSlot: 70},
+	}
+	for _, tt := range tests {
+		s, err := db.NearestArchivedState(ctx, tt.slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.wantNil {
+			if s != nil {
+				t.Errorf("Slot %d: wanted no archived state, received state at slot %d", tt.slot, s.Slot)
+			}
+			continue
+		}
+		if s == nil || s.Slot != tt.wantSlot {
+			t.Errorf("Slot %d: wanted archived state at slot %d, received %v", tt.slot, tt.wantSlot, s)
+		}
+	}
+}
+
+func TestStore_NearestArchivedState_ReplaysToIntermediateState(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	db.slotsPerArchivedPoint = 32
+
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	if err := db.SaveArchivedPointState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	// The first slots of the second archive period are skipped, so its archived point
+	// is the state of the first block after them.
+	skipped := map[uint64]bool{32: true, 33: true, 50: true}
+	stateRoots := make(map[uint64][32]byte)
+	for slot := uint64(1); slot <= 80; slot++ {
+		if skipped[slot] {
+			continue
+		}
+		blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{}, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		stateRoots[slot], err = stateutil.HashTreeRootState(beaconState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveArchivedPointState(ctx, beaconState); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		slot         uint64
+		archivedSlot uint64
+	}{
+		{slot: 31, archivedSlot: 0},
+		{slot: 33, archivedSlot: 0},
+		{slot: 63, archivedSlot: 34},
+		{slot: 75, archivedSlot: 64},
+	}
+	for _, tt := range tests {
+		archived, err := db.NearestArchivedState(ctx, tt.slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if archived == nil || archived.Slot != tt.archivedSlot {
+			t.Fatalf("Slot %d: wanted archived state at slot %d, received %v", tt.slot, tt.archivedSlot, archived)
+		}
+		if _, ok := stateRoots[tt.slot]; !ok {
+			continue
+		}
+
+		blks, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(archived.Slot+1).SetEndSlot(tt.slot))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Slice(blks, func(i, j int) bool { return blks[i].Block.Slot < blks[j].Block.Slot })
+		replayed := archived
+		for _, blk := range blks {
+			replayed, err = state.ExecuteStateTransition(ctx, replayed, blk)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		root, err := stateutil.HashTreeRootState(replayed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != stateRoots[tt.slot] {
+			t.Errorf("Slot %d: wanted replayed state root %#x, received %#x", tt.slot, stateRoots[tt.slot], root)
+		}
+	}
+}
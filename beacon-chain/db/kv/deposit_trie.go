@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/boltdb/bolt"
+	"go.opencensus.io/trace"
+)
+
+// DepositTrieNode returns the deposit trie node of the given layer and index moved out of memory
+// by the bounded deposit trie, or nil if no such node was saved.
+func (k *Store) DepositTrieNode(ctx context.Context, layer uint64, index uint64) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositTrieNode")
+	defer span.End()
+	var node []byte
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(depositTrieNodesBucket)
+		if enc := bkt.Get(depositTrieNodeKey(layer, index)); enc != nil {
+			node = make([]byte, len(enc))
+			copy(node, enc)
+		}
+		return nil
+	})
+	return node, err
+}
+
+// SaveDepositTrieNodes saves consecutive nodes of a deposit trie layer, the first one being at the
+// given index of the layer.
+func (k *Store) SaveDepositTrieNodes(ctx context.Context, layer uint64, startIndex uint64, nodes [][]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositTrieNodes")
+	defer span.End()
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(depositTrieNodesBucket)
+		for i, node := range nodes {
+			if err := bkt.Put(depositTrieNodeKey(layer, startIndex+uint64(i)), node); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func depositTrieNodeKey(layer uint64, index uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key[:8], layer)
+	binary.BigEndian.PutUint64(key[8:], index)
+	return key
+}
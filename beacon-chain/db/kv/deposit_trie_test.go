@@ -0,0 +1,34 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestStore_DepositTrieNodes(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	nodes := [][]byte{[]byte("node4"), []byte("node5"), []byte("node6")}
+	if err := db.SaveDepositTrieNodes(ctx, 2, 4, nodes); err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range nodes {
+		node, err := db.DepositTrieNode(ctx, 2, uint64(4+i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(node, want) {
+			t.Errorf("Wanted node %q at index %d, received %q", want, 4+i, node)
+		}
+	}
+	node, err := db.DepositTrieNode(ctx, 3, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node != nil {
+		t.Errorf("Wanted no node at layer 3, received %q", node)
+	}
+}
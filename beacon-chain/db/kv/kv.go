@@ -32,16 +32,21 @@ var BlockCacheSize = int64(1 << 21)
 // Store defines an implementation of the Prysm Database interface
 // using BoltDB as the underlying persistent kv-store for eth2.
 type Store struct {
-	db                  *bolt.DB
-	databasePath        string
-	blockCache          *ristretto.Cache
-	validatorIndexCache *ristretto.Cache
+	db                    *bolt.DB
+	databasePath          string
+	blockCache            *ristretto.Cache
+	validatorIndexCache   *ristretto.Cache
+	slotsPerArchivedPoint uint64
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
-// an open connection db object as a property of the Store struct.
-func NewKVStore(dirPath string) (*Store, error) {
+// an open connection db object as a property of the Store struct. A state is archived every
+// slots per archived point, which must be positive.
+func NewKVStore(dirPath string, slotsPerArchivedPoint uint64) (*Store, error) {
+	if slotsPerArchivedPoint == 0 {
+		return nil, errors.New("slots per archived point must be positive")
+	}
 	if err := os.MkdirAll(dirPath, 0700); err != nil {
 		return nil, err
 	}
@@ -73,10 +78,11 @@ func NewKVStore(dirPath string) (*Store, error) {
 	}
 
 	kv := &Store{
-		db:                  boltDB,
-		databasePath:        dirPath,
-		blockCache:          blockCache,
-		validatorIndexCache: validatorCache,
+		db:                    boltDB,
+		databasePath:          dirPath,
+		blockCache:            blockCache,
+		validatorIndexCache:   validatorCache,
+		slotsPerArchivedPoint: slotsPerArchivedPoint,
 	}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
@@ -95,7 +101,10 @@ func NewKVStore(dirPath string) (*Store, error) {
 			archivedCommitteeInfoBucket,
 			archivedBalancesBucket,
 			archivedValidatorParticipationBucket,
+			archivedPointStateBucket,
 			powchainBucket,
+			proposalHistoryBucket,
+			depositTrieNodesBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -105,6 +114,7 @@ func NewKVStore(dirPath string) (*Store, error) {
 			blockSlotIndicesBucket,
 			blockParentRootIndicesBucket,
 			finalizedBlockRootsIndexBucket,
+			validatorIndicesBucket,
 			// Migration bucket.
 			migrationBucket,
 		)
@@ -116,6 +126,10 @@ func NewKVStore(dirPath string) (*Store, error) {
 		return nil, err
 	}
 
+	if err := kv.backfillValidatorIndices(); err != nil {
+		return nil, err
+	}
+
 	err = prometheus.Register(createBoltCollector(kv.db))
 
 	return kv, err
@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+)
+
+// ErrConflictingProposal is returned when a validator already proposed a block with a different
+// signing root at the same slot.
+var ErrConflictingProposal = errors.New("validator already proposed a different block at this slot")
+
+// HasProposedAt returns true if a proposal by the validator index is recorded at the given slot.
+func (k *Store) HasProposedAt(ctx context.Context, validatorIdx uint64, slot uint64) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasProposedAt")
+	defer span.End()
+	exists := false
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(proposalHistoryBucket)
+		exists = bkt.Get(proposalHistoryKey(validatorIdx, slot)) != nil
+		return nil
+	})
+	return exists, err
+}
+
+// SaveProposalHistory records the signing root of the block proposed by the validator index at
+// the given slot. Saving the same signing root again is a no-op, while saving a different signing
+// root for a slot which already has a proposal returns ErrConflictingProposal and leaves the
+// existing record untouched.
+func (k *Store) SaveProposalHistory(ctx context.Context, validatorIdx uint64, slot uint64, signingRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveProposalHistory")
+	defer span.End()
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(proposalHistoryBucket)
+		key := proposalHistoryKey(validatorIdx, slot)
+		if existing := bkt.Get(key); existing != nil {
+			if !bytes.Equal(existing, signingRoot[:]) {
+				return ErrConflictingProposal
+			}
+			return nil
+		}
+		return bkt.Put(key, signingRoot[:])
+	})
+}
+
+// proposalHistoryKey is the validator index followed by the slot, both big endian encoded so
+// the proposals of a validator are stored contiguously and in slot order.
+func proposalHistoryKey(validatorIdx uint64, slot uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key[:8], validatorIdx)
+	binary.BigEndian.PutUint64(key[8:], slot)
+	return key
+}
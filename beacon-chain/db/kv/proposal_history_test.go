@@ -0,0 +1,69 @@
+package kv
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStore_ProposalHistory_NoHistory(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	proposed, err := db.HasProposedAt(ctx, 1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposed {
+		t.Error("Expected no proposal to be recorded")
+	}
+}
+
+func TestStore_ProposalHistory_SameRoot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	root := [32]byte{'A'}
+
+	if err := db.SaveProposalHistory(ctx, 1, 5, root); err != nil {
+		t.Fatal(err)
+	}
+	proposed, err := db.HasProposedAt(ctx, 1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proposed {
+		t.Error("Expected proposal to be recorded")
+	}
+	if err := db.SaveProposalHistory(ctx, 1, 5, root); err != nil {
+		t.Errorf("Expected saving the same signing root again to succeed, received %v", err)
+	}
+
+	// Proposals at other slots or by other validators are tracked separately.
+	for _, tt := range []struct{ idx, slot uint64 }{{1, 6}, {2, 5}} {
+		proposed, err := db.HasProposedAt(ctx, tt.idx, tt.slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proposed {
+			t.Errorf("Expected no proposal for validator %d at slot %d", tt.idx, tt.slot)
+		}
+	}
+}
+
+func TestStore_ProposalHistory_ConflictingRoot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveProposalHistory(ctx, 1, 5, [32]byte{'A'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveProposalHistory(ctx, 1, 5, [32]byte{'B'}); err != ErrConflictingProposal {
+		t.Errorf("Expected %v, received %v", ErrConflictingProposal, err)
+	}
+	// The conflicting root must not replace the recorded one.
+	if err := db.SaveProposalHistory(ctx, 1, 5, [32]byte{'A'}); err != nil {
+		t.Errorf("Expected the original signing root to still be recorded, received %v", err)
+	}
+}
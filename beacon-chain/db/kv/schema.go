@@ -20,7 +20,10 @@ var (
 	archivedCommitteeInfoBucket          = []byte("archived-committee-info")
 	archivedBalancesBucket               = []byte("archived-balances")
 	archivedValidatorParticipationBucket = []byte("archived-validator-participation")
+	archivedPointStateBucket             = []byte("archived-point-state")
 	powchainBucket                       = []byte("powchain")
+	proposalHistoryBucket                = []byte("proposal-history")
+	depositTrieNodesBucket               = []byte("deposit-trie-nodes")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -31,6 +34,7 @@ var (
 	attestationTargetRootIndicesBucket  = []byte("attestation-target-root-indices")
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
+	validatorIndicesBucket              = []byte("validator-indices")
 
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
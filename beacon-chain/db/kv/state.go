@@ -5,9 +5,13 @@ import (
 	"context"
 
 	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
 	"go.opencensus.io/trace"
 )
 
@@ -98,6 +102,54 @@ func (k *Store) SaveState(ctx context.Context, state *pb.BeaconState, blockRoot
 	})
 }
 
+// SaveStateFromSSZ decodes an SSZ encoded state, checks it against the state roots it commits
+// to and stores it as the head state, under the root of its latest block. This is used to start
+// a node from a trusted state rather than from genesis.
+func (k *Store) SaveStateFromSSZ(ctx context.Context, enc []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateFromSSZ")
+	defer span.End()
+	state := &pb.BeaconState{}
+	if err := ssz.Unmarshal(enc, state); err != nil {
+		return errors.Wrap(err, "could not unmarshal state")
+	}
+	if state.LatestBlockHeader == nil {
+		return errors.New("state has no latest block header")
+	}
+	header := proto.Clone(state.LatestBlockHeader).(*ethpb.BeaconBlockHeader)
+	if header.Slot > state.Slot {
+		return errors.Errorf("latest block header slot %d is ahead of state slot %d", header.Slot, state.Slot)
+	}
+	if state.Slot >= header.Slot+params.BeaconConfig().SlotsPerHistoricalRoot {
+		return errors.Errorf("latest block header slot %d is too far behind state slot %d", header.Slot, state.Slot)
+	}
+	stateRoot, err := stateutil.HashTreeRootState(state)
+	if err != nil {
+		return errors.Wrap(err, "could not compute state root")
+	}
+	// The state root of the latest block header is only filled in when processing the next
+	// slot, from then on it is also recorded in the state roots of the state.
+	if header.Slot == state.Slot {
+		zeroHash := params.BeaconConfig().ZeroHash
+		if !bytes.Equal(header.StateRoot, zeroHash[:]) && !bytes.Equal(header.StateRoot, stateRoot[:]) {
+			return errors.Errorf("latest block header state root %#x does not match state root %#x", header.StateRoot, stateRoot)
+		}
+		header.StateRoot = stateRoot[:]
+	} else {
+		wanted := state.StateRoots[header.Slot%params.BeaconConfig().SlotsPerHistoricalRoot]
+		if !bytes.Equal(header.StateRoot, wanted) {
+			return errors.Errorf("latest block header state root %#x does not match state root %#x at slot %d", header.StateRoot, wanted, header.Slot)
+		}
+	}
+	blockRoot, err := ssz.HashTreeRoot(header)
+	if err != nil {
+		return errors.Wrap(err, "could not compute block root")
+	}
+	if err := k.SaveState(ctx, state, blockRoot); err != nil {
+		return err
+	}
+	return k.SaveHeadBlockRoot(ctx, blockRoot)
+}
+
 // HasState checks if a state by root exists in the db.
 func (k *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasState")
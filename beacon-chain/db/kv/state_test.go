@@ -3,12 +3,17 @@ package kv
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 func TestState_CanSaveRetrieve(t *testing.T) {
@@ -255,3 +260,99 @@ func TestStore_DeleteHeadState(t *testing.T) {
 		t.Error("Did not receive wanted error")
 	}
 }
+
+func TestStore_SaveStateFromSSZ(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	enc, err := ssz.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateFromSSZ(ctx, enc); err != nil {
+		t.Fatal(err)
+	}
+
+	stateRoot, err := stateutil.HashTreeRootState(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := proto.Clone(s.LatestBlockHeader).(*ethpb.BeaconBlockHeader)
+	header.StateRoot = stateRoot[:]
+	blockRoot, err := ssz.HashTreeRoot(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasState(ctx, blockRoot) {
+		t.Error("Expected state to be saved under the root of its latest block")
+	}
+	headState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(headState, s) {
+		t.Error("Expected imported state to be the head state")
+	}
+}
+
+func TestStore_SaveStateFromSSZ_PastHeader(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	s.Slot = params.BeaconConfig().SlotsPerEpoch
+	headerStateRoot := bytesutil.Bytes32(1)
+	s.LatestBlockHeader.StateRoot = headerStateRoot
+	s.StateRoots[0] = headerStateRoot
+	enc, err := ssz.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateFromSSZ(ctx, enc); err != nil {
+		t.Fatal(err)
+	}
+
+	s.StateRoots[0] = bytesutil.Bytes32(2)
+	enc, err = ssz.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateFromSSZ(ctx, enc); err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Errorf("Expected state root mismatch error, received %v", err)
+	}
+}
+
+func TestStore_SaveStateFromSSZ_Invalid(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	enc, err := ssz.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateFromSSZ(ctx, enc[:len(enc)/2]); err == nil || !strings.Contains(err.Error(), "could not unmarshal state") {
+		t.Errorf("Expected unmarshal error, received %v", err)
+	}
+
+	s.LatestBlockHeader.StateRoot = bytesutil.Bytes32(1)
+	enc, err = ssz.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateFromSSZ(ctx, enc); err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Errorf("Expected state root mismatch error, received %v", err)
+	}
+
+	headState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headState != nil {
+		t.Error("Expected no head state to be saved")
+	}
+}
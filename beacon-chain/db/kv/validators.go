@@ -1,16 +1,42 @@
 package kv
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
 
 	"github.com/boltdb/bolt"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
+// ErrNotFoundPublicKey is returned when no public key is mapped to a validator index.
+var ErrNotFoundPublicKey = errors.New("no public key found for validator index")
+
+// ErrValidatorIndexConflict is returned when a strict save would remap a public key or a
+// validator index already mapped to something else.
+var ErrValidatorIndexConflict = errors.New("validator index conflicts with an existing mapping")
+
+var backfillValidatorIndicesKey = []byte("backfill-validator-indices")
+
+var (
+	// validatorIndexCacheHit tracks the number of validator index lookups served from the cache.
+	validatorIndexCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_index_cache_hit",
+		Help: "The number of validator index requests that are present in the cache.",
+	})
+	// validatorIndexCacheMiss tracks the number of validator index lookups that had to read the db.
+	validatorIndexCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_index_cache_miss",
+		Help: "The number of validator index requests that aren't present in the cache.",
+	})
+)
+
 // ValidatorIndex by public key.
 func (k *Store) ValidatorIndex(ctx context.Context, publicKey []byte) (uint64, bool, error) {
 	if len(publicKey) != params.BeaconConfig().BLSPubkeyLength {
@@ -18,8 +44,10 @@ func (k *Store) ValidatorIndex(ctx context.Context, publicKey []byte) (uint64, b
 	}
 	// Return latest validatorIndex from cache if it exists.
 	if v, ok := k.validatorIndexCache.Get(string(publicKey)); v != nil && ok {
+		validatorIndexCacheHit.Inc()
 		return v.(uint64), true, nil
 	}
+	validatorIndexCacheMiss.Inc()
 	var validatorIdx uint64
 	var ok bool
 	err := k.db.View(func(tx *bolt.Tx) error {
@@ -33,6 +61,10 @@ func (k *Store) ValidatorIndex(ctx context.Context, publicKey []byte) (uint64, b
 		ok = true
 		return nil
 	})
+	if err == nil && ok {
+		// Populate the cache so repeated lookups of the same key avoid the db.
+		k.validatorIndexCache.Set(string(publicKey), validatorIdx, 8)
+	}
 	return validatorIdx, ok, err
 }
 
@@ -53,15 +85,42 @@ func (k *Store) HasValidatorIndex(ctx context.Context, publicKey []byte) bool {
 	return exists
 }
 
+// PublicKeyForIndex returns the public key of a validator by its index.
+func (k *Store) PublicKeyForIndex(ctx context.Context, validatorIdx uint64) ([48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PublicKeyForIndex")
+	defer span.End()
+	var publicKey [48]byte
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorIndicesBucket)
+		enc := bkt.Get(uint64ToBytes(validatorIdx))
+		if enc == nil {
+			return ErrNotFoundPublicKey
+		}
+		copy(publicKey[:], enc)
+		return nil
+	})
+	return publicKey, err
+}
+
 // DeleteValidatorIndex clears a validator index from the db by the validator's public key.
 func (k *Store) DeleteValidatorIndex(ctx context.Context, publicKey []byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteValidatorIndex")
 	defer span.End()
-	return k.db.Update(func(tx *bolt.Tx) error {
+	if err := k.db.Update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(validatorsBucket)
-		k.validatorIndexCache.Del(string(publicKey))
+		if enc := bucket.Get(publicKey); enc != nil {
+			if err := deleteReverseIndex(tx, enc, publicKey); err != nil {
+				return err
+			}
+		}
 		return bucket.Delete(publicKey)
-	})
+	}); err != nil {
+		return err
+	}
+	// Only evict the index once the deletion is committed, so a concurrent read cannot
+	// cache the index again before it is gone from the db.
+	k.validatorIndexCache.Del(string(publicKey))
+	return nil
 }
 
 // SaveValidatorIndex by public key in the db.
@@ -71,15 +130,51 @@ func (k *Store) SaveValidatorIndex(ctx context.Context, publicKey []byte, valida
 	}
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorIndex")
 	defer span.End()
-	return k.db.Update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(validatorsBucket)
-		buf := uint64ToBytes(validatorIdx)
-		k.validatorIndexCache.Set(string(publicKey), validatorIdx, int64(len(buf)))
-		return bucket.Put(publicKey, buf)
-	})
+	if err := k.db.Update(func(tx *bolt.Tx) error {
+		return putValidatorIndex(tx, publicKey, validatorIdx)
+	}); err != nil {
+		return err
+	}
+	// Only cache the index once it is committed, so the cache never serves an index
+	// missing from the db.
+	k.validatorIndexCache.Set(string(publicKey), validatorIdx, 8)
+	return nil
+}
+
+// SaveValidatorIndexStrict by public key in the db. Unlike SaveValidatorIndex, it returns
+// ErrValidatorIndexConflict instead of overwriting if the public key is already mapped to a
+// different index or the index to a different public key. Saving an existing mapping again
+// is a no-op.
+func (k *Store) SaveValidatorIndexStrict(ctx context.Context, publicKey []byte, validatorIdx uint64) error {
+	if len(publicKey) != params.BeaconConfig().BLSPubkeyLength {
+		return errors.New("incorrect key length")
+	}
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorIndexStrict")
+	defer span.End()
+	if err := k.db.Update(func(tx *bolt.Tx) error {
+		enc := uint64ToBytes(validatorIdx)
+		if prevIdx := tx.Bucket(validatorsBucket).Get(publicKey); prevIdx != nil && !bytes.Equal(prevIdx, enc) {
+			return errors.Wrapf(
+				ErrValidatorIndexConflict,
+				"public key %#x is mapped to index %d, not %d",
+				publicKey,
+				binary.LittleEndian.Uint64(prevIdx),
+				validatorIdx,
+			)
+		}
+		if prevKey := tx.Bucket(validatorIndicesBucket).Get(enc); prevKey != nil && !bytes.Equal(prevKey, publicKey) {
+			return errors.Wrapf(ErrValidatorIndexConflict, "index %d is mapped to public key %#x", validatorIdx, prevKey)
+		}
+		return putValidatorIndex(tx, publicKey, validatorIdx)
+	}); err != nil {
+		return err
+	}
+	k.validatorIndexCache.Set(string(publicKey), validatorIdx, 8)
+	return nil
 }
 
-// SaveValidatorIndices by public keys to the DB.
+// SaveValidatorIndices by public keys to the DB in a single transaction. Existing indices
+// of the given public keys are overwritten.
 func (k *Store) SaveValidatorIndices(ctx context.Context, publicKeys [][]byte, validatorIndices []uint64) error {
 	if len(publicKeys) != len(validatorIndices) {
 		return fmt.Errorf(
@@ -90,18 +185,79 @@ func (k *Store) SaveValidatorIndices(ctx context.Context, publicKeys [][]byte, v
 	}
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorIndices")
 	defer span.End()
-	return k.db.Update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(validatorsBucket)
-		var err error
+	for i := 0; i < len(publicKeys); i++ {
+		if len(publicKeys[i]) != params.BeaconConfig().BLSPubkeyLength {
+			return errors.New("incorrect key length")
+		}
+	}
+	if err := k.db.Update(func(tx *bolt.Tx) error {
 		for i := 0; i < len(publicKeys); i++ {
-			if len(publicKeys[i]) != params.BeaconConfig().BLSPubkeyLength {
-				return errors.New("incorrect key length")
+			if err := putValidatorIndex(tx, publicKeys[i], validatorIndices[i]); err != nil {
+				return err
 			}
-			buf := uint64ToBytes(validatorIndices[i])
-			k.validatorIndexCache.Set(string(publicKeys[i]), validatorIndices[i], int64(len(buf)))
-			err = bucket.Put(publicKeys[i], buf)
 		}
+		return nil
+	}); err != nil {
 		return err
+	}
+	for i := 0; i < len(publicKeys); i++ {
+		k.validatorIndexCache.Set(string(publicKeys[i]), validatorIndices[i], 8)
+	}
+	return nil
+}
+
+// putValidatorIndex maps a public key to a validator index and the index back to the public key,
+// overwriting any existing mapping of the public key. The reverse mapping of the index previously
+// held by the public key is removed. Another public key already mapped to the index keeps its
+// mapping, but the index now resolves back to the given public key.
+func putValidatorIndex(tx *bolt.Tx, publicKey []byte, validatorIdx uint64) error {
+	bkt := tx.Bucket(validatorsBucket)
+	enc := uint64ToBytes(validatorIdx)
+	if prevIdx := bkt.Get(publicKey); prevIdx != nil && !bytes.Equal(prevIdx, enc) {
+		if err := deleteReverseIndex(tx, prevIdx, publicKey); err != nil {
+			return err
+		}
+	}
+	if err := bkt.Put(publicKey, enc); err != nil {
+		return err
+	}
+	return tx.Bucket(validatorIndicesBucket).Put(enc, publicKey)
+}
+
+// deleteReverseIndex removes the mapping of an encoded validator index back to a public key,
+// unless the index has since been mapped to a different public key.
+func deleteReverseIndex(tx *bolt.Tx, enc []byte, publicKey []byte) error {
+	indicesBkt := tx.Bucket(validatorIndicesBucket)
+	if prevKey := indicesBkt.Get(enc); prevKey != nil && !bytes.Equal(prevKey, publicKey) {
+		return nil
+	}
+	return indicesBkt.Delete(enc)
+}
+
+// backfillValidatorIndices fills the reverse mapping of validator indices to public keys from the
+// public key to index mapping, for databases written before the reverse mapping existed. Indices
+// already mapped are left untouched. The backfill only runs once per database.
+func (k *Store) backfillValidatorIndices() error {
+	return k.db.Update(func(tx *bolt.Tx) error {
+		migrationBkt := tx.Bucket(migrationBucket)
+		if v := migrationBkt.Get(backfillValidatorIndicesKey); len(v) == 1 && v[0] == 0x01 {
+			return nil
+		}
+		indicesBkt := tx.Bucket(validatorIndicesBucket)
+		count := 0
+		if err := tx.Bucket(validatorsBucket).ForEach(func(publicKey []byte, enc []byte) error {
+			if indicesBkt.Get(enc) != nil {
+				return nil
+			}
+			count++
+			return indicesBkt.Put(enc, publicKey)
+		}); err != nil {
+			return err
+		}
+		if count > 0 {
+			logrus.WithField("prefix", "kv").WithField("count", count).Info("Backfilled validator indices")
+		}
+		return migrationBkt.Put(backfillValidatorIndicesKey, []byte{0x01})
 	})
 }
 
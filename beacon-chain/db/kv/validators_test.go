@@ -4,6 +4,9 @@ import (
 	"context"
 	"strconv"
 	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
 )
 
 func TestStore_ValidatorIndexCRUD(t *testing.T) {
@@ -40,6 +43,133 @@ func TestStore_ValidatorIndexCRUD(t *testing.T) {
 	}
 }
 
+func TestStore_PublicKeyForIndex(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	if _, err := db.PublicKeyForIndex(ctx, 1); err != ErrNotFoundPublicKey {
+		t.Errorf("Expected %v, received %v", ErrNotFoundPublicKey, err)
+	}
+	pubKey := [48]byte{1}
+	if err := db.SaveValidatorIndex(ctx, pubKey[:], 1); err != nil {
+		t.Fatal(err)
+	}
+	retrievedKey, err := db.PublicKeyForIndex(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrievedKey != pubKey {
+		t.Errorf("Wanted %#x, received %#x", pubKey, retrievedKey)
+	}
+	if err := db.DeleteValidatorIndex(ctx, pubKey[:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.PublicKeyForIndex(ctx, 1); err != ErrNotFoundPublicKey {
+		t.Errorf("Expected %v after deletion, received %v", ErrNotFoundPublicKey, err)
+	}
+}
+
+func TestStore_BackfillValidatorIndices(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// Write the public key to index mappings the way they were saved before the reverse mapping
+	// existed, with only one index already mapped back to a public key.
+	keys := [][48]byte{{1}, {2}, {3}}
+	mappedKey := [48]byte{4}
+	if err := db.db.Update(func(tx *bolt.Tx) error {
+		for i, k := range keys {
+			if err := tx.Bucket(validatorsBucket).Put(k[:], uint64ToBytes(uint64(i))); err != nil {
+				return err
+			}
+		}
+		if err := tx.Bucket(validatorIndicesBucket).Put(uint64ToBytes(2), mappedKey[:]); err != nil {
+			return err
+		}
+		return tx.Bucket(migrationBucket).Delete(backfillValidatorIndicesKey)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.PublicKeyForIndex(ctx, 0); err != ErrNotFoundPublicKey {
+		t.Fatalf("Expected %v before the backfill, received %v", ErrNotFoundPublicKey, err)
+	}
+
+	if err := db.backfillValidatorIndices(); err != nil {
+		t.Fatal(err)
+	}
+	wanted := [][48]byte{keys[0], keys[1], mappedKey}
+	for i, k := range wanted {
+		retrievedKey, err := db.PublicKeyForIndex(ctx, uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrievedKey != k {
+			t.Errorf("Index %d: wanted %#x, received %#x", i, k, retrievedKey)
+		}
+	}
+}
+
+func TestStore_PublicKeyForIndex_Overwrite(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	firstKey := [48]byte{1}
+	secondKey := [48]byte{2}
+	if err := db.SaveValidatorIndices(ctx, [][]byte{firstKey[:], secondKey[:]}, []uint64{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	// Moving the first key to a new index drops its old reverse mapping.
+	if err := db.SaveValidatorIndex(ctx, firstKey[:], 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.PublicKeyForIndex(ctx, 1); err != ErrNotFoundPublicKey {
+		t.Errorf("Expected %v for index 1, received %v", ErrNotFoundPublicKey, err)
+	}
+	retrievedKey, err := db.PublicKeyForIndex(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrievedKey != firstKey {
+		t.Errorf("Wanted %#x, received %#x", firstKey, retrievedKey)
+	}
+	// Assigning the index of the second key to the first key resolves the index back to the
+	// first key, while the second key keeps its mapping.
+	if err := db.SaveValidatorIndex(ctx, firstKey[:], 2); err != nil {
+		t.Fatal(err)
+	}
+	retrievedKey, err = db.PublicKeyForIndex(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrievedKey != firstKey {
+		t.Errorf("Wanted %#x, received %#x", firstKey, retrievedKey)
+	}
+	if _, err := db.PublicKeyForIndex(ctx, 3); err != ErrNotFoundPublicKey {
+		t.Errorf("Expected %v for index 3, received %v", ErrNotFoundPublicKey, err)
+	}
+	idx, ok, err := db.ValidatorIndex(ctx, secondKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || idx != 2 {
+		t.Errorf("Expected the second key to keep index 2, received %d (ok=%v)", idx, ok)
+	}
+	// Deleting the second key leaves the reverse mapping of the first key in place.
+	if err := db.DeleteValidatorIndex(ctx, secondKey[:]); err != nil {
+		t.Fatal(err)
+	}
+	retrievedKey, err = db.PublicKeyForIndex(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrievedKey != firstKey {
+		t.Errorf("Wanted %#x, received %#x", firstKey, retrievedKey)
+	}
+}
+
 func TestStore_SaveValidatorIndices(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -66,3 +196,161 @@ func TestStore_SaveValidatorIndices(t *testing.T) {
 		}
 	}
 }
+
+func TestStore_SaveValidatorIndices_Overwrite(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	numVals := 8192
+	indices := make([]uint64, numVals)
+	keys := make([][]byte, numVals)
+	for i := 0; i < numVals; i++ {
+		indices[i] = uint64(i)
+		pub := [48]byte{}
+		copy(pub[:], strconv.Itoa(i))
+		keys[i] = pub[:]
+	}
+	if err := db.SaveValidatorIndices(ctx, keys, indices); err != nil {
+		t.Fatal(err)
+	}
+	// Saving again with shifted indices overwrites every entry.
+	for i := 0; i < numVals; i++ {
+		indices[i] = uint64(i + numVals)
+	}
+	if err := db.SaveValidatorIndices(ctx, keys, indices); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < numVals; i++ {
+		idx, ok, err := db.ValidatorIndex(ctx, keys[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatalf("Expected validator index %d to have been saved to the db", i)
+		}
+		if idx != indices[i] {
+			t.Errorf("Wanted %d, received %d", indices[i], idx)
+		}
+	}
+}
+
+func BenchmarkStore_SaveValidatorIndices(b *testing.B) {
+	db := setupDB(b)
+	defer teardownDB(b, db)
+	ctx := context.Background()
+
+	numVals := 8192
+	keys := make([][]byte, numVals)
+	indices := make([]uint64, numVals)
+	for i := 0; i < numVals; i++ {
+		pub := [48]byte{}
+		copy(pub[:], strconv.Itoa(i))
+		keys[i] = pub[:]
+		indices[i] = uint64(i)
+	}
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := db.SaveValidatorIndices(ctx, keys, indices); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("per key", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < numVals; j++ {
+				if err := db.SaveValidatorIndex(ctx, keys[j], indices[j]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkStore_ValidatorIndex(b *testing.B) {
+	db := setupDB(b)
+	defer teardownDB(b, db)
+	ctx := context.Background()
+
+	numVals := 1000
+	keys := make([][]byte, numVals)
+	indices := make([]uint64, numVals)
+	for i := 0; i < numVals; i++ {
+		pub := [48]byte{}
+		copy(pub[:], strconv.Itoa(i))
+		keys[i] = pub[:]
+		indices[i] = uint64(i)
+	}
+	if err := db.SaveValidatorIndices(ctx, keys, indices); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("cached", func(b *testing.B) {
+		// Warm up the cache, which may drop or delay sets under contention.
+		for i := 0; i < numVals; i++ {
+			if _, _, err := db.ValidatorIndex(ctx, keys[i]); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, _, err := db.ValidatorIndex(ctx, keys[i%numVals]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			key := keys[i%numVals]
+			b.StopTimer()
+			db.validatorIndexCache.Del(string(key))
+			b.StartTimer()
+			if _, _, err := db.ValidatorIndex(ctx, key); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestStore_SaveValidatorIndexStrict(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	firstKey := [48]byte{1}
+	secondKey := [48]byte{2}
+	if err := db.SaveValidatorIndexStrict(ctx, firstKey[:], 1); err != nil {
+		t.Fatal(err)
+	}
+	// Saving the same mapping again is allowed.
+	if err := db.SaveValidatorIndexStrict(ctx, firstKey[:], 1); err != nil {
+		t.Errorf("Expected re-saving the same index to succeed, received %v", err)
+	}
+	// Remapping the public key to another index is rejected.
+	if err := db.SaveValidatorIndexStrict(ctx, firstKey[:], 2); errors.Cause(err) != ErrValidatorIndexConflict {
+		t.Errorf("Expected %v, received %v", ErrValidatorIndexConflict, err)
+	}
+	// Mapping another public key to the index is rejected.
+	if err := db.SaveValidatorIndexStrict(ctx, secondKey[:], 1); errors.Cause(err) != ErrValidatorIndexConflict {
+		t.Errorf("Expected %v, received %v", ErrValidatorIndexConflict, err)
+	}
+
+	idx, ok, err := db.ValidatorIndex(ctx, firstKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || idx != 1 {
+		t.Errorf("Wanted index 1 to be kept, received %d", idx)
+	}
+	retrievedKey, err := db.PublicKeyForIndex(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrievedKey != firstKey {
+		t.Errorf("Wanted %#x, received %#x", firstKey, retrievedKey)
+	}
+	if db.HasValidatorIndex(ctx, secondKey[:]) {
+		t.Error("Expected conflicting public key to not have been saved")
+	}
+}
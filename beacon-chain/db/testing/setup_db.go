@@ -10,11 +10,18 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 // SetupDB instantiates and returns database backed by key value store.
 func SetupDB(t testing.TB) db.Database {
+	return SetupDBWithSlotsPerArchivedPoint(t, uint64(flags.SlotsPerArchivedPoint.Value))
+}
+
+// SetupDBWithSlotsPerArchivedPoint instantiates and returns database backed by key value store,
+// which archives a state every given number of slots.
+func SetupDBWithSlotsPerArchivedPoint(t testing.TB, slotsPerArchivedPoint uint64) db.Database {
 	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
 	if err != nil {
 		t.Fatalf("could not generate random file path: %v", err)
@@ -23,7 +30,7 @@ func SetupDB(t testing.TB) db.Database {
 	if err := os.RemoveAll(p); err != nil {
 		t.Fatalf("failed to remove directory: %v", err)
 	}
-	s, err := kv.NewKVStore(p)
+	s, err := kv.NewKVStore(p, slotsPerArchivedPoint)
 	if err != nil {
 		t.Fatal(err)
 	}
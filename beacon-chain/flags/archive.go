@@ -29,4 +29,11 @@ var (
 		Name:  "archive-attestations",
 		Usage: "Whether or not beacon chain should archive historical blocks",
 	}
+	// SlotsPerArchivedPoint defines the number of slots between the states kept in persistent
+	// storage once finalized, the states in between can be regenerated by replaying blocks.
+	SlotsPerArchivedPoint = cli.IntFlag{
+		Name:  "slots-per-archive-point",
+		Usage: "The number of slots between the finalized states kept in the database, the states in between are regenerated on demand",
+		Value: 2048,
+	}
 )
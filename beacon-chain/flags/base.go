@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 )
 
@@ -48,6 +50,19 @@ var (
 		Name:  "grpc-gateway-port",
 		Usage: "Enable gRPC gateway for JSON requests",
 	}
+	// MaxValidatorsPerDutiesRequest caps the number of validators a client can request duties for
+	// in a single call, so one request cannot exhaust the memory of the node.
+	MaxValidatorsPerDutiesRequest = cli.IntFlag{
+		Name:  "max-validators-per-duties-request",
+		Usage: "The maximum number of validator public keys and indices accepted in a single duties request",
+		Value: 1 << 15,
+	}
+	// EnableDebugRPCEndpoints registers the debug gRPC service, whose endpoints let operators act on
+	// the internals of the node, such as forcing a head recomputation.
+	EnableDebugRPCEndpoints = cli.BoolFlag{
+		Name:  "enable-debug-rpc-endpoints",
+		Usage: "Enables the debug rpc service, containing utility endpoints such as forcing a head update",
+	}
 	// MinSyncPeers specifies the required number of successful peer handshakes in order
 	// to start syncing with external peers.
 	MinSyncPeers = cli.IntFlag{
@@ -55,12 +70,31 @@ var (
 		Usage: "The required number of valid peers to connect with before syncing.",
 		Value: 3,
 	}
+	// GossipValidationTimeout is the time after which the validation of a gossip message is
+	// abandoned and the message ignored.
+	GossipValidationTimeout = cli.DurationFlag{
+		Name:  "gossip-validation-timeout",
+		Usage: "The time after which the validation of a gossip message is abandoned and the message ignored.",
+		Value: 5 * time.Second,
+	}
 	// ContractDeploymentBlock is the block in which the eth1 deposit contract was deployed.
 	ContractDeploymentBlock = cli.IntFlag{
 		Name:  "contract-deployment-block",
 		Usage: "The eth1 block in which the deposit contract was deployed.",
 		Value: 1960177,
 	}
+	// Eth1FollowDistance overrides the number of eth1 blocks to wait before considering a block
+	// and its deposits for eth1 data voting.
+	Eth1FollowDistance = cli.Uint64Flag{
+		Name:  "eth1-follow-distance",
+		Usage: "The number of eth1 blocks to wait before considering a block for eth1 data voting, overriding the chain config. Useful for testnets with faster activation.",
+	}
+	// WeakSubjectivityCheckpoint is a trusted checkpoint the synced chain must include.
+	WeakSubjectivityCheckpoint = cli.StringFlag{
+		Name: "weak-subjectivity-checkpoint",
+		Usage: "Input in `block_root:epoch_number` format. The node refuses to consider itself synced " +
+			"unless its chain includes the block root at the start of the epoch.",
+	}
 	// SlasherCertFlag defines a flag for the slasher TLS certificate.
 	SlasherCertFlag = cli.StringFlag{
 		Name:  "slasher-tls-cert",
@@ -1,6 +1,12 @@
 package flags
 
 import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -13,8 +19,10 @@ type GlobalFlags struct {
 	EnableArchivedValidatorSetChanges bool
 	EnableArchivedBlocks              bool
 	EnableArchivedAttestations        bool
+	SlotsPerArchivedPoint             int
 	MinimumSyncPeers                  int
 	DeploymentBlock                   int
+	WeakSubjectivityCheckpoint        *ethpb.Checkpoint
 }
 
 var globalConfig *GlobalFlags
@@ -48,7 +56,18 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	if ctx.GlobalBool(ArchiveAttestationsFlag.Name) {
 		cfg.EnableArchivedAttestations = true
 	}
+	cfg.SlotsPerArchivedPoint = ctx.GlobalInt(SlotsPerArchivedPoint.Name)
+	if cfg.SlotsPerArchivedPoint <= 0 {
+		log.WithField("slotsPerArchivedPoint", cfg.SlotsPerArchivedPoint).Fatal("Slots per archived point must be positive")
+	}
 	cfg.DeploymentBlock = ctx.GlobalInt(ContractDeploymentBlock.Name)
+	if ctx.GlobalIsSet(WeakSubjectivityCheckpoint.Name) {
+		checkpoint, err := ParseWeakSubjectivityCheckpoint(ctx.GlobalString(WeakSubjectivityCheckpoint.Name))
+		if err != nil {
+			log.WithError(err).Fatal("Invalid weak subjectivity checkpoint")
+		}
+		cfg.WeakSubjectivityCheckpoint = checkpoint
+	}
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
@@ -62,3 +81,24 @@ func configureMinimumPeers(ctx *cli.Context, cfg *GlobalFlags) {
 		cfg.MinimumSyncPeers = maxPeers
 	}
 }
+
+// ParseWeakSubjectivityCheckpoint parses a checkpoint given in `block_root:epoch_number` format,
+// where the block root is hex encoded with an optional 0x prefix.
+func ParseWeakSubjectivityCheckpoint(s string) (*ethpb.Checkpoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, errors.New("checkpoint should be in block_root:epoch_number format")
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(parts[0], "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode block root")
+	}
+	if len(root) != 32 {
+		return nil, errors.Errorf("block root should be 32 bytes, received %d", len(root))
+	}
+	epoch, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse epoch")
+	}
+	return &ethpb.Checkpoint{Root: root, Epoch: epoch}, nil
+}
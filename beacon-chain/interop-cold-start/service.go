@@ -169,15 +169,19 @@ func (s *Service) saveGenesisState(ctx context.Context, genesisState *pb.BeaconS
 		return errors.Wrap(err, "could save finalized checkpoint")
 	}
 
+	pubKeys := make([][]byte, len(genesisState.Validators))
+	indices := make([]uint64, len(genesisState.Validators))
 	for i, v := range genesisState.Validators {
-		if err := s.beaconDB.SaveValidatorIndex(ctx, v.PublicKey, uint64(i)); err != nil {
-			return errors.Wrapf(err, "could not save validator index: %d", i)
-		}
+		pubKeys[i] = v.PublicKey
+		indices[i] = uint64(i)
 		s.chainStartDeposits[i] = &ethpb.Deposit{
 			Data: &ethpb.Deposit_Data{
 				PublicKey: v.PublicKey,
 			},
 		}
 	}
+	if err := s.beaconDB.SaveValidatorIndices(ctx, pubKeys, indices); err != nil {
+		return errors.Wrap(err, "could not save validator indices")
+	}
 	return nil
 }
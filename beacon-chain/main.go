@@ -32,8 +32,13 @@ var appFlags = []cli.Flag{
 	flags.CertFlag,
 	flags.KeyFlag,
 	flags.GRPCGatewayPort,
+	flags.MaxValidatorsPerDutiesRequest,
+	flags.EnableDebugRPCEndpoints,
 	flags.MinSyncPeers,
+	flags.GossipValidationTimeout,
 	flags.ContractDeploymentBlock,
+	flags.Eth1FollowDistance,
+	flags.WeakSubjectivityCheckpoint,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
@@ -42,6 +47,7 @@ var appFlags = []cli.Flag{
 	flags.ArchiveValidatorSetChangesFlag,
 	flags.ArchiveBlocksFlag,
 	flags.ArchiveAttestationsFlag,
+	flags.SlotsPerArchivedPoint,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
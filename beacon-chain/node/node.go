@@ -18,12 +18,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/archiver"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
@@ -58,6 +61,9 @@ type BeaconNode struct {
 	stop            chan struct{} // Channel to wait for termination notifications.
 	db              db.Database
 	attestationPool attestations.Pool
+	exitPool        *voluntaryexits.Pool
+	slashingsPool   *slashings.Pool
+	subnetIDs       *cache.SubnetIDs
 	depositCache    *depositcache.DepositCache
 	stateFeed       *event.Feed
 	opFeed          *event.Feed
@@ -93,6 +99,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 			params.UseDemoBeaconConfig()
 		}
 	}
+	if ctx.GlobalIsSet(flags.Eth1FollowDistance.Name) {
+		c := params.BeaconConfig()
+		c.Eth1FollowDistance = ctx.GlobalUint64(flags.Eth1FollowDistance.Name)
+		log.WithField("eth1FollowDistance", c.Eth1FollowDistance).Info("Using custom eth1 follow distance")
+		params.OverrideBeaconConfig(c)
+	}
 
 	beacon := &BeaconNode{
 		ctx:             ctx,
@@ -101,6 +113,9 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		stateFeed:       new(event.Feed),
 		opFeed:          new(event.Feed),
 		attestationPool: attestations.NewPool(),
+		exitPool:        voluntaryexits.NewPool(),
+		slashingsPool:   slashings.NewPool(),
+		subnetIDs:       cache.NewSubnetIDs(),
 	}
 
 	if err := beacon.startDB(ctx); err != nil {
@@ -219,7 +234,9 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	clearDB := ctx.GlobalBool(cmd.ClearDB.Name)
 	forceClearDB := ctx.GlobalBool(cmd.ForceClearDB.Name)
 
-	d, err := db.NewDB(dbPath)
+	slotsPerArchivedPoint := uint64(flags.Get().SlotsPerArchivedPoint)
+
+	d, err := db.NewDB(dbPath, slotsPerArchivedPoint)
 	if err != nil {
 		return err
 	}
@@ -238,7 +255,7 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 		if err := d.ClearDB(); err != nil {
 			return err
 		}
-		d, err = db.NewDB(dbPath)
+		d, err = db.NewDB(dbPath, slotsPerArchivedPoint)
 		if err != nil {
 			return err
 		}
@@ -315,7 +332,8 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 
 func (b *BeaconNode) registerAttestationPool(ctx *cli.Context) error {
 	attPoolService, err := attestations.NewService(context.Background(), &attestations.Config{
-		Pool: b.attestationPool,
+		Pool:          b.attestationPool,
+		StateNotifier: b,
 	})
 	if err != nil {
 		return err
@@ -385,12 +403,14 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	}
 
 	rs := prysmsync.NewRegularSync(&prysmsync.Config{
-		DB:            b.db,
-		P2P:           b.fetchP2P(ctx),
-		Chain:         chainService,
-		InitialSync:   initSync,
-		StateNotifier: b,
-		AttPool:       b.attestationPool,
+		DB:                b.db,
+		P2P:               b.fetchP2P(ctx),
+		Chain:             chainService,
+		InitialSync:       initSync,
+		StateNotifier:     b,
+		AttPool:           b.attestationPool,
+		SubnetIDs:         b.subnetIDs,
+		ValidationTimeout: ctx.GlobalDuration(flags.GossipValidationTimeout.Name),
 	})
 
 	return b.services.RegisterService(rs)
@@ -453,31 +473,40 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	slasherProvider := ctx.GlobalString(flags.SlasherProviderFlag.Name)
 
 	mockEth1DataVotes := ctx.GlobalBool(flags.InteropMockEth1DataVotesFlag.Name)
+	maxValidatorsPerDutiesRequest := ctx.GlobalInt(flags.MaxValidatorsPerDutiesRequest.Name)
+	enableDebugRPCEndpoints := ctx.GlobalBool(flags.EnableDebugRPCEndpoints.Name)
 	rpcService := rpc.NewService(context.Background(), &rpc.Config{
-		Port:                  port,
-		CertFlag:              cert,
-		KeyFlag:               key,
-		BeaconDB:              b.db,
-		Broadcaster:           b.fetchP2P(ctx),
-		PeersFetcher:          b.fetchP2P(ctx),
-		HeadFetcher:           chainService,
-		ForkFetcher:           chainService,
-		FinalizationFetcher:   chainService,
-		ParticipationFetcher:  chainService,
-		BlockReceiver:         chainService,
-		AttestationReceiver:   chainService,
-		GenesisTimeFetcher:    chainService,
-		AttestationsPool:      b.attestationPool,
-		POWChainService:       web3Service,
-		ChainStartFetcher:     chainStartFetcher,
-		MockEth1Votes:         mockEth1DataVotes,
-		SyncService:           syncService,
-		DepositFetcher:        depositFetcher,
-		PendingDepositFetcher: b.depositCache,
-		StateNotifier:         b,
-		OperationNotifier:     b,
-		SlasherCert:           slasherCert,
-		SlasherProvider:       slasherProvider,
+		Port:                          port,
+		CertFlag:                      cert,
+		KeyFlag:                       key,
+		BeaconDB:                      b.db,
+		Broadcaster:                   b.fetchP2P(ctx),
+		PeersFetcher:                  b.fetchP2P(ctx),
+		HeadFetcher:                   chainService,
+		ForkFetcher:                   chainService,
+		FinalizationFetcher:           chainService,
+		ParticipationFetcher:          chainService,
+		BlockReceiver:                 chainService,
+		AttestationReceiver:           chainService,
+		GenesisTimeFetcher:            chainService,
+		AttestationsPool:              b.attestationPool,
+		ExitPool:                      b.exitPool,
+		SlashingsPool:                 b.slashingsPool,
+		POWChainService:               web3Service,
+		ChainStartFetcher:             chainStartFetcher,
+		MockEth1Votes:                 mockEth1DataVotes,
+		SyncService:                   syncService,
+		SyncProgressFetcher:           syncService,
+		SubnetIDs:                     b.subnetIDs,
+		DepositFetcher:                depositFetcher,
+		PendingDepositFetcher:         b.depositCache,
+		StateNotifier:                 b,
+		OperationNotifier:             b,
+		SlasherCert:                   slasherCert,
+		SlasherProvider:               slasherProvider,
+		MaxValidatorsPerDutiesRequest: maxValidatorsPerDutiesRequest,
+		HeadUpdater:                   chainService,
+		EnableDebugRPCEndpoints:       enableDebugRPCEndpoints,
 	})
 
 	return b.services.RegisterService(rpcService)
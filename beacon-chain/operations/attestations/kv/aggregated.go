@@ -13,6 +13,9 @@ func (p *AttCaches) SaveAggregatedAttestation(att *ethpb.Attestation) error {
 	if !helpers.IsAggregated(att) {
 		return errors.New("attestation is not aggregated")
 	}
+	if err := p.verifySlotTime(att); err != nil {
+		return err
+	}
 	r, err := ssz.HashTreeRoot(att.Data)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
@@ -89,6 +92,22 @@ func (p *AttCaches) AggregatedAttestationsBySlotIndex(slot uint64, committeeInde
 	return atts
 }
 
+// AggregatedAttestationsByDataRoot returns the aggregated attestations in cache with the given
+// attestation data root.
+func (p *AttCaches) AggregatedAttestationsByDataRoot(root [32]byte) []*ethpb.Attestation {
+	d, ok := p.aggregatedAtt.Get(string(root[:]))
+	if !ok {
+		return []*ethpb.Attestation{}
+	}
+	atts, ok := d.([]*ethpb.Attestation)
+	if !ok {
+		// Type assertion for the worst case. This shouldn't happen.
+		p.aggregatedAtt.Delete(string(root[:]))
+		return []*ethpb.Attestation{}
+	}
+	return atts
+}
+
 // DeleteAggregatedAttestation deletes the aggregated attestations in cache.
 func (p *AttCaches) DeleteAggregatedAttestation(att *ethpb.Attestation) error {
 	if !helpers.IsAggregated(att) {
@@ -51,6 +51,31 @@ func TestKV_Aggregated_CanSaveRetrieve(t *testing.T) {
 	}
 }
 
+func TestKV_Aggregated_ByDataRoot(t *testing.T) {
+	cache := NewAttCaches()
+
+	att1 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}}
+	att2 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1011}}
+	att3 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1101}}
+	for _, att := range []*ethpb.Attestation{att1, att2, att3} {
+		if err := cache.SaveAggregatedAttestation(att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	root, err := ssz.HashTreeRoot(att1.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	returned := cache.AggregatedAttestationsByDataRoot(root)
+	if !reflect.DeepEqual([]*ethpb.Attestation{att1, att2}, returned) {
+		t.Errorf("Did not receive correct aggregated atts, received %v", returned)
+	}
+	if returned := cache.AggregatedAttestationsByDataRoot([32]byte{'a'}); len(returned) != 0 {
+		t.Errorf("Wanted no aggregated atts, received %v", returned)
+	}
+}
+
 func TestKV_Aggregated_CanDelete(t *testing.T) {
 	cache := NewAttCaches()
 
@@ -0,0 +1,160 @@
+package kv
+
+import (
+	"bytes"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// inclusionCandidate is an aggregated attestation considered for block inclusion, along with
+// the root of its attestation data used to track which attesters are already covered.
+type inclusionCandidate struct {
+	att            *ethpb.Attestation
+	dataRoot       string
+	matchingTarget bool
+}
+
+// BestAttestations returns up to max aggregated attestations from the cache to include in a
+// block built on top of the given state. Attestations outside of the inclusion window or with
+// a source other than the state's justified checkpoint are skipped. The remaining ones are
+// picked greedily by the number of attesters they add on top of the attestations already
+// selected or already included in the state, preferring a matching target and then the most
+// recent slot on ties, until max is reached or no attestation adds new attesters.
+func (p *AttCaches) BestAttestations(state *pb.BeaconState, max int) []*ethpb.Attestation {
+	if max <= 0 {
+		return []*ethpb.Attestation{}
+	}
+
+	candidates := make([]*inclusionCandidate, 0, p.aggregatedAtt.ItemCount())
+	for s, i := range p.aggregatedAtt.Items() {
+		// Type assertion for the worst case. This shouldn't happen.
+		atts, ok := i.Object.([]*ethpb.Attestation)
+		if !ok {
+			p.aggregatedAtt.Delete(s)
+			continue
+		}
+		for _, att := range atts {
+			matchingTarget, ok := includable(state, att)
+			if !ok {
+				continue
+			}
+			candidates = append(candidates, &inclusionCandidate{att: att, dataRoot: s, matchingTarget: matchingTarget})
+		}
+	}
+
+	covered := includedBits(state)
+	selected := make([]*ethpb.Attestation, 0, max)
+	for len(selected) < max && len(candidates) > 0 {
+		bestIdx := -1
+		bestCount := uint64(0)
+		for idx, c := range candidates {
+			count := newBitsCount(c.att.AggregationBits, covered[c.dataRoot])
+			if count == 0 {
+				continue
+			}
+			if bestIdx == -1 || count > bestCount || (count == bestCount && preferred(c, candidates[bestIdx])) {
+				bestIdx = idx
+				bestCount = count
+			}
+		}
+		if bestIdx == -1 {
+			break
+		}
+
+		best := candidates[bestIdx]
+		selected = append(selected, best.att)
+		covered[best.dataRoot] = mergeBits(covered[best.dataRoot], best.att.AggregationBits)
+		candidates[bestIdx] = candidates[len(candidates)-1]
+		candidates = candidates[:len(candidates)-1]
+	}
+
+	return selected
+}
+
+// includable returns whether the attestation's target matches the state's block root at the
+// target epoch, and whether the attestation can be included in a block built on top of the state.
+func includable(state *pb.BeaconState, att *ethpb.Attestation) (bool, bool) {
+	if att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return false, false
+	}
+	if att.Data.Slot+params.BeaconConfig().MinAttestationInclusionDelay > state.Slot ||
+		state.Slot > att.Data.Slot+params.BeaconConfig().SlotsPerEpoch {
+		return false, false
+	}
+
+	var justified *ethpb.Checkpoint
+	switch att.Data.Target.Epoch {
+	case helpers.CurrentEpoch(state):
+		justified = state.CurrentJustifiedCheckpoint
+	case helpers.PrevEpoch(state):
+		justified = state.PreviousJustifiedCheckpoint
+	default:
+		return false, false
+	}
+	if justified == nil || att.Data.Source.Epoch != justified.Epoch || !bytes.Equal(att.Data.Source.Root, justified.Root) {
+		return false, false
+	}
+
+	// The target block root is not part of the state yet when the block is proposed at the
+	// start slot of the target epoch, in which case the target is treated as not matching.
+	targetRoot, err := helpers.BlockRoot(state, att.Data.Target.Epoch)
+	if err != nil {
+		return false, true
+	}
+	return bytes.Equal(att.Data.Target.Root, targetRoot), true
+}
+
+// includedBits returns the aggregation bits of the state's pending attestations, merged by
+// attestation data root.
+func includedBits(state *pb.BeaconState) map[string]bitfield.Bitlist {
+	covered := make(map[string]bitfield.Bitlist)
+	for _, pending := range [][]*pb.PendingAttestation{state.PreviousEpochAttestations, state.CurrentEpochAttestations} {
+		for _, a := range pending {
+			r, err := ssz.HashTreeRoot(a.Data)
+			if err != nil {
+				continue
+			}
+			covered[string(r[:])] = mergeBits(covered[string(r[:])], a.AggregationBits)
+		}
+	}
+	return covered
+}
+
+// preferred returns whether candidate a should be picked over candidate b when both add
+// the same number of new attesters.
+func preferred(a *inclusionCandidate, b *inclusionCandidate) bool {
+	if a.matchingTarget != b.matchingTarget {
+		return a.matchingTarget
+	}
+	return a.att.Data.Slot > b.att.Data.Slot
+}
+
+// newBitsCount returns the number of bits set in bits which are not set in covered.
+func newBitsCount(bits bitfield.Bitlist, covered bitfield.Bitlist) uint64 {
+	if covered == nil || covered.Len() != bits.Len() {
+		return bits.Count()
+	}
+	count := uint64(0)
+	for i := uint64(0); i < bits.Len(); i++ {
+		if bits.BitAt(i) && !covered.BitAt(i) {
+			count++
+		}
+	}
+	return count
+}
+
+// mergeBits returns the union of covered and bits. Bitlists of different lengths belong to
+// different committees, so bits replaces covered in that case.
+func mergeBits(covered bitfield.Bitlist, bits bitfield.Bitlist) bitfield.Bitlist {
+	if covered == nil || covered.Len() != bits.Len() {
+		merged := make(bitfield.Bitlist, len(bits))
+		copy(merged, bits)
+		return merged
+	}
+	return covered.Or(bits)
+}
@@ -0,0 +1,143 @@
+package kv
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var inclusionTargetRoot = []byte{'t', 'a', 'r', 'g', 'e', 't', 31: 0}
+
+func inclusionTestState() *pb.BeaconState {
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = params.BeaconConfig().ZeroHash[:]
+	}
+	blockRoots[0] = inclusionTargetRoot
+	return &pb.BeaconState{
+		Slot:                        5,
+		BlockRoots:                  blockRoots,
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+}
+
+func inclusionTestData(committeeIndex uint64) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Slot:           1,
+		CommitteeIndex: committeeIndex,
+		Source:         &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+		Target:         &ethpb.Checkpoint{Root: inclusionTargetRoot},
+	}
+}
+
+func bitsAt(length uint64, indices ...uint64) bitfield.Bitlist {
+	bits := bitfield.NewBitlist(length)
+	for _, i := range indices {
+		bits.SetBitAt(i, true)
+	}
+	return bits
+}
+
+func TestKV_BestAttestations_MaximizesDistinctBits(t *testing.T) {
+	cache := NewAttCaches()
+
+	att1 := &ethpb.Attestation{Data: inclusionTestData(0), AggregationBits: bitsAt(8, 0, 1, 2, 3, 4)}
+	att2 := &ethpb.Attestation{Data: inclusionTestData(0), AggregationBits: bitsAt(8, 3, 4, 5, 6)}
+	att3 := &ethpb.Attestation{Data: inclusionTestData(0), AggregationBits: bitsAt(8, 5, 6, 7)}
+	if err := cache.SaveAggregatedAttestations([]*ethpb.Attestation{att1, att2, att3}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		max  int
+		want []*ethpb.Attestation
+	}{
+		{max: 0, want: []*ethpb.Attestation{}},
+		{max: 1, want: []*ethpb.Attestation{att1}},
+		// Picking the attestations with the most bits would cover 7 attesters with att1 and
+		// att2, while att1 and att3 cover all 8 of them.
+		{max: 2, want: []*ethpb.Attestation{att1, att3}},
+		// att2 adds no attester on top of att1 and att3.
+		{max: 3, want: []*ethpb.Attestation{att1, att3}},
+	}
+	for _, tt := range tests {
+		received := cache.BestAttestations(inclusionTestState(), tt.max)
+		if len(received) != len(tt.want) {
+			t.Fatalf("Max %d: wanted %d attestations, received %d", tt.max, len(tt.want), len(received))
+		}
+		for i := range tt.want {
+			if received[i] != tt.want[i] {
+				t.Errorf("Max %d: wanted attestation %d to have bits %#b, received %#b", tt.max, i, tt.want[i].AggregationBits, received[i].AggregationBits)
+			}
+		}
+	}
+}
+
+func TestKV_BestAttestations_SkipsBitsIncludedInState(t *testing.T) {
+	cache := NewAttCaches()
+
+	att1 := &ethpb.Attestation{Data: inclusionTestData(0), AggregationBits: bitsAt(8, 0, 1, 2, 3, 4)}
+	att2 := &ethpb.Attestation{Data: inclusionTestData(0), AggregationBits: bitsAt(8, 5, 6)}
+	if err := cache.SaveAggregatedAttestations([]*ethpb.Attestation{att1, att2}); err != nil {
+		t.Fatal(err)
+	}
+
+	state := inclusionTestState()
+	state.CurrentEpochAttestations = []*pb.PendingAttestation{
+		{Data: inclusionTestData(0), AggregationBits: bitsAt(8, 0, 1, 2, 3)},
+	}
+
+	received := cache.BestAttestations(state, 1)
+	if len(received) != 1 || received[0] != att2 {
+		t.Errorf("Wanted the attestation adding the most attesters to the state, received %v", received)
+	}
+}
+
+func TestKV_BestAttestations_FiltersAndPrefersMatchingTarget(t *testing.T) {
+	cache := NewAttCaches()
+
+	wrongTarget := inclusionTestData(1)
+	wrongTarget.Target.Root = []byte{'w', 'r', 'o', 'n', 'g', 31: 0}
+	wrongSource := inclusionTestData(2)
+	wrongSource.Source.Epoch = 1
+	tooRecent := inclusionTestData(3)
+	tooRecent.Slot = 5
+
+	matchingAtt := &ethpb.Attestation{Data: inclusionTestData(0), AggregationBits: bitsAt(8, 0, 1)}
+	wrongTargetAtt := &ethpb.Attestation{Data: wrongTarget, AggregationBits: bitsAt(8, 0, 1)}
+	wrongSourceAtt := &ethpb.Attestation{Data: wrongSource, AggregationBits: bitsAt(8, 0, 1, 2, 3)}
+	tooRecentAtt := &ethpb.Attestation{Data: tooRecent, AggregationBits: bitsAt(8, 0, 1, 2, 3)}
+	if err := cache.SaveAggregatedAttestations([]*ethpb.Attestation{wrongTargetAtt, matchingAtt, wrongSourceAtt, tooRecentAtt}); err != nil {
+		t.Fatal(err)
+	}
+
+	received := cache.BestAttestations(inclusionTestState(), 1)
+	if len(received) != 1 || received[0] != matchingAtt {
+		t.Errorf("Wanted the attestation with a matching target, received %v", received)
+	}
+
+	received = cache.BestAttestations(inclusionTestState(), 4)
+	if len(received) != 2 {
+		t.Errorf("Wanted 2 includable attestations, received %d", len(received))
+	}
+}
+
+func TestKV_BestAttestations_RespectsMax(t *testing.T) {
+	cache := NewAttCaches()
+
+	for i := uint64(0); i < 5; i++ {
+		att := &ethpb.Attestation{Data: inclusionTestData(i), AggregationBits: bitsAt(8, 0, 1)}
+		if err := cache.SaveAggregatedAttestation(att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	received := cache.BestAttestations(inclusionTestState(), 3)
+	if len(received) != 3 {
+		t.Errorf("Wanted 3 attestations, received %d", len(received))
+	}
+}
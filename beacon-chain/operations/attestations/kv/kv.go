@@ -1,12 +1,20 @@
 package kv
 
 import (
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/patrickmn/go-cache"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 )
 
+// maximumClockDisparity is how far ahead of the local clock the slot of an attestation can start,
+// to account for the clock disparity between nodes.
+const maximumClockDisparity = 500 * time.Millisecond
+
 // AttCaches defines the caches used to satisfy attestation pool interface.
 // These caches are KV store for various attestations
 // such are unaggregated, aggregated or attestations within a block.
@@ -15,6 +23,8 @@ type AttCaches struct {
 	unAggregatedAtt *cache.Cache
 	forkchoiceAtt   *cache.Cache
 	blockAtt        *cache.Cache
+	genesisTime     time.Time
+	genesisLock     sync.RWMutex
 }
 
 // NewAttCaches initializes a new attestation pool consists of multiple KV store in cache for
@@ -33,3 +43,27 @@ func NewAttCaches() *AttCaches {
 
 	return pool
 }
+
+// SetGenesisTime sets the genesis time of the chain. Once it is set, attestations of slots which
+// have not started yet are rejected.
+func (p *AttCaches) SetGenesisTime(genesisTime time.Time) {
+	p.genesisLock.Lock()
+	defer p.genesisLock.Unlock()
+	p.genesisTime = genesisTime
+}
+
+// verifySlotTime returns an error if the slot of the attestation starts later than the maximum
+// clock disparity from now. Any slot is accepted while the genesis time is unknown.
+func (p *AttCaches) verifySlotTime(att *ethpb.Attestation) error {
+	p.genesisLock.RLock()
+	genesisTime := p.genesisTime
+	p.genesisLock.RUnlock()
+	if genesisTime.IsZero() || att == nil || att.Data == nil {
+		return nil
+	}
+	slotTime := genesisTime.Add(time.Duration(att.Data.Slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	if slotTime.After(roughtime.Now().Add(maximumClockDisparity)) {
+		return fmt.Errorf("attestation slot %d is in the future, slot starts at %v", att.Data.Slot, slotTime)
+	}
+	return nil
+}
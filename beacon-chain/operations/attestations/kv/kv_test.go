@@ -0,0 +1,78 @@
+package kv
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+func TestKV_SaveAttestation_RejectsFutureSlots(t *testing.T) {
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	// The current slot is 10 and slot 11 starts in 100 milliseconds, within the clock disparity.
+	genesisTime := roughtime.Now().Add(-11*secondsPerSlot + 100*time.Millisecond)
+
+	tests := []struct {
+		name    string
+		slot    uint64
+		wantErr bool
+	}{
+		{name: "current slot", slot: 10},
+		{name: "next slot within clock disparity", slot: 11},
+		{name: "far future slot", slot: 20, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewAttCaches()
+			cache.SetGenesisTime(genesisTime)
+
+			unaggregated := &ethpb.Attestation{
+				Data:            &ethpb.AttestationData{Slot: tt.slot},
+				AggregationBits: bitfield.Bitlist{0b101},
+			}
+			aggregated := &ethpb.Attestation{
+				Data:            &ethpb.AttestationData{Slot: tt.slot},
+				AggregationBits: bitfield.Bitlist{0b111},
+			}
+			errs := []error{
+				cache.SaveUnaggregatedAttestation(unaggregated),
+				cache.SaveAggregatedAttestation(aggregated),
+			}
+			for _, err := range errs {
+				if tt.wantErr {
+					if err == nil || !strings.Contains(err.Error(), "in the future") {
+						t.Errorf("Wanted future slot error, received %v", err)
+					}
+				} else if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+
+			wantCount := 1
+			if tt.wantErr {
+				wantCount = 0
+			}
+			if len(cache.UnaggregatedAttestations()) != wantCount {
+				t.Errorf("Wanted %d unaggregated attestations, received %d", wantCount, len(cache.UnaggregatedAttestations()))
+			}
+			if len(cache.AggregatedAttestations()) != wantCount {
+				t.Errorf("Wanted %d aggregated attestations, received %d", wantCount, len(cache.AggregatedAttestations()))
+			}
+		})
+	}
+}
+
+func TestKV_SaveAttestation_AcceptsAnySlotWithoutGenesisTime(t *testing.T) {
+	cache := NewAttCaches()
+	att := &ethpb.Attestation{
+		Data:            &ethpb.AttestationData{Slot: 1 << 40},
+		AggregationBits: bitfield.Bitlist{0b101},
+	}
+	if err := cache.SaveUnaggregatedAttestation(att); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,86 @@
+package kv
+
+import (
+	"github.com/patrickmn/go-cache"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// PruneExpired removes the attestations which can no longer be included in a block proposed at
+// the current slot, as their slot is more than an epoch old and so is their target, from every
+// cache of the pool. It also removes the aggregated attestations which are fully covered by an
+// attestation already included in a block.
+func (p *AttCaches) PruneExpired(currentSlot uint64) {
+	pruneExpired(p.unAggregatedAtt, currentSlot)
+	pruneExpired(p.forkchoiceAtt, currentSlot)
+	pruneExpiredLists(p.blockAtt, currentSlot, nil)
+	pruneExpiredLists(p.aggregatedAtt, currentSlot, p.blockAtt)
+}
+
+// pruneExpired removes the expired attestations from a cache of single attestations.
+func pruneExpired(c *cache.Cache, currentSlot uint64) {
+	for s, i := range c.Items() {
+		att, ok := i.Object.(*ethpb.Attestation)
+		if !ok || expired(att, currentSlot) {
+			c.Delete(s)
+		}
+	}
+}
+
+// pruneExpiredLists removes the expired attestations from a cache of attestation lists keyed by
+// attestation data root, along with the ones fully covered by an attestation of the included
+// cache under the same key, if any.
+func pruneExpiredLists(c *cache.Cache, currentSlot uint64, included *cache.Cache) {
+	for s, i := range c.Items() {
+		atts, ok := i.Object.([]*ethpb.Attestation)
+		if !ok {
+			c.Delete(s)
+			continue
+		}
+		var includedAtts []*ethpb.Attestation
+		if included != nil {
+			if d, ok := included.Get(s); ok {
+				includedAtts, _ = d.([]*ethpb.Attestation)
+			}
+		}
+
+		kept := make([]*ethpb.Attestation, 0, len(atts))
+		for _, att := range atts {
+			if expired(att, currentSlot) || covered(att, includedAtts) {
+				continue
+			}
+			kept = append(kept, att)
+		}
+		if len(kept) == len(atts) {
+			continue
+		}
+		if len(kept) == 0 {
+			c.Delete(s)
+			continue
+		}
+		// DefaultExpiration is set to what was given to New(). In this case
+		// it's one epoch.
+		c.Set(s, kept, cache.DefaultExpiration)
+	}
+}
+
+// expired returns whether the attestation is too old to be included in a block of the given
+// slot. An attestation can be included up to an epoch after its slot, which also keeps its
+// target in the previous epoch at most.
+func expired(att *ethpb.Attestation, currentSlot uint64) bool {
+	if att == nil || att.Data == nil {
+		return true
+	}
+	return att.Data.Slot+params.BeaconConfig().SlotsPerEpoch < currentSlot
+}
+
+// covered returns whether the aggregation bits of the attestation are contained in the ones
+// of any of the included attestations.
+func covered(att *ethpb.Attestation, included []*ethpb.Attestation) bool {
+	for _, a := range included {
+		if a.AggregationBits.Len() == att.AggregationBits.Len() && a.AggregationBits.Contains(att.AggregationBits) {
+			return true
+		}
+	}
+	return false
+}
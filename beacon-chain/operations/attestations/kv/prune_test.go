@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"sort"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestKV_PruneExpired(t *testing.T) {
+	cache := NewAttCaches()
+	currentSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+	staleSlot := currentSlot - params.BeaconConfig().SlotsPerEpoch - 1
+	freshSlot := currentSlot - params.BeaconConfig().SlotsPerEpoch
+
+	staleUnaggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: staleSlot}, AggregationBits: bitfield.Bitlist{0b1001}}
+	freshUnaggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: freshSlot}, AggregationBits: bitfield.Bitlist{0b1001}}
+	if err := cache.SaveUnaggregatedAttestations([]*ethpb.Attestation{staleUnaggregated, freshUnaggregated}); err != nil {
+		t.Fatal(err)
+	}
+
+	staleAggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: staleSlot}, AggregationBits: bitfield.Bitlist{0b1101}}
+	freshAggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: freshSlot}, AggregationBits: bitfield.Bitlist{0b1101}}
+	// Both attestations of the current slot are fresh but the first one is covered by an
+	// attestation included in a block.
+	coveredAggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: currentSlot}, AggregationBits: bitfield.Bitlist{0b1011}}
+	uncoveredAggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: currentSlot}, AggregationBits: bitfield.Bitlist{0b1110}}
+	if err := cache.SaveAggregatedAttestations([]*ethpb.Attestation{staleAggregated, freshAggregated, coveredAggregated, uncoveredAggregated}); err != nil {
+		t.Fatal(err)
+	}
+	included := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: currentSlot}, AggregationBits: bitfield.Bitlist{0b1011}}
+	if err := cache.SaveBlockAttestation(included); err != nil {
+		t.Fatal(err)
+	}
+
+	staleForkchoice := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: staleSlot}, AggregationBits: bitfield.Bitlist{0b1101}}
+	freshForkchoice := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: freshSlot}, AggregationBits: bitfield.Bitlist{0b1101}}
+	if err := cache.SaveForkchoiceAttestations([]*ethpb.Attestation{staleForkchoice, freshForkchoice}); err != nil {
+		t.Fatal(err)
+	}
+
+	cache.PruneExpired(currentSlot)
+
+	if atts := cache.UnaggregatedAttestations(); len(atts) != 1 || atts[0] != freshUnaggregated {
+		t.Errorf("Wanted only the fresh unaggregated attestation to remain, received %v", atts)
+	}
+	atts := cache.AggregatedAttestations()
+	sort.Slice(atts, func(i, j int) bool {
+		return atts[i].Data.Slot < atts[j].Data.Slot
+	})
+	if len(atts) != 2 || atts[0] != freshAggregated || atts[1] != uncoveredAggregated {
+		t.Errorf("Wanted only the fresh uncovered aggregated attestations to remain, received %v", atts)
+	}
+	if atts := cache.ForkchoiceAttestations(); len(atts) != 1 || atts[0] != freshForkchoice {
+		t.Errorf("Wanted only the fresh fork choice attestation to remain, received %v", atts)
+	}
+	if atts := cache.BlockAttestations(); len(atts) != 1 || atts[0] != included {
+		t.Errorf("Wanted the included attestation to remain, received %v", atts)
+	}
+}
@@ -13,6 +13,9 @@ func (p *AttCaches) SaveUnaggregatedAttestation(att *ethpb.Attestation) error {
 	if helpers.IsAggregated(att) {
 		return errors.New("attestation is aggregated")
 	}
+	if err := p.verifySlotTime(att); err != nil {
+		return err
+	}
 
 	r, err := ssz.HashTreeRoot(att)
 	if err != nil {
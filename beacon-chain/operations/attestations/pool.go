@@ -1,8 +1,11 @@
 package attestations
 
 import (
+	"time"
+
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations/kv"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
 // Pool defines the necessary methods for Prysm attestations pool to serve
@@ -15,8 +18,10 @@ type Pool interface {
 	SaveAggregatedAttestations(atts []*ethpb.Attestation) error
 	AggregatedAttestations() []*ethpb.Attestation
 	AggregatedAttestationsBySlotIndex(slot uint64, committeeIndex uint64) []*ethpb.Attestation
+	AggregatedAttestationsByDataRoot(root [32]byte) []*ethpb.Attestation
 	DeleteAggregatedAttestation(att *ethpb.Attestation) error
 	HasAggregatedAttestation(att *ethpb.Attestation) (bool, error)
+	BestAttestations(state *pb.BeaconState, max int) []*ethpb.Attestation
 	// For unaggregated attestations.
 	SaveUnaggregatedAttestation(att *ethpb.Attestation) error
 	SaveUnaggregatedAttestations(atts []*ethpb.Attestation) error
@@ -32,6 +37,10 @@ type Pool interface {
 	SaveForkchoiceAttestations(atts []*ethpb.Attestation) error
 	ForkchoiceAttestations() []*ethpb.Attestation
 	DeleteForkchoiceAttestation(att *ethpb.Attestation) error
+	// For pruning attestations which can no longer be included.
+	PruneExpired(currentSlot uint64)
+	// For rejecting aggregated and unaggregated attestations of future slots.
+	SetGenesisTime(genesisTime time.Time)
 }
 
 // NewPool initializes a new attestation pool.
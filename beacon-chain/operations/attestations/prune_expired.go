@@ -0,0 +1,59 @@
+package attestations
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// This prunes the attestations which can no longer be included in a block from the pool at
+// the start of every slot, once the genesis time is known. The genesis time is also handed to the
+// pool so it can reject attestations of future slots.
+func (s *Service) pruneExpiredRoutine() {
+	if s.stateNotifier == nil {
+		return
+	}
+	genesisTime, ok := s.waitForGenesisTime()
+	if !ok {
+		return
+	}
+	s.pool.SetGenesisTime(genesisTime)
+
+	ticker := slotutil.GetSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			s.pool.PruneExpired(slot)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// This waits for the state initialized event and returns the genesis time it carries. It returns
+// false if the service is stopped or the subscription fails before then.
+func (s *Service) waitForGenesisTime() (time.Time, bool) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.Initialized {
+				data := event.Data.(*statefeed.InitializedData)
+				return data.StartTime, true
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state notifier failed")
+			return time.Time{}, false
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return time.Time{}, false
+		}
+	}
+}
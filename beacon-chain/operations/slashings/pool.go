@@ -0,0 +1,246 @@
+// Package slashings defines an in-memory pool of proposer and attester slashings pending
+// inclusion in a block.
+package slashings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"go.opencensus.io/trace"
+)
+
+// PoolManager maintains the slashings pending inclusion in a block.
+type PoolManager interface {
+	PendingProposerSlashings(ctx context.Context, state *pb.BeaconState) []*ethpb.ProposerSlashing
+	PendingAttesterSlashings(ctx context.Context, state *pb.BeaconState) []*ethpb.AttesterSlashing
+	InsertProposerSlashing(ctx context.Context, state *pb.BeaconState, slashing *ethpb.ProposerSlashing) error
+	InsertAttesterSlashing(ctx context.Context, state *pb.BeaconState, slashing *ethpb.AttesterSlashing) error
+}
+
+// Pool is a concrete implementation of PoolManager. It keeps at most one proposer slashing per
+// proposer, sorted by proposer index, and the attester slashings in the order they were
+// received, each of them slashing a validator none of the previous ones slash.
+type Pool struct {
+	lock                     sync.RWMutex
+	pendingProposerSlashings []*ethpb.ProposerSlashing
+	pendingAttesterSlashings []*ethpb.AttesterSlashing
+}
+
+// NewPool initializes an empty slashings pool.
+func NewPool() *Pool {
+	return &Pool{
+		pendingProposerSlashings: make([]*ethpb.ProposerSlashing, 0),
+		pendingAttesterSlashings: make([]*ethpb.AttesterSlashing, 0),
+	}
+}
+
+// PendingProposerSlashings returns the proposer slashings of the pool which can be included in
+// a block applied to the given state, up to the maximum number of proposer slashings per block.
+func (p *Pool) PendingProposerSlashings(ctx context.Context, state *pb.BeaconState) []*ethpb.ProposerSlashing {
+	ctx, span := trace.StartSpan(ctx, "slashingsPool.PendingProposerSlashings")
+	defer span.End()
+
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	pending := make([]*ethpb.ProposerSlashing, 0, params.BeaconConfig().MaxProposerSlashings)
+	for _, s := range p.pendingProposerSlashings {
+		if uint64(len(pending)) == params.BeaconConfig().MaxProposerSlashings {
+			break
+		}
+		if err := verifyProposerSlashing(state, s); err != nil {
+			continue
+		}
+		pending = append(pending, s)
+	}
+	return pending
+}
+
+// PendingAttesterSlashings returns the attester slashings of the pool which can be included in
+// a block applied to the given state, up to the maximum number of attester slashings per block.
+// An attester slashing is left out if the validators it slashes are all slashed by the proposer
+// slashings or the attester slashings returned before it, as it would then be invalid in the
+// block.
+func (p *Pool) PendingAttesterSlashings(ctx context.Context, state *pb.BeaconState) []*ethpb.AttesterSlashing {
+	ctx, span := trace.StartSpan(ctx, "slashingsPool.PendingAttesterSlashings")
+	defer span.End()
+
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	slashed := make(map[uint64]bool)
+	for _, s := range p.pendingProposerSlashings {
+		if verifyProposerSlashing(state, s) == nil {
+			slashed[s.ProposerIndex] = true
+		}
+	}
+	pending := make([]*ethpb.AttesterSlashing, 0, params.BeaconConfig().MaxAttesterSlashings)
+	for _, s := range p.pendingAttesterSlashings {
+		if uint64(len(pending)) == params.BeaconConfig().MaxAttesterSlashings {
+			break
+		}
+		indices := slashableIndices(state, s)
+		slashesNew := false
+		for _, idx := range indices {
+			if !slashed[idx] {
+				slashesNew = true
+				break
+			}
+		}
+		if !slashesNew {
+			continue
+		}
+		if err := blocks.VerifyAttesterSlashing(ctx, state, s); err != nil {
+			continue
+		}
+		for _, idx := range indices {
+			slashed[idx] = true
+		}
+		pending = append(pending, s)
+	}
+	return pending
+}
+
+// InsertProposerSlashing validates the proposer slashing against the given state and adds it to
+// the pool, unless the pool already has one for the same proposer. The slashings of validators
+// which are no longer slashable in the state, such as the ones included in a block, are pruned
+// from the pool.
+func (p *Pool) InsertProposerSlashing(ctx context.Context, state *pb.BeaconState, slashing *ethpb.ProposerSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "slashingsPool.InsertProposerSlashing")
+	defer span.End()
+
+	if err := verifyProposerSlashing(state, slashing); err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.prune(state)
+
+	i := sort.Search(len(p.pendingProposerSlashings), func(i int) bool {
+		return p.pendingProposerSlashings[i].ProposerIndex >= slashing.ProposerIndex
+	})
+	if i < len(p.pendingProposerSlashings) && p.pendingProposerSlashings[i].ProposerIndex == slashing.ProposerIndex {
+		return nil
+	}
+	p.pendingProposerSlashings = append(p.pendingProposerSlashings, nil)
+	copy(p.pendingProposerSlashings[i+1:], p.pendingProposerSlashings[i:])
+	p.pendingProposerSlashings[i] = slashing
+	return nil
+}
+
+// InsertAttesterSlashing validates the attester slashing against the given state and adds it to
+// the pool, unless the validators it slashes are all slashed by the attester slashings already
+// in the pool. The slashings which no longer slash any validator in the state, such as the ones
+// included in a block, are pruned from the pool.
+func (p *Pool) InsertAttesterSlashing(ctx context.Context, state *pb.BeaconState, slashing *ethpb.AttesterSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "slashingsPool.InsertAttesterSlashing")
+	defer span.End()
+
+	if err := verifyAttesterSlashing(ctx, state, slashing); err != nil {
+		return err
+	}
+	indices := slashableIndices(state, slashing)
+	if len(indices) == 0 {
+		return errors.New("attester slashing does not slash any slashable validator")
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.prune(state)
+
+	pending := make(map[uint64]bool)
+	for _, s := range p.pendingAttesterSlashings {
+		for _, idx := range slashableIndices(state, s) {
+			pending[idx] = true
+		}
+	}
+	for _, idx := range indices {
+		if !pending[idx] {
+			p.pendingAttesterSlashings = append(p.pendingAttesterSlashings, slashing)
+			return nil
+		}
+	}
+	return nil
+}
+
+// prune removes the slashings which no longer slash any validator in the given state. The caller
+// must hold the write lock.
+func (p *Pool) prune(state *pb.BeaconState) {
+	keptProposerSlashings := p.pendingProposerSlashings[:0]
+	for _, s := range p.pendingProposerSlashings {
+		if slashable(state, s.ProposerIndex) {
+			keptProposerSlashings = append(keptProposerSlashings, s)
+		}
+	}
+	p.pendingProposerSlashings = keptProposerSlashings
+
+	keptAttesterSlashings := p.pendingAttesterSlashings[:0]
+	for _, s := range p.pendingAttesterSlashings {
+		if len(slashableIndices(state, s)) > 0 {
+			keptAttesterSlashings = append(keptAttesterSlashings, s)
+		}
+	}
+	p.pendingAttesterSlashings = keptAttesterSlashings
+}
+
+// verifyProposerSlashing checks the proposer slashing is valid against the state, guarding
+// against a proposer index out of the validator registry.
+func verifyProposerSlashing(state *pb.BeaconState, slashing *ethpb.ProposerSlashing) error {
+	if slashing == nil || slashing.Header_1 == nil || slashing.Header_1.Header == nil ||
+		slashing.Header_2 == nil || slashing.Header_2.Header == nil {
+		return errors.New("nil proposer slashing")
+	}
+	if slashing.ProposerIndex >= uint64(len(state.Validators)) {
+		return fmt.Errorf("invalid proposer index %d", slashing.ProposerIndex)
+	}
+	return blocks.VerifyProposerSlashing(state, slashing)
+}
+
+// verifyAttesterSlashing checks the attester slashing is valid against the state, guarding
+// against missing attestation data and attesting indices out of the validator registry.
+func verifyAttesterSlashing(ctx context.Context, state *pb.BeaconState, slashing *ethpb.AttesterSlashing) error {
+	if slashing == nil {
+		return errors.New("nil attester slashing")
+	}
+	for _, att := range []*ethpb.IndexedAttestation{slashing.Attestation_1, slashing.Attestation_2} {
+		if att == nil || att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+			return errors.New("nil attestation in attester slashing")
+		}
+		for _, idx := range att.AttestingIndices {
+			if idx >= uint64(len(state.Validators)) {
+				return fmt.Errorf("invalid attesting index %d", idx)
+			}
+		}
+	}
+	return blocks.VerifyAttesterSlashing(ctx, state, slashing)
+}
+
+// slashableIndices returns the validators attesting to both attestations of the slashing which
+// are slashable in the given state.
+func slashableIndices(state *pb.BeaconState, slashing *ethpb.AttesterSlashing) []uint64 {
+	var indices []uint64
+	for _, idx := range sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices) {
+		if slashable(state, idx) {
+			indices = append(indices, idx)
+		}
+	}
+	return indices
+}
+
+// slashable returns whether the validator of the given index is slashable in the state.
+func slashable(state *pb.BeaconState, validatorIndex uint64) bool {
+	if validatorIndex >= uint64(len(state.Validators)) {
+		return false
+	}
+	return helpers.IsSlashableValidator(state.Validators[validatorIndex], helpers.CurrentEpoch(state))
+}
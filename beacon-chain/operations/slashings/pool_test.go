@@ -0,0 +1,148 @@
+package slashings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func proposerSlashing(t *testing.T, state *pb.BeaconState, privKeys []*bls.SecretKey, proposerIndex uint64) *ethpb.ProposerSlashing {
+	domain := helpers.Domain(state.Fork, helpers.CurrentEpoch(state), params.BeaconConfig().DomainBeaconProposer)
+	headers := make([]*ethpb.SignedBeaconBlockHeader, 2)
+	for i := range headers {
+		bodyRoot := make([]byte, 32)
+		bodyRoot[0] = byte(i)
+		header := &ethpb.BeaconBlockHeader{Slot: state.Slot, BodyRoot: bodyRoot}
+		root, err := ssz.HashTreeRoot(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers[i] = &ethpb.SignedBeaconBlockHeader{
+			Header:    header,
+			Signature: privKeys[proposerIndex].Sign(root[:], domain).Marshal(),
+		}
+	}
+	return &ethpb.ProposerSlashing{ProposerIndex: proposerIndex, Header_1: headers[0], Header_2: headers[1]}
+}
+
+// attesterSlashing returns a double vote of the given sorted attesting indices.
+func attesterSlashing(t *testing.T, state *pb.BeaconState, privKeys []*bls.SecretKey, indices []uint64) *ethpb.AttesterSlashing {
+	domain := helpers.Domain(state.Fork, 0, params.BeaconConfig().DomainBeaconAttester)
+	atts := make([]*ethpb.IndexedAttestation, 2)
+	for i := range atts {
+		blockRoot := make([]byte, 32)
+		blockRoot[0] = byte(i)
+		data := &ethpb.AttestationData{
+			BeaconBlockRoot: blockRoot,
+			Source:          &ethpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]},
+			Target:          &ethpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]},
+		}
+		root, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigs := make([]*bls.Signature, len(indices))
+		for j, idx := range indices {
+			sigs[j] = privKeys[idx].Sign(root[:], domain)
+		}
+		atts[i] = &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             data,
+			Signature:        bls.AggregateSignatures(sigs).Marshal(),
+		}
+	}
+	return &ethpb.AttesterSlashing{Attestation_1: atts[0], Attestation_2: atts[1]}
+}
+
+func TestPool_ProposerSlashings(t *testing.T) {
+	ctx := context.Background()
+	state, privKeys := testutil.DeterministicGenesisState(t, 64)
+	p := NewPool()
+
+	slashing3 := proposerSlashing(t, state, privKeys, 3)
+	slashing1 := proposerSlashing(t, state, privKeys, 1)
+	for _, s := range []*ethpb.ProposerSlashing{slashing3, slashing1, slashing3} {
+		if err := p.InsertProposerSlashing(ctx, state, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	invalid := proposerSlashing(t, state, privKeys, 2)
+	invalid.Header_2 = invalid.Header_1
+	if err := p.InsertProposerSlashing(ctx, state, invalid); err == nil {
+		t.Error("Expected an invalid proposer slashing to be rejected")
+	}
+
+	pending := p.PendingProposerSlashings(ctx, state)
+	if len(pending) != 2 || !proto.Equal(pending[0], slashing1) || !proto.Equal(pending[1], slashing3) {
+		t.Fatalf("Wanted the slashings of proposers 1 and 3, received %v", pending)
+	}
+
+	// A proposer slashed in the state is no longer pending.
+	slashedState := proto.Clone(state).(*pb.BeaconState)
+	slashedState.Validators[1].Slashed = true
+	pending = p.PendingProposerSlashings(ctx, slashedState)
+	if len(pending) != 1 || !proto.Equal(pending[0], slashing3) {
+		t.Errorf("Wanted the slashing of proposer 3, received %v", pending)
+	}
+}
+
+func TestPool_AttesterSlashings(t *testing.T) {
+	ctx := context.Background()
+	state, privKeys := testutil.DeterministicGenesisState(t, 64)
+	p := NewPool()
+
+	slashing1 := attesterSlashing(t, state, privKeys, []uint64{1, 2})
+	covered := attesterSlashing(t, state, privKeys, []uint64{2})
+	slashing2 := attesterSlashing(t, state, privKeys, []uint64{2, 5})
+	for _, s := range []*ethpb.AttesterSlashing{slashing1, covered, slashing2} {
+		if err := p.InsertAttesterSlashing(ctx, state, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	invalid := attesterSlashing(t, state, privKeys, []uint64{7})
+	invalid.Attestation_2.Signature = invalid.Attestation_1.Signature
+	if err := p.InsertAttesterSlashing(ctx, state, invalid); err == nil {
+		t.Error("Expected an attester slashing with an invalid signature to be rejected")
+	}
+
+	pending := p.PendingAttesterSlashings(ctx, state)
+	if len(pending) != 2 || !proto.Equal(pending[0], slashing1) || !proto.Equal(pending[1], slashing2) {
+		t.Fatalf("Wanted the slashings of validators 1, 2 and 5, received %v", pending)
+	}
+
+	// A slashing whose validators are all slashed in the state is no longer pending.
+	slashedState := proto.Clone(state).(*pb.BeaconState)
+	slashedState.Validators[1].Slashed = true
+	slashedState.Validators[2].Slashed = true
+	pending = p.PendingAttesterSlashings(ctx, slashedState)
+	if len(pending) != 1 || !proto.Equal(pending[0], slashing2) {
+		t.Errorf("Wanted the slashing of validator 5, received %v", pending)
+	}
+	if err := p.InsertAttesterSlashing(ctx, slashedState, slashing1); err == nil {
+		t.Error("Expected a slashing of already slashed validators to be rejected")
+	}
+}
+
+func TestPool_AttesterSlashingsSkipSlashedProposers(t *testing.T) {
+	ctx := context.Background()
+	state, privKeys := testutil.DeterministicGenesisState(t, 64)
+	p := NewPool()
+
+	if err := p.InsertProposerSlashing(ctx, state, proposerSlashing(t, state, privKeys, 4)); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.InsertAttesterSlashing(ctx, state, attesterSlashing(t, state, privKeys, []uint64{4})); err != nil {
+		t.Fatal(err)
+	}
+	if pending := p.PendingAttesterSlashings(ctx, state); len(pending) != 0 {
+		t.Errorf("Wanted no attester slashing of an already slashed proposer, received %v", pending)
+	}
+}
@@ -0,0 +1,96 @@
+// Package voluntaryexits defines an in-memory pool of voluntary exits received from validators,
+// pending inclusion in a block.
+package voluntaryexits
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// PoolManager maintains the voluntary exits pending inclusion in a block.
+type PoolManager interface {
+	PendingExits(state *pb.BeaconState) []*ethpb.SignedVoluntaryExit
+	InsertVoluntaryExit(ctx context.Context, state *pb.BeaconState, exit *ethpb.SignedVoluntaryExit)
+}
+
+// Pool is a concrete implementation of PoolManager. It keeps at most one exit per validator,
+// sorted by validator index.
+type Pool struct {
+	lock    sync.RWMutex
+	pending []*ethpb.SignedVoluntaryExit
+}
+
+// NewPool initializes an empty voluntary exits pool.
+func NewPool() *Pool {
+	return &Pool{
+		pending: make([]*ethpb.SignedVoluntaryExit, 0),
+	}
+}
+
+// PendingExits returns the exits of the pool which can be included in a block applied to the
+// given state, up to the maximum number of exits per block.
+func (p *Pool) PendingExits(state *pb.BeaconState) []*ethpb.SignedVoluntaryExit {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	pending := make([]*ethpb.SignedVoluntaryExit, 0, params.BeaconConfig().MaxVoluntaryExits)
+	for _, e := range p.pending {
+		if uint64(len(pending)) == params.BeaconConfig().MaxVoluntaryExits {
+			break
+		}
+		// Exits which are not valid yet, such as the ones with an epoch after the state's, are
+		// kept in the pool for a later block.
+		if err := blocks.VerifyExit(state, e); err != nil {
+			continue
+		}
+		pending = append(pending, e)
+	}
+	return pending
+}
+
+// InsertVoluntaryExit adds the exit to the pool, unless the pool already has one for the same
+// validator. The exits of validators which are already exiting in the given state, such as the
+// ones included in a block, are pruned from the pool.
+func (p *Pool) InsertVoluntaryExit(ctx context.Context, state *pb.BeaconState, exit *ethpb.SignedVoluntaryExit) {
+	ctx, span := trace.StartSpan(ctx, "exitPool.InsertVoluntaryExit")
+	defer span.End()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	kept := p.pending[:0]
+	for _, e := range p.pending {
+		if !exiting(state, e.Exit.ValidatorIndex) {
+			kept = append(kept, e)
+		}
+	}
+	p.pending = kept
+
+	if exit == nil || exit.Exit == nil || exiting(state, exit.Exit.ValidatorIndex) {
+		return
+	}
+	i := sort.Search(len(p.pending), func(i int) bool {
+		return p.pending[i].Exit.ValidatorIndex >= exit.Exit.ValidatorIndex
+	})
+	if i < len(p.pending) && p.pending[i].Exit.ValidatorIndex == exit.Exit.ValidatorIndex {
+		return
+	}
+	p.pending = append(p.pending, nil)
+	copy(p.pending[i+1:], p.pending[i:])
+	p.pending[i] = exit
+}
+
+// exiting returns whether the validator of the given index has an exit epoch set in the state.
+func exiting(state *pb.BeaconState, validatorIndex uint64) bool {
+	if validatorIndex >= uint64(len(state.Validators)) {
+		return false
+	}
+	return state.Validators[validatorIndex].ExitEpoch != params.BeaconConfig().FarFutureEpoch
+}
@@ -0,0 +1,94 @@
+package voluntaryexits
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestPool_InsertVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	validators := make([]*ethpb.Validator, 4)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{ExitEpoch: params.BeaconConfig().FarFutureEpoch}
+	}
+	state := &pb.BeaconState{Validators: validators}
+	exit := func(idx uint64, epoch uint64) *ethpb.SignedVoluntaryExit {
+		return &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: idx, Epoch: epoch}}
+	}
+	indices := func(p *Pool) []uint64 {
+		var res []uint64
+		for _, e := range p.pending {
+			res = append(res, e.Exit.ValidatorIndex)
+		}
+		return res
+	}
+
+	p := NewPool()
+	p.InsertVoluntaryExit(ctx, state, exit(2, 0))
+	p.InsertVoluntaryExit(ctx, state, exit(0, 0))
+	p.InsertVoluntaryExit(ctx, state, exit(3, 0))
+	// A second exit of the same validator is ignored.
+	p.InsertVoluntaryExit(ctx, state, exit(2, 5))
+	if want := []uint64{0, 2, 3}; !reflect.DeepEqual(indices(p), want) {
+		t.Fatalf("Wanted pending exits of validators %v, received %v", want, indices(p))
+	}
+	if p.pending[1].Exit.Epoch != 0 {
+		t.Errorf("Wanted the first exit of validator 2 to be kept, received epoch %d", p.pending[1].Exit.Epoch)
+	}
+
+	// Exits of validators which are exiting in the state are pruned and not inserted.
+	validators[0].ExitEpoch = 10
+	validators[1].ExitEpoch = 10
+	p.InsertVoluntaryExit(ctx, state, exit(1, 0))
+	if want := []uint64{2, 3}; !reflect.DeepEqual(indices(p), want) {
+		t.Errorf("Wanted pending exits of validators %v, received %v", want, indices(p))
+	}
+}
+
+func TestPool_PendingExits(t *testing.T) {
+	ctx := context.Background()
+	genesis, privKeys := testutil.DeterministicGenesisState(t, 64)
+	exitEpoch := params.BeaconConfig().PersistentCommitteePeriod
+	state := proto.Clone(genesis).(*pb.BeaconState)
+	state.Slot = helpers.StartSlot(exitEpoch)
+	signedExit := func(idx uint64, epoch uint64) *ethpb.SignedVoluntaryExit {
+		exit := &ethpb.VoluntaryExit{ValidatorIndex: idx, Epoch: epoch}
+		root, err := ssz.HashTreeRoot(exit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := helpers.Domain(state.Fork, epoch, params.BeaconConfig().DomainVoluntaryExit)
+		return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: privKeys[idx].Sign(root[:], domain).Marshal()}
+	}
+
+	p := NewPool()
+	valid := signedExit(1, exitEpoch)
+	future := signedExit(2, exitEpoch+1)
+	stale := signedExit(3, exitEpoch)
+	for _, e := range []*ethpb.SignedVoluntaryExit{valid, future, stale} {
+		p.InsertVoluntaryExit(ctx, state, e)
+	}
+
+	// Validator 3 exited since its exit was received.
+	state.Validators[3].ExitEpoch = exitEpoch + 5
+	pending := p.PendingExits(state)
+	if len(pending) != 1 || !proto.Equal(pending[0], valid) {
+		t.Fatalf("Wanted the exit of validator 1, received %v", pending)
+	}
+
+	// The future exit is included once its epoch is reached.
+	state.Slot = helpers.StartSlot(exitEpoch + 1)
+	pending = p.PendingExits(state)
+	if len(pending) != 2 || !proto.Equal(pending[0], valid) || !proto.Equal(pending[1], future) {
+		t.Errorf("Wanted the exits of validators 1 and 2, received %v", pending)
+	}
+}
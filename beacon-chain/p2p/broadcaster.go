@@ -18,24 +18,40 @@ import (
 // GossipTypeMapping.
 var ErrMessageNotMapped = errors.New("message type is not mapped to a PubSub topic")
 
+// ErrAttestationNeedsSubnet occurs on a Broadcast attempt of an attestation, which has to be
+// broadcast to the topic of its subnet with BroadcastAttestation.
+var ErrAttestationNeedsSubnet = errors.New("attestation must be broadcast to its subnet")
+
 // Broadcast a message to the p2p network.
 func (s *Service) Broadcast(ctx context.Context, msg proto.Message) error {
 	ctx, span := trace.StartSpan(ctx, "p2p.Broadcast")
 	defer span.End()
 
-	var topic string
-	switch msg.(type) {
-	case *eth.Attestation:
-		topic = attestationToTopic(msg.(*eth.Attestation))
-	default:
-		var ok bool
-		topic, ok = GossipTypeMapping[reflect.TypeOf(msg)]
-		if !ok {
-			traceutil.AnnotateError(span, ErrMessageNotMapped)
-			return ErrMessageNotMapped
-		}
+	// Attestations are published to the topic of their subnet, which the caller has to compute
+	// from the state with BroadcastAttestation.
+	if _, ok := msg.(*eth.Attestation); ok {
+		traceutil.AnnotateError(span, ErrAttestationNeedsSubnet)
+		return ErrAttestationNeedsSubnet
+	}
+	topic, ok := GossipTypeMapping[reflect.TypeOf(msg)]
+	if !ok {
+		traceutil.AnnotateError(span, ErrMessageNotMapped)
+		return ErrMessageNotMapped
 	}
+	return s.broadcastObject(ctx, msg, topic)
+}
+
+// BroadcastAttestation broadcasts an attestation to the p2p network, on the topic of the given
+// attestation subnet.
+func (s *Service) BroadcastAttestation(ctx context.Context, subnet uint64, att *eth.Attestation) error {
+	ctx, span := trace.StartSpan(ctx, "p2p.BroadcastAttestation")
+	defer span.End()
+
+	return s.broadcastObject(ctx, att, attestationToTopic(subnet))
+}
 
+func (s *Service) broadcastObject(ctx context.Context, msg proto.Message, topic string) error {
+	span := trace.FromContext(ctx)
 	span.AddAttributes(trace.StringAttribute("topic", topic))
 
 	buf := new(bytes.Buffer)
@@ -61,9 +77,6 @@ func (s *Service) Broadcast(ctx context.Context, msg proto.Message) error {
 
 const attestationSubnetTopicFormat = "/eth2/committee_index%d_beacon_attestation"
 
-func attestationToTopic(att *eth.Attestation) string {
-	if att == nil || att.Data == nil {
-		return ""
-	}
-	return fmt.Sprintf(attestationSubnetTopicFormat, att.Data.CommitteeIndex)
+func attestationToTopic(subnet uint64) string {
+	return fmt.Sprintf(attestationSubnetTopicFormat, subnet)
 }
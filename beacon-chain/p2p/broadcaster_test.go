@@ -84,49 +84,37 @@ func TestService_Broadcast_ReturnsErr_TopicNotMapped(t *testing.T) {
 	}
 }
 
+func TestService_Broadcast_ReturnsErr_AttestationWithoutSubnet(t *testing.T) {
+	p := Service{}
+	if err := p.Broadcast(context.Background(), &eth.Attestation{}); err != ErrAttestationNeedsSubnet {
+		t.Fatalf("Expected error %v, got %v", ErrAttestationNeedsSubnet, err)
+	}
+}
+
 func TestService_Attestation_Subnet(t *testing.T) {
 	if gtm := GossipTypeMapping[reflect.TypeOf(&eth.Attestation{})]; gtm != attestationSubnetTopicFormat {
 		t.Errorf("Constant is out of date. Wanted %s, got %s", attestationSubnetTopicFormat, gtm)
 	}
 
 	tests := []struct {
-		att   *eth.Attestation
-		topic string
+		subnet uint64
+		topic  string
 	}{
 		{
-			att: &eth.Attestation{
-				Data: &eth.AttestationData{
-					CommitteeIndex: 0,
-				},
-			},
-			topic: "/eth2/committee_index0_beacon_attestation",
-		},
-		{
-			att: &eth.Attestation{
-				Data: &eth.AttestationData{
-					CommitteeIndex: 11,
-				},
-			},
-			topic: "/eth2/committee_index11_beacon_attestation",
-		},
-		{
-			att: &eth.Attestation{
-				Data: &eth.AttestationData{
-					CommitteeIndex: 55,
-				},
-			},
-			topic: "/eth2/committee_index55_beacon_attestation",
+			subnet: 0,
+			topic:  "/eth2/committee_index0_beacon_attestation",
 		},
 		{
-			att:   &eth.Attestation{},
-			topic: "",
+			subnet: 11,
+			topic:  "/eth2/committee_index11_beacon_attestation",
 		},
 		{
-			topic: "",
+			subnet: 55,
+			topic:  "/eth2/committee_index55_beacon_attestation",
 		},
 	}
 	for _, tt := range tests {
-		if res := attestationToTopic(tt.att); res != tt.topic {
+		if res := attestationToTopic(tt.subnet); res != tt.topic {
 			t.Errorf("Wrong topic, got %s wanted %s", res, tt.topic)
 		}
 	}
@@ -17,6 +17,9 @@
 //
 // Peer information is persistent for the run of the service.  This allows for collection of useful long-term statistics such as
 // number of bad responses obtained from the peer, giving the basis for decisions to not talk to known-bad peers.
+//
+// Peers also have a score reflecting the validity of the gossip messages they relay, lowered by their bad responses, so that
+// requests can be sent to the best behaved peers first.
 package peers
 
 import (
@@ -47,6 +50,16 @@ const (
 	PeerDisconnecting
 )
 
+const (
+	// MaxScore is the highest gossip score a peer can reach, so that a long-lived peer can't build up enough credit to
+	// hide a later run of invalid messages.
+	MaxScore = 100
+	// MinScore is the lowest gossip score a peer can reach.
+	MinScore = -100
+	// badResponseScore is the score deducted from a peer for each of its bad responses.
+	badResponseScore = 10
+)
+
 var (
 	// ErrPeerUnknown is returned when there is an attempt to obtain data from a peer that is not known.
 	ErrPeerUnknown = errors.New("peer unknown")
@@ -67,6 +80,7 @@ type peerStatus struct {
 	chainState            *pb.Status
 	chainStateLastUpdated time.Time
 	badResponses          int
+	gossipScore           int
 }
 
 // NewStatus creates a new status entity.
@@ -216,6 +230,65 @@ func (p *Status) IsBad(pid peer.ID) bool {
 	return false
 }
 
+// AddGossipScore adds the given delta, which may be negative, to the gossip score of the given remote peer. The gossip score is
+// kept between MinScore and MaxScore.
+// Unknown peers are ignored, as gossip messages published by this node are validated as well and it must not be added as a peer.
+func (p *Status) AddGossipScore(pid peer.ID, delta int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status, ok := p.status[pid]
+	if !ok {
+		return
+	}
+	status.gossipScore += delta
+	if status.gossipScore > MaxScore {
+		status.gossipScore = MaxScore
+	}
+	if status.gossipScore < MinScore {
+		status.gossipScore = MinScore
+	}
+}
+
+// Score returns the score of the given remote peer, which is its gossip score lowered by its bad responses.
+// This will error if the peer does not exist.
+func (p *Status) Score(pid peer.ID) (int, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if status, ok := p.status[pid]; ok {
+		return status.score(), nil
+	}
+	return 0, ErrPeerUnknown
+}
+
+// BestPeers returns at most n connected peers which are not bad, in decreasing order of score.
+func (p *Status) BestPeers(n int) []peer.ID {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	peers := make([]peer.ID, 0)
+	scores := make(map[peer.ID]int)
+	for pid, status := range p.status {
+		if status.peerState != PeerConnected || status.badResponses >= p.maxBadResponses {
+			continue
+		}
+		peers = append(peers, pid)
+		scores[pid] = status.score()
+	}
+	// Peers with the same score are ordered by ID so the selection is stable.
+	sort.Slice(peers, func(i, j int) bool {
+		if scores[peers[i]] != scores[peers[j]] {
+			return scores[peers[i]] > scores[peers[j]]
+		}
+		return peers[i] < peers[j]
+	})
+	if len(peers) > n {
+		peers = peers[:n]
+	}
+	return peers
+}
+
 // Connecting returns the peers that are connecting.
 func (p *Status) Connecting() []peer.ID {
 	p.lock.RLock()
@@ -328,6 +401,7 @@ func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte,
 	finalized := make(map[[32]byte]uint64)
 	rootToEpoch := make(map[[32]byte]uint64)
 	pidEpochs := make(map[peer.ID]uint64)
+	pidScores := make(map[peer.ID]int)
 	potentialPIDs := make([]peer.ID, 0, len(connected))
 	for _, pid := range connected {
 		peerChainState, err := p.ChainState(pid)
@@ -336,6 +410,7 @@ func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte,
 			finalized[root]++
 			rootToEpoch[root] = peerChainState.FinalizedEpoch
 			pidEpochs[pid] = peerChainState.FinalizedEpoch
+			pidScores[pid], _ = p.Score(pid)
 			potentialPIDs = append(potentialPIDs, pid)
 		}
 	}
@@ -351,9 +426,13 @@ func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte,
 	}
 	targetEpoch := rootToEpoch[targetRoot]
 
-	// Sort PIDs by finalized epoch, in decreasing order.
+	// Sort PIDs by finalized epoch, in decreasing order, preferring the peers with a higher score
+	// within the same epoch.
 	sort.Slice(potentialPIDs, func(i, j int) bool {
-		return pidEpochs[potentialPIDs[i]] > pidEpochs[potentialPIDs[j]]
+		if pidEpochs[potentialPIDs[i]] != pidEpochs[potentialPIDs[j]] {
+			return pidEpochs[potentialPIDs[i]] > pidEpochs[potentialPIDs[j]]
+		}
+		return pidScores[potentialPIDs[i]] > pidScores[potentialPIDs[j]]
 	})
 
 	// Trim potential peers to those on or after target epoch.
@@ -372,6 +451,11 @@ func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte,
 	return targetRoot[:], targetEpoch, potentialPIDs
 }
 
+// score returns the gossip score of the peer lowered by its bad responses.
+func (s *peerStatus) score() int {
+	return s.gossipScore - s.badResponses*badResponseScore
+}
+
 // fetch is a helper function that fetches a peer status, possibly creating it.
 func (p *Status) fetch(pid peer.ID) *peerStatus {
 	if _, ok := p.status[pid]; !ok {
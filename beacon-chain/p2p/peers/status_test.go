@@ -458,6 +458,101 @@ func TestBestFinalized_returnsMaxValue(t *testing.T) {
 	}
 }
 
+func TestPeerGossipScore(t *testing.T) {
+	p := peers.NewStatus(2)
+
+	pid := addPeer(t, p, peers.PeerConnected)
+	p.AddGossipScore(pid, 3)
+	p.AddGossipScore(pid, -1)
+	score, err := p.Score(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score != 2 {
+		t.Errorf("Unexpected score: expected 2, received %v", score)
+	}
+
+	// Bad responses lower the score.
+	p.IncrementBadResponses(pid)
+	lowered, err := p.Score(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lowered >= score {
+		t.Errorf("Expected bad response to lower score %v, received %v", score, lowered)
+	}
+
+	// The gossip score is bounded.
+	other := addPeer(t, p, peers.PeerConnected)
+	p.AddGossipScore(other, peers.MaxScore+1)
+	if score, _ := p.Score(other); score != peers.MaxScore {
+		t.Errorf("Unexpected score: expected %v, received %v", peers.MaxScore, score)
+	}
+	p.AddGossipScore(other, 3*peers.MinScore)
+	if score, _ := p.Score(other); score != peers.MinScore {
+		t.Errorf("Unexpected score: expected %v, received %v", peers.MinScore, score)
+	}
+
+	// Unknown peers are not added.
+	unknown := peer.ID("unknown")
+	p.AddGossipScore(unknown, 1)
+	if _, err := p.Score(unknown); err != peers.ErrPeerUnknown {
+		t.Errorf("Unexpected error: expected %v, received %v", peers.ErrPeerUnknown, err)
+	}
+}
+
+func TestBestPeers_OrderedByScore(t *testing.T) {
+	p := peers.NewStatus(2)
+
+	pid1 := addPeer(t, p, peers.PeerConnected)
+	p.AddGossipScore(pid1, 5)
+	pid2 := addPeer(t, p, peers.PeerConnected)
+	p.AddGossipScore(pid2, 20)
+	pid3 := addPeer(t, p, peers.PeerConnected)
+	p.AddGossipScore(pid3, -5)
+	pid4 := addPeer(t, p, peers.PeerConnected)
+	p.AddGossipScore(pid4, 10)
+	// A bad peer is never selected, whatever its gossip score.
+	bad := addPeer(t, p, peers.PeerConnected)
+	p.AddGossipScore(bad, 50)
+	p.IncrementBadResponses(bad)
+	p.IncrementBadResponses(bad)
+	// Nor is a peer which isn't connected.
+	disconnected := addPeer(t, p, peers.PeerDisconnected)
+	p.AddGossipScore(disconnected, 50)
+
+	best := p.BestPeers(10)
+	wanted := []peer.ID{pid2, pid4, pid1, pid3}
+	if len(best) != len(wanted) {
+		t.Fatalf("Unexpected number of peers: expected %v, received %v", len(wanted), len(best))
+	}
+	for i, pid := range wanted {
+		if best[i] != pid {
+			t.Errorf("Unexpected peer at position %d: expected %v, received %v", i, pid, best[i])
+		}
+	}
+
+	best = p.BestPeers(2)
+	if len(best) != 2 || best[0] != pid2 || best[1] != pid4 {
+		t.Errorf("Unexpected best peers: expected [%v %v], received %v", pid2, pid4, best)
+	}
+}
+
+func TestBestFinalized_PrefersHigherScoreWithinEpoch(t *testing.T) {
+	p := peers.NewStatus(2)
+
+	low := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(low, &pb.Status{FinalizedEpoch: 3})
+	high := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(high, &pb.Status{FinalizedEpoch: 3})
+	p.AddGossipScore(high, 10)
+
+	_, _, pids := p.BestFinalized(1, 0)
+	if len(pids) != 1 || pids[0] != high {
+		t.Errorf("Expected the higher scored peer %v, received %v", high, pids)
+	}
+}
+
 // addPeer is a helper to add a peer with a given connection state)
 func addPeer(t *testing.T, p *peers.Status, state peers.PeerConnectionState) peer.ID {
 	// Set up some peers with different states
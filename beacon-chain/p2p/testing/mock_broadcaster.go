@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/gogo/protobuf/proto"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 )
 
 // MockBroadcaster implements p2p.Broadcaster for testing.
 type MockBroadcaster struct {
-	BroadcastCalled bool
+	BroadcastCalled    bool
+	BroadcastedSubnets []uint64
 }
 
 // Broadcast records a broadcast occurred.
@@ -16,3 +18,10 @@ func (m *MockBroadcaster) Broadcast(context.Context, proto.Message) error {
 	m.BroadcastCalled = true
 	return nil
 }
+
+// BroadcastAttestation records a broadcast occurred and the subnet it was broadcast to.
+func (m *MockBroadcaster) BroadcastAttestation(_ context.Context, subnet uint64, _ *eth.Attestation) error {
+	m.BroadcastCalled = true
+	m.BroadcastedSubnets = append(m.BroadcastedSubnets, subnet)
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	peers "github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -127,6 +128,12 @@ func (p *TestP2P) Broadcast(ctx context.Context, msg proto.Message) error {
 	return nil
 }
 
+// BroadcastAttestation broadcasts an attestation.
+func (p *TestP2P) BroadcastAttestation(ctx context.Context, subnet uint64, att *eth.Attestation) error {
+	p.BroadcastCalled = true
+	return nil
+}
+
 // SetStreamHandler for RPC.
 func (p *TestP2P) SetStreamHandler(topic string, handler network.StreamHandler) {
 	p.Host.SetStreamHandler(protocol.ID(topic), handler)
@@ -18,11 +18,6 @@ var (
 	// a blockInfo struct.
 	ErrNotABlockInfo = errors.New("object is not a block info")
 
-	// maxCacheSize is 2x of the follow distance for additional cache padding.
-	// Requests should be only accessing blocks within recent blocks within the
-	// Eth1FollowDistance.
-	maxCacheSize = int(2 * params.BeaconConfig().Eth1FollowDistance)
-
 	// Metrics
 	blockCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_block_cache_miss",
@@ -38,6 +33,13 @@ var (
 	})
 )
 
+// maxCacheSize is 2x of the follow distance for additional cache padding.
+// Requests should be only accessing blocks within recent blocks within the
+// Eth1FollowDistance, which is read from the config as it can be set at runtime.
+func maxCacheSize() int {
+	return int(2 * params.BeaconConfig().Eth1FollowDistance)
+}
+
 // blockInfo specifies the block information in the ETH 1.0 chain.
 type blockInfo struct {
 	Number *big.Int
@@ -160,8 +162,8 @@ func (b *blockCache) AddBlock(blk *gethTypes.Block) error {
 		return err
 	}
 
-	trim(b.hashCache, maxCacheSize)
-	trim(b.heightCache, maxCacheSize)
+	trim(b.hashCache, maxCacheSize())
+	trim(b.heightCache, maxCacheSize())
 
 	blockCacheSize.Set(float64(len(b.hashCache.ListKeys())))
 
@@ -139,7 +139,7 @@ func TestBlockCache_byHeight(t *testing.T) {
 func TestBlockCache_maxSize(t *testing.T) {
 	cache := newBlockCache()
 
-	for i := int64(0); i < int64(maxCacheSize+10); i++ {
+	for i := int64(0); i < int64(maxCacheSize()+10); i++ {
 		header := &gethTypes.Header{
 			Number: big.NewInt(i),
 		}
@@ -148,17 +148,17 @@ func TestBlockCache_maxSize(t *testing.T) {
 		}
 	}
 
-	if len(cache.hashCache.ListKeys()) != maxCacheSize {
+	if len(cache.hashCache.ListKeys()) != maxCacheSize() {
 		t.Errorf(
 			"Expected hash cache key size to be %d, got %d",
-			maxCacheSize,
+			maxCacheSize(),
 			len(cache.hashCache.ListKeys()),
 		)
 	}
-	if len(cache.heightCache.ListKeys()) != maxCacheSize {
+	if len(cache.heightCache.ListKeys()) != maxCacheSize() {
 		t.Errorf(
 			"Expected height cache key size to be %d, got %d",
-			maxCacheSize,
+			maxCacheSize(),
 			len(cache.heightCache.ListKeys()),
 		)
 	}
@@ -0,0 +1,104 @@
+package powchain
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// boundedTrieRecentDeposits is the number of most recent deposits the bounded deposit trie keeps
+// in memory the nodes to prove of.
+const boundedTrieRecentDeposits = 1024
+
+// newBoundedDepositTrie returns an empty bounded deposit trie, which moves its older nodes to the
+// beacon database.
+func (s *Service) newBoundedDepositTrie() (*trieutil.BoundedTrie, error) {
+	return trieutil.NewBoundedTrie(
+		int(params.BeaconConfig().DepositContractTreeDepth),
+		boundedTrieRecentDeposits,
+		s.beaconDB,
+	)
+}
+
+// restoreBoundedDepositTrie rebuilds the bounded deposit trie from saved deposits, as only the
+// sparse deposit trie is saved along with the eth1 data.
+func (s *Service) restoreBoundedDepositTrie(ctx context.Context, ctrs []*protodb.DepositContainer) error {
+	boundedTrie, err := s.newBoundedDepositTrie()
+	if err != nil {
+		return errors.Wrap(err, "could not create bounded deposit trie")
+	}
+	sorted := make([]*protodb.DepositContainer, len(ctrs))
+	copy(sorted, ctrs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Index < sorted[j].Index
+	})
+	for _, ctr := range sorted {
+		depositHash, err := ssz.HashTreeRoot(ctr.Deposit.Data)
+		if err != nil {
+			return errors.Wrap(err, "unable to determine hashed value of deposit")
+		}
+		if _, _, err := insertDeposit(ctx, nil, boundedTrie, depositHash, uint64(ctr.Index)); err != nil {
+			return errors.Wrapf(err, "could not insert deposit %d", ctr.Index)
+		}
+	}
+	s.boundedDepositTrie = boundedTrie
+	s.lastReceivedMerkleIndex = int64(boundedTrie.NumOfItems()) - 1
+	return nil
+}
+
+// insertDeposit inserts the hash of the deposit at the given index into the bounded deposit trie if
+// set, into the sparse deposit trie otherwise. It returns the proof of the deposit and the root of
+// the updated trie.
+func insertDeposit(
+	ctx context.Context,
+	sparseTrie *trieutil.SparseMerkleTrie,
+	boundedTrie *trieutil.BoundedTrie,
+	depositHash [32]byte,
+	index uint64,
+) ([][]byte, [32]byte, error) {
+	if boundedTrie == nil {
+		sparseTrie.Insert(depositHash[:], int(index))
+		proof, err := sparseTrie.MerkleProof(int(index))
+		if err != nil {
+			return nil, [32]byte{}, err
+		}
+		return proof, sparseTrie.Root(), nil
+	}
+	// Items of a bounded trie can only be appended.
+	if index != boundedTrie.NumOfItems() {
+		return nil, [32]byte{}, errors.Errorf(
+			"expected merkle index %d in bounded deposit trie, received %d",
+			boundedTrie.NumOfItems(),
+			index,
+		)
+	}
+	if err := boundedTrie.Insert(ctx, depositHash[:]); err != nil {
+		return nil, [32]byte{}, err
+	}
+	proof, err := boundedTrie.MerkleProof(ctx, index)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	return proof, boundedTrie.HashTreeRoot(), nil
+}
+
+// depositProof returns the proof of the deposit at the given index against the deposit trie.
+func (s *Service) depositProof(ctx context.Context, index uint64) ([][]byte, error) {
+	if s.boundedDepositTrie != nil {
+		return s.boundedDepositTrie.MerkleProof(ctx, index)
+	}
+	return s.depositTrie.MerkleProof(int(index))
+}
+
+// depositTrieRoot returns the root of the deposit trie.
+func (s *Service) depositTrieRoot() [32]byte {
+	if s.boundedDepositTrie != nil {
+		return s.boundedDepositTrie.HashTreeRoot()
+	}
+	return s.depositTrie.Root()
+}
@@ -0,0 +1,98 @@
+package powchain
+
+import (
+	"context"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestBoundedDepositTrie_MatchesSparseTrie(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	s := &Service{beaconDB: beaconDB}
+
+	boundedTrie, err := s.newBoundedDepositTrie()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sparseTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Insert more deposits than the bounded trie keeps in memory, so older nodes are moved to the db.
+	numDeposits := 2*boundedTrieRecentDeposits + 3
+	ctrs := make([]*protodb.DepositContainer, numDeposits)
+	for i := 0; i < numDeposits; i++ {
+		pubKey := make([]byte, 48)
+		binary.LittleEndian.PutUint64(pubKey, uint64(i))
+		data := &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                params.BeaconConfig().MaxEffectiveBalance,
+			Signature:             make([]byte, 96),
+		}
+		depositHash, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantProof, wantRoot, err := insertDeposit(ctx, sparseTrie, nil, depositHash, uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, root, err := insertDeposit(ctx, nil, boundedTrie, depositHash, uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != wantRoot {
+			t.Fatalf("Deposit %d: wanted root %#x, received %#x", i, wantRoot, root)
+		}
+		if !reflect.DeepEqual(proof, wantProof) {
+			t.Fatalf("Deposit %d: bounded trie proof does not match sparse trie proof", i)
+		}
+		// Reverse the order of the containers, restoring the trie has to sort them by index.
+		ctrs[numDeposits-1-i] = &protodb.DepositContainer{
+			Deposit: &ethpb.Deposit{Data: data},
+			Index:   int64(i),
+		}
+	}
+
+	s.boundedDepositTrie = boundedTrie
+	for _, i := range []int{0, boundedTrieRecentDeposits, numDeposits - 1} {
+		wantProof, err := sparseTrie.MerkleProof(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := s.depositProof(ctx, uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(proof, wantProof) {
+			t.Errorf("Deposit %d: bounded trie proof does not match sparse trie proof", i)
+		}
+	}
+
+	if _, _, err := insertDeposit(ctx, nil, boundedTrie, [32]byte{}, uint64(numDeposits+1)); err == nil {
+		t.Error("Expected inserting a deposit out of order to fail")
+	}
+
+	restored := &Service{beaconDB: beaconDB}
+	if err := restored.restoreBoundedDepositTrie(ctx, ctrs); err != nil {
+		t.Fatal(err)
+	}
+	if restored.depositTrieRoot() != sparseTrie.Root() {
+		t.Errorf("Wanted restored root %#x, received %#x", sparseTrie.Root(), restored.depositTrieRoot())
+	}
+	if restored.lastReceivedMerkleIndex != int64(numDeposits-1) {
+		t.Errorf("Wanted last received merkle index %d, received %d", numDeposits-1, restored.lastReceivedMerkleIndex)
+	}
+}
@@ -142,9 +142,7 @@ func (s *Service) ProcessDepositLog(ctx context.Context, depositLog gethTypes.Lo
 		return errors.Wrap(err, "Unable to determine hashed value of deposit")
 	}
 
-	s.depositTrie.Insert(depositHash[:], int(index))
-
-	proof, err := s.depositTrie.MerkleProof(int(index))
+	proof, root, err := insertDeposit(ctx, s.depositTrie, s.boundedDepositTrie, depositHash, index)
 	if err != nil {
 		return errors.Wrap(err, "Unable to generate merkle proof for deposit")
 	}
@@ -166,11 +164,10 @@ func (s *Service) ProcessDepositLog(ctx context.Context, depositLog gethTypes.Lo
 	}
 
 	// We always store all historical deposits in the DB.
-	s.depositCache.InsertDeposit(ctx, deposit, depositLog.BlockNumber, int64(index), s.depositTrie.Root())
+	s.depositCache.InsertDeposit(ctx, deposit, depositLog.BlockNumber, int64(index), root)
 	validData := true
 	if !s.chainStartData.Chainstarted {
 		s.chainStartData.ChainstartDeposits = append(s.chainStartData.ChainstartDeposits, deposit)
-		root := s.depositTrie.Root()
 		eth1Data := &ethpb.Eth1Data{
 			DepositRoot:  root[:],
 			DepositCount: uint64(len(s.chainStartData.ChainstartDeposits)),
@@ -180,7 +177,7 @@ func (s *Service) ProcessDepositLog(ctx context.Context, depositLog gethTypes.Lo
 			validData = false
 		}
 	} else {
-		s.depositCache.InsertPendingDeposit(ctx, deposit, depositLog.BlockNumber, int64(index), s.depositTrie.Root())
+		s.depositCache.InsertPendingDeposit(ctx, deposit, depositLog.BlockNumber, int64(index), root)
 	}
 	if validData {
 		log.WithFields(logrus.Fields{
@@ -214,14 +211,14 @@ func (s *Service) ProcessChainStart(genesisTime uint64, eth1BlockHash [32]byte,
 	chainStartTime := time.Unix(int64(genesisTime), 0)
 
 	for i := range s.chainStartData.ChainstartDeposits {
-		proof, err := s.depositTrie.MerkleProof(i)
+		proof, err := s.depositProof(s.ctx, uint64(i))
 		if err != nil {
 			log.Errorf("Unable to generate deposit proof %v", err)
 		}
 		s.chainStartData.ChainstartDeposits[i].Proof = proof
 	}
 
-	root := s.depositTrie.Root()
+	root := s.depositTrieRoot()
 	s.chainStartData.Eth1Data = &ethpb.Eth1Data{
 		DepositCount: uint64(len(s.chainStartData.ChainstartDeposits)),
 		DepositRoot:  root[:],
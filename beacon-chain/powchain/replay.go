@@ -0,0 +1,144 @@
+package powchain
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+// ReplayDepositLogs re-fetches the deposit logs emitted by the deposit contract between
+// fromBlock and toBlock, both inclusive, and rebuilds the deposit trie and the deposit cache
+// from them. Deposits cached from blocks before fromBlock are kept as is and deposits cached
+// from blocks after toBlock are re-inserted on top of the replayed ones, so the rebuilt trie
+// yields the same deposit root as the original sync. Pending deposits and the chainstart
+// deposits are left untouched, which is why the replay is only allowed after chainstart.
+func (s *Service) ReplayDepositLogs(ctx context.Context, fromBlock uint64, toBlock uint64) error {
+	if fromBlock > toBlock {
+		return errors.Errorf("invalid block range, from block %d is after to block %d", fromBlock, toBlock)
+	}
+	if !s.chainStartData.Chainstarted {
+		return errors.New("cannot replay deposit logs before chainstart")
+	}
+
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+
+	var before, after []*protodb.DepositContainer
+	for _, ctr := range s.depositCache.AllDepositContainers(ctx) {
+		if ctr.Eth1BlockHeight < fromBlock {
+			before = append(before, ctr)
+		} else if ctr.Eth1BlockHeight > toBlock {
+			after = append(after, ctr)
+		}
+	}
+
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit trie")
+	}
+	var boundedTrie *trieutil.BoundedTrie
+	if s.boundedDepositTrie != nil {
+		boundedTrie, err = s.newBoundedDepositTrie()
+		if err != nil {
+			return errors.Wrap(err, "could not create bounded deposit trie")
+		}
+	}
+	ctrs := make([]*protodb.DepositContainer, 0, len(before)+len(after))
+	insert := func(data *ethpb.Deposit_Data, blockNum uint64, index int64) error {
+		if index != int64(len(ctrs)) {
+			return errors.Errorf("missing deposits, expected merkle index %d but received %d", len(ctrs), index)
+		}
+		depositHash, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			return errors.Wrap(err, "unable to determine hashed value of deposit")
+		}
+		proof, root, err := insertDeposit(ctx, depositTrie, boundedTrie, depositHash, uint64(index))
+		if err != nil {
+			return errors.Wrap(err, "unable to generate merkle proof for deposit")
+		}
+		ctrs = append(ctrs, &protodb.DepositContainer{
+			Deposit:         &ethpb.Deposit{Data: data, Proof: proof},
+			Eth1BlockHeight: blockNum,
+			DepositRoot:     root[:],
+			Index:           index,
+		})
+		return nil
+	}
+
+	for _, ctr := range before {
+		if err := insert(ctr.Deposit.Data, ctr.Eth1BlockHeight, ctr.Index); err != nil {
+			return errors.Wrap(err, "could not re-insert deposits before the replayed range")
+		}
+	}
+	for start := fromBlock; start <= toBlock; start += eth1HeaderReqLimit {
+		end := start + eth1HeaderReqLimit - 1
+		if end > toBlock {
+			end = toBlock
+		}
+		query := ethereum.FilterQuery{
+			Addresses: []common.Address{
+				s.depositContractAddress,
+			},
+			FromBlock: big.NewInt(int64(start)),
+			ToBlock:   big.NewInt(int64(end)),
+		}
+		logs, err := s.httpLogger.FilterLogs(ctx, query)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch deposit logs")
+		}
+		for _, depositLog := range logs {
+			if len(depositLog.Topics) == 0 || depositLog.Topics[0] != depositEventSignature {
+				continue
+			}
+			pubkey, withdrawalCredentials, amount, signature, merkleTreeIndex, err := contracts.UnpackDepositLogData(depositLog.Data)
+			if err != nil {
+				return errors.Wrap(err, "could not unpack log")
+			}
+			index := int64(binary.LittleEndian.Uint64(merkleTreeIndex))
+			// Logs may be received more than once, in which case they are already in the trie.
+			if index < int64(len(ctrs)) {
+				continue
+			}
+			depositData := &ethpb.Deposit_Data{
+				Amount:                bytesutil.FromBytes8(amount),
+				PublicKey:             pubkey,
+				Signature:             signature,
+				WithdrawalCredentials: withdrawalCredentials,
+			}
+			if err := insert(depositData, depositLog.BlockNumber, index); err != nil {
+				return errors.Wrapf(err, "could not replay deposit log in block %d", depositLog.BlockNumber)
+			}
+		}
+	}
+	for _, ctr := range after {
+		if err := insert(ctr.Deposit.Data, ctr.Eth1BlockHeight, ctr.Index); err != nil {
+			return errors.Wrap(err, "could not re-insert deposits after the replayed range, a wider range needs to be replayed")
+		}
+	}
+
+	s.depositTrie = depositTrie
+	s.boundedDepositTrie = boundedTrie
+	s.depositCache.InsertDepositContainers(ctx, ctrs)
+	s.lastReceivedMerkleIndex = int64(len(ctrs)) - 1
+	root := s.depositTrieRoot()
+	log.WithFields(logrus.Fields{
+		"fromBlock":   fromBlock,
+		"toBlock":     toBlock,
+		"deposits":    len(ctrs),
+		"depositRoot": fmt.Sprintf("%#x", root),
+	}).Info("Replayed deposit logs")
+	return nil
+}
@@ -0,0 +1,146 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// replayLogger serves a fixed set of logs, filtered by the block range of the query.
+type replayLogger struct {
+	logs []gethTypes.Log
+}
+
+func (r *replayLogger) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+func (r *replayLogger) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	var logs []gethTypes.Log
+	for _, l := range r.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func TestReplayDepositLogs_RebuildsDepositRoot(t *testing.T) {
+	testutil.ResetCache()
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		ETH1Endpoint:    endpoint,
+		DepositContract: testAcc.ContractAddr,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service = setDefaultMocks(web3Service)
+	web3Service.chainStartData.Chainstarted = true
+
+	testAcc.Backend.Commit()
+	deposits, _, _ := testutil.DeterministicDepositsAndKeys(3)
+	_, depositRoots, err := testutil.DeterministicDepositTrie(len(deposits))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each deposit is sent in its own block.
+	for i := range deposits {
+		data := deposits[i].Data
+		testAcc.TxOpts.Value = contracts.Amount32Eth()
+		testAcc.TxOpts.GasLimit = 1000000
+		if _, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, depositRoots[i]); err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+		testAcc.Backend.Commit()
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{
+			web3Service.depositContractAddress,
+		},
+	}
+	logs, err := testAcc.Backend.FilterLogs(web3Service.ctx, query)
+	if err != nil {
+		t.Fatalf("Unable to retrieve logs %v", err)
+	}
+	if len(logs) != len(deposits) {
+		t.Fatalf("Wanted %d logs, received %d", len(deposits), len(logs))
+	}
+	for _, l := range logs {
+		if err := web3Service.ProcessLog(context.Background(), l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wantRoot := web3Service.depositTrie.Root()
+	wantRoots := make([][]byte, 0, len(deposits))
+	for _, ctr := range web3Service.depositCache.AllDepositContainers(context.Background()) {
+		wantRoots = append(wantRoots, ctr.DepositRoot)
+	}
+	web3Service.httpLogger = &replayLogger{logs: logs}
+
+	emptyTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		kept      int
+		fromBlock uint64
+	}{
+		{name: "partial range", kept: 1, fromBlock: logs[1].BlockNumber},
+		{name: "full range", kept: 0, fromBlock: 0},
+	}
+	for _, tt := range tests {
+		// Corrupt the cache by dropping the deposits of the replayed range.
+		ctrs := web3Service.depositCache.AllDepositContainers(context.Background())
+		web3Service.depositCache.InsertDepositContainers(context.Background(), ctrs[:tt.kept])
+		web3Service.depositTrie = emptyTrie
+		web3Service.lastReceivedMerkleIndex = int64(tt.kept) - 1
+
+		if err := web3Service.ReplayDepositLogs(context.Background(), tt.fromBlock, logs[len(logs)-1].BlockNumber); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if web3Service.depositTrie.Root() != wantRoot {
+			t.Errorf("%s: wanted deposit root %#x, received %#x", tt.name, wantRoot, web3Service.depositTrie.Root())
+		}
+		ctrs = web3Service.depositCache.AllDepositContainers(context.Background())
+		if len(ctrs) != len(deposits) {
+			t.Fatalf("%s: wanted %d deposits, received %d", tt.name, len(deposits), len(ctrs))
+		}
+		for i, ctr := range ctrs {
+			if ctr.Index != int64(i) || !bytes.Equal(ctr.DepositRoot, wantRoots[i]) {
+				t.Errorf("%s: wanted deposit %d with root %#x, received deposit %d with root %#x", tt.name, i, wantRoots[i], ctr.Index, ctr.DepositRoot)
+			}
+		}
+		if web3Service.lastReceivedMerkleIndex != int64(len(deposits)-1) {
+			t.Errorf("%s: wanted last received merkle index %d, received %d", tt.name, len(deposits)-1, web3Service.lastReceivedMerkleIndex)
+		}
+	}
+}
+
+func TestReplayDepositLogs_InvalidRange(t *testing.T) {
+	web3Service := &Service{chainStartData: &protodb.ChainStartData{Chainstarted: true}}
+	if err := web3Service.ReplayDepositLogs(context.Background(), 10, 5); err == nil {
+		t.Error("Expected an error for a from block after the to block")
+	}
+}
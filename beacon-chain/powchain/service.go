@@ -136,6 +136,7 @@ type Service struct {
 	depositContractCaller   *contracts.DepositContractCaller
 	depositRoot             []byte
 	depositTrie             *trieutil.SparseMerkleTrie
+	boundedDepositTrie      *trieutil.BoundedTrie // Used instead of depositTrie when set.
 	chainStartData          *protodb.ChainStartData
 	beaconDB                db.HeadAccessDatabase // Circular dep if using HeadFetcher.
 	depositCache            *depositcache.DepositCache
@@ -199,6 +200,13 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		lastReceivedMerkleIndex: -1,
 		preGenesisState:         state.EmptyGenesisState(),
 	}
+	if featureconfig.Get().BoundedDepositTrie {
+		s.boundedDepositTrie, err = s.newBoundedDepositTrie()
+		if err != nil {
+			cancel()
+			return nil, errors.Wrap(err, "could not setup bounded deposit trie")
+		}
+	}
 
 	if featureconfig.Get().EnableSavingOfDepositData {
 		eth1Data, err := config.BeaconDB.PowchainData(ctx)
@@ -211,6 +219,11 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			s.preGenesisState = eth1Data.BeaconState
 			s.latestEth1Data = eth1Data.CurrentEth1Data
 			s.lastReceivedMerkleIndex = int64(len(s.depositTrie.Items()) - 1)
+			if s.boundedDepositTrie != nil {
+				if err := s.restoreBoundedDepositTrie(ctx, eth1Data.DepositContainers); err != nil {
+					return nil, errors.Wrap(err, "could not restore bounded deposit trie")
+				}
+			}
 			if err := s.initDepositCaches(ctx, eth1Data.DepositContainers); err != nil {
 				return nil, errors.Wrap(err, "could not initialize caches")
 			}
@@ -283,11 +296,12 @@ func (s *Service) IsConnectedToETH1() bool {
 // DepositRoot returns the Merkle root of the latest deposit trie
 // from the ETH1.0 deposit contract.
 func (s *Service) DepositRoot() [32]byte {
-	return s.depositTrie.Root()
+	return s.depositTrieRoot()
 }
 
 // DepositTrie returns the sparse Merkle trie used for storing
-// deposits from the ETH1.0 deposit contract.
+// deposits from the ETH1.0 deposit contract. The trie is empty
+// when the bounded deposit trie is enabled.
 func (s *Service) DepositTrie() *trieutil.SparseMerkleTrie {
 	return s.depositTrie
 }
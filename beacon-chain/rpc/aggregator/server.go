@@ -4,13 +4,19 @@ import (
 	"context"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -66,7 +72,7 @@ func (as *Server) SubmitAggregateAndProof(ctx context.Context, req *pb.Aggregati
 	}
 
 	// Check if the validator is an aggregator
-	isAggregator, err := helpers.IsAggregator(uint64(len(committee)), req.Slot, req.CommitteeIndex, req.SlotSignature)
+	isAggregator, err := helpers.IsAggregator(uint64(len(committee)), req.SlotSignature)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get aggregator status: %v", err)
 	}
@@ -101,3 +107,173 @@ func (as *Server) SubmitAggregateAndProof(ctx context.Context, req *pb.Aggregati
 
 	return &pb.AggregationResponse{}, nil
 }
+
+// SubmitAggregateSelectionProof is called by a validator to obtain the best aggregate of its committee
+// along with its selection proof, once it knows it is an aggregator of the slot. The validator must be
+// a member of the requested committee and its slot signature must be a valid selection proof.
+func (as *Server) SubmitAggregateSelectionProof(ctx context.Context, req *pb.AggregationRequest) (*pb.AggregateSelectionResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "AggregatorServer.SubmitAggregateSelectionProof")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(req.Slot)))
+
+	if as.SyncChecker.Syncing() {
+		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	validatorIndex, exists, err := as.BeaconDB.ValidatorIndex(ctx, req.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get validator index from DB: %v", err)
+	}
+	if !exists {
+		return nil, status.Error(codes.Internal, "Could not locate validator index in DB")
+	}
+	headState, err := as.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if err := verifyAggregator(headState, req.Slot, req.CommitteeIndex, validatorIndex, req.SlotSignature); err != nil {
+		return nil, err
+	}
+
+	// Pick the aggregate covering the most attesters.
+	var best *ethpb.Attestation
+	for _, att := range as.AttPool.AggregatedAttestationsBySlotIndex(req.Slot, req.CommitteeIndex) {
+		if best == nil || att.AggregationBits.Count() > best.AggregationBits.Count() {
+			best = att
+		}
+	}
+	if best == nil {
+		return nil, status.Errorf(codes.NotFound, "No aggregated attestation for slot %d and committee %d", req.Slot, req.CommitteeIndex)
+	}
+
+	return &pb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: validatorIndex,
+			SelectionProof:  req.SlotSignature,
+			Aggregate:       best,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof is called by an aggregator to publish an aggregate along with its
+// selection proof. The aggregate is verified before it is saved in the attestation pool and broadcast:
+// the aggregator must be a member of the aggregate's committee with a valid selection proof, the
+// aggregate must include attesters beyond the aggregates already known for the same data, and its
+// signature must be valid.
+func (as *Server) SubmitSignedAggregateSelectionProof(ctx context.Context, req *ethpb.AggregateAttestationAndProof) (*pb.AggregationResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "AggregatorServer.SubmitSignedAggregateSelectionProof")
+	defer span.End()
+
+	if req.Aggregate == nil || req.Aggregate.Data == nil || req.Aggregate.Data.Target == nil {
+		return nil, status.Error(codes.InvalidArgument, "Incomplete aggregate")
+	}
+	data := req.Aggregate.Data
+	span.AddAttributes(trace.Int64Attribute("slot", int64(data.Slot)))
+
+	if as.SyncChecker.Syncing() {
+		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	headState, err := as.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if err := verifyAggregator(headState, data.Slot, data.CommitteeIndex, req.AggregatorIndex, req.SelectionProof); err != nil {
+		return nil, err
+	}
+
+	seen, err := as.AttPool.HasAggregatedAttestation(req.Aggregate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check known aggregates: %v", err)
+	}
+	if seen {
+		return nil, status.Error(codes.InvalidArgument, "Aggregate is already covered by a known aggregate")
+	}
+	if err := blocks.VerifyAttestation(ctx, headState, req.Aggregate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not verify aggregate: %v", err)
+	}
+
+	if err := as.AttPool.SaveAggregatedAttestation(req.Aggregate); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save aggregated attestation: %v", err)
+	}
+	if err := as.P2p.Broadcast(ctx, req); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast aggregated attestation: %v", err)
+	}
+
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not tree hash attestation data: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"slot":            data.Slot,
+		"committeeIndex":  data.CommitteeIndex,
+		"validatorIndex":  req.AggregatorIndex,
+		"aggregatedCount": req.Aggregate.AggregationBits.Count(),
+	}).Debug("Broadcasting signed aggregated attestation and proof")
+
+	return &pb.AggregationResponse{Root: root[:]}, nil
+}
+
+// verifyAggregator checks that a validator is a member of the committee at the given slot and index,
+// and that its selection proof is a valid slot signature selecting it as an aggregator.
+func verifyAggregator(s *pbp2p.BeaconState, slot uint64, committeeIndex uint64, validatorIndex uint64, proof []byte) error {
+	committee, err := helpers.BeaconCommitteeFromState(s, slot, committeeIndex)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get committee: %v", err)
+	}
+	var inCommittee bool
+	for _, idx := range committee {
+		if idx == validatorIndex {
+			inCommittee = true
+			break
+		}
+	}
+	if !inCommittee {
+		return status.Errorf(codes.InvalidArgument, "Validator %d is not assigned to committee %d at slot %d", validatorIndex, committeeIndex, slot)
+	}
+
+	isAggregator, err := helpers.IsAggregator(uint64(len(committee)), proof)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get aggregator status: %v", err)
+	}
+	if !isAggregator {
+		return status.Errorf(codes.InvalidArgument, "Validator is not an aggregator")
+	}
+
+	domain := helpers.Domain(s.Fork, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainBeaconAttester)
+	slotMsg, err := ssz.HashTreeRoot(slot)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not tree hash slot: %v", err)
+	}
+	pubKey, err := bls.PublicKeyFromBytes(s.Validators[validatorIndex].PublicKey)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not deserialize validator public key: %v", err)
+	}
+	slotSig, err := bls.SignatureFromBytes(proof)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Could not deserialize selection proof: %v", err)
+	}
+	if !slotSig.Verify(slotMsg[:], pubKey, domain) {
+		return status.Error(codes.InvalidArgument, "Could not verify selection proof")
+	}
+	return nil
+}
+
+// GetAggregatedAttestation returns the aggregate with the most attesters in the pool for the
+// attestation data with the given root. It returns a NotFound error while the pool only holds
+// unaggregated attestations for the data, if any.
+func (as *Server) GetAggregatedAttestation(ctx context.Context, req *pb.AggregatedAttestationRequest) (*ethpb.Attestation, error) {
+	if len(req.AttestationDataRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Attestation data root must be 32 bytes, received %d", len(req.AttestationDataRoot))
+	}
+	var best *ethpb.Attestation
+	for _, att := range as.AttPool.AggregatedAttestationsByDataRoot(bytesutil.ToBytes32(req.AttestationDataRoot)) {
+		if best == nil || att.AggregationBits.Count() > best.AggregationBits.Count() {
+			best = att
+		}
+	}
+	if best == nil {
+		return nil, status.Errorf(codes.NotFound, "No aggregated attestation for data root %#x", req.AttestationDataRoot)
+	}
+	return best, nil
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -208,6 +210,188 @@ func TestSubmitAggregateAndProof_AggregateNotOk(t *testing.T) {
 	}
 }
 
+func TestSubmitSignedAggregateSelectionProof_Valid(t *testing.T) {
+	params.UseMinimalConfig()
+	c := params.MinimalSpecConfig()
+	c.TargetAggregatorsPerCommittee = 16
+	params.OverrideBeaconConfig(c)
+	defer params.UseMainnetConfig()
+
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 32)
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregate := generateAggregate(t, beaconState, committee, privKeys)
+	aggregatorIndex := committee[0]
+
+	broadcaster := &mockp2p.MockBroadcaster{}
+	aggregatorServer := &Server{
+		HeadFetcher: &mock.ChainService{State: beaconState},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		AttPool:     attestations.NewPool(),
+		P2p:         broadcaster,
+	}
+	req := &ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: aggregatorIndex,
+		SelectionProof:  selectionProof(t, beaconState, 0, privKeys[aggregatorIndex]),
+		Aggregate:       aggregate,
+	}
+	if _, err := aggregatorServer.SubmitSignedAggregateSelectionProof(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if !broadcaster.BroadcastCalled {
+		t.Error("Expected the aggregate to be broadcast")
+	}
+	if len(aggregatorServer.AttPool.AggregatedAttestations()) != 1 {
+		t.Error("Expected the aggregate to be saved in the pool")
+	}
+
+	// The same aggregate is now covered by the aggregate in the pool.
+	wanted := "already covered by a known aggregate"
+	if _, err := aggregatorServer.SubmitSignedAggregateSelectionProof(ctx, req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %q, received %v", wanted, err)
+	}
+}
+
+func TestSubmitSignedAggregateSelectionProof_NotInCommittee(t *testing.T) {
+	params.UseMinimalConfig()
+	c := params.MinimalSpecConfig()
+	c.TargetAggregatorsPerCommittee = 16
+	params.OverrideBeaconConfig(c)
+	defer params.UseMainnetConfig()
+
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 32)
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregate := generateAggregate(t, beaconState, committee, privKeys)
+	inCommittee := make(map[uint64]bool)
+	for _, idx := range committee {
+		inCommittee[idx] = true
+	}
+	var outsider uint64
+	for inCommittee[outsider] {
+		outsider++
+	}
+
+	broadcaster := &mockp2p.MockBroadcaster{}
+	aggregatorServer := &Server{
+		HeadFetcher: &mock.ChainService{State: beaconState},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		AttPool:     attestations.NewPool(),
+		P2p:         broadcaster,
+	}
+	req := &ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: outsider,
+		SelectionProof:  selectionProof(t, beaconState, 0, privKeys[outsider]),
+		Aggregate:       aggregate,
+	}
+	wanted := "is not assigned to committee"
+	if _, err := aggregatorServer.SubmitSignedAggregateSelectionProof(ctx, req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %q, received %v", wanted, err)
+	}
+	if broadcaster.BroadcastCalled {
+		t.Error("Expected the aggregate to not be broadcast")
+	}
+
+	// A committee member with the selection proof of another validator is rejected as well.
+	req.AggregatorIndex = committee[0]
+	wanted = "Could not verify selection proof"
+	if _, err := aggregatorServer.SubmitSignedAggregateSelectionProof(ctx, req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %q, received %v", wanted, err)
+	}
+}
+
+func TestSubmitAggregateSelectionProof_ReturnsBestAggregate(t *testing.T) {
+	params.UseMinimalConfig()
+	c := params.MinimalSpecConfig()
+	c.TargetAggregatorsPerCommittee = 16
+	params.OverrideBeaconConfig(c)
+	defer params.UseMainnetConfig()
+
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 32)
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregate := generateAggregate(t, beaconState, committee, privKeys)
+	aggregatorIndex := committee[0]
+	if err := db.SaveValidatorIndex(ctx, beaconState.Validators[aggregatorIndex].PublicKey, aggregatorIndex); err != nil {
+		t.Fatal(err)
+	}
+
+	aggregatorServer := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{State: beaconState},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		AttPool:     attestations.NewPool(),
+	}
+	if err := aggregatorServer.AttPool.SaveAggregatedAttestation(aggregate); err != nil {
+		t.Fatal(err)
+	}
+	proof := selectionProof(t, beaconState, 0, privKeys[aggregatorIndex])
+	res, err := aggregatorServer.SubmitAggregateSelectionProof(ctx, &pb.AggregationRequest{
+		Slot:           0,
+		CommitteeIndex: 0,
+		PublicKey:      beaconState.Validators[aggregatorIndex].PublicKey,
+		SlotSignature:  proof,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AggregateAndProof.AggregatorIndex != aggregatorIndex {
+		t.Errorf("Wanted aggregator index %d, received %d", aggregatorIndex, res.AggregateAndProof.AggregatorIndex)
+	}
+	if !reflect.DeepEqual(res.AggregateAndProof.Aggregate, aggregate) {
+		t.Errorf("Wanted aggregate %v, received %v", aggregate, res.AggregateAndProof.Aggregate)
+	}
+}
+
+// generateAggregate returns an attestation of the first committee at genesis signed by all of its members.
+func generateAggregate(t *testing.T, state *pbp2p.BeaconState, committee []uint64, privKeys []*bls.SecretKey) *ethpb.Attestation {
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		aggBits.SetBitAt(uint64(i), true)
+	}
+	att := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: params.BeaconConfig().ZeroHash[:],
+			Source:          &ethpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]},
+			Target:          &ethpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]},
+		},
+		AggregationBits: aggBits,
+	}
+	root, err := ssz.HashTreeRoot(att.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(state.Fork, 0, params.BeaconConfig().DomainBeaconAttester)
+	sigs := make([]*bls.Signature, len(committee))
+	for i, idx := range committee {
+		sigs[i] = privKeys[idx].Sign(root[:], domain)
+	}
+	att.Signature = bls.AggregateSignatures(sigs).Marshal()
+	return att
+}
+
+// selectionProof returns the slot signature of a validator.
+func selectionProof(t *testing.T, state *pbp2p.BeaconState, slot uint64, privKey *bls.SecretKey) []byte {
+	domain := helpers.Domain(state.Fork, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainBeaconAttester)
+	root, err := ssz.HashTreeRoot(slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return privKey.Sign(root[:], domain).Marshal()
+}
+
 func generateAtt(state *pbp2p.BeaconState, index uint64, privKeys []*bls.SecretKey) *ethpb.Attestation {
 	aggBits := bitfield.NewBitlist(4)
 	aggBits.SetBitAt(index, true)
@@ -237,3 +421,59 @@ func generateAtt(state *pbp2p.BeaconState, index uint64, privKeys []*bls.SecretK
 
 	return att
 }
+
+func TestGetAggregatedAttestation_ReturnsBestAggregate(t *testing.T) {
+	data := &ethpb.AttestationData{
+		Slot:            1,
+		BeaconBlockRoot: params.BeaconConfig().ZeroHash[:],
+		Source:          &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+		Target:          &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+	aggregate := &ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0b10011}}
+	best := &ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0b10111}}
+	aggregatorServer := &Server{AttPool: attestations.NewPool()}
+	if err := aggregatorServer.AttPool.SaveAggregatedAttestations([]*ethpb.Attestation{aggregate, best}); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := aggregatorServer.GetAggregatedAttestation(context.Background(), &pb.AggregatedAttestationRequest{
+		AttestationDataRoot: root[:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res, best) {
+		t.Errorf("Wanted aggregate %v, received %v", best, res)
+	}
+}
+
+func TestGetAggregatedAttestation_OnlyUnaggregated(t *testing.T) {
+	data := &ethpb.AttestationData{
+		Slot:            1,
+		BeaconBlockRoot: params.BeaconConfig().ZeroHash[:],
+		Source:          &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+		Target:          &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+	aggregatorServer := &Server{AttPool: attestations.NewPool()}
+	if err := aggregatorServer.AttPool.SaveUnaggregatedAttestation(&ethpb.Attestation{
+		Data:            data,
+		AggregationBits: bitfield.Bitlist{0b1001},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = aggregatorServer.GetAggregatedAttestation(context.Background(), &pb.AggregatedAttestationRequest{
+		AttestationDataRoot: root[:],
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Wanted NotFound error, received %v", err)
+	}
+}
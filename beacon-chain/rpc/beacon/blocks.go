@@ -11,6 +11,8 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/pagination"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -18,6 +20,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// maxBlocksByRangeCount is the maximum number of slots a single StreamBlocksByRange request may
+// cover, matching the limit of a blocks by range request of the p2p layer.
+const maxBlocksByRangeCount = 1024
+
 // ListBlocks retrieves blocks by root, slot, or epoch.
 //
 // The server may return multiple blocks in the case that a slot or epoch is
@@ -167,11 +173,160 @@ func (bs *Server) ListBlocks(
 	return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching blocks")
 }
 
+// GetBlockRoot retrieves the root of the canonical block at the requested slot, as seen from the
+// head of the chain. Recent slots are resolved through the block roots of the head state, older
+// slots through the finalized blocks in the database. A skipped slot returns NOT_FOUND.
+func (bs *Server) GetBlockRoot(ctx context.Context, req *pb.BlockRootRequest) (*pb.BlockRootResponse, error) {
+	_, root, err := bs.canonicalBlockAtSlot(ctx, req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BlockRootResponse{BlockRoot: root[:]}, nil
+}
+
+// GetBlock retrieves a block from the database either by its root or by slot, in which case the
+// canonical block at the slot is returned as in GetBlockRoot. An unknown root or a skipped slot
+// returns NOT_FOUND.
+func (bs *Server) GetBlock(ctx context.Context, req *pb.BeaconBlockRequest) (*ethpb.SignedBeaconBlock, error) {
+	switch q := req.QueryFilter.(type) {
+	case *pb.BeaconBlockRequest_BlockRoot:
+		blk, err := bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(q.BlockRoot))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+		}
+		if blk == nil {
+			return nil, status.Errorf(codes.NotFound, "No block found with root %#x", q.BlockRoot)
+		}
+		return blk, nil
+	case *pb.BeaconBlockRequest_Slot:
+		blk, _, err := bs.canonicalBlockAtSlot(ctx, q.Slot)
+		if err != nil {
+			return nil, err
+		}
+		return blk, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Must specify a block root or slot")
+	}
+}
+
+// canonicalBlockAtSlot returns the canonical block at the given slot and its root, or a
+// NOT_FOUND error if the slot was skipped.
+func (bs *Server) canonicalBlockAtSlot(ctx context.Context, slot uint64) (*ethpb.SignedBeaconBlock, [32]byte, error) {
+	headState, err := bs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, [32]byte{}, status.Error(codes.Unavailable, "Head state is not available")
+	}
+	if slot > headState.Slot {
+		return nil, [32]byte{}, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve block of a future slot, head slot %d, requesting %d",
+			headState.Slot,
+			slot,
+		)
+	}
+	root, ok, err := bs.canonicalRootAtSlot(ctx, headState, slot)
+	if err != nil {
+		return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not retrieve block root at slot %d: %v", slot, err)
+	}
+	if !ok {
+		return nil, [32]byte{}, status.Errorf(codes.NotFound, "No canonical block at slot %d", slot)
+	}
+	// The block roots of a state repeat the previous block root over skipped slots.
+	blk, err := bs.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+	}
+	if blk == nil || blk.Block.Slot != slot {
+		return nil, [32]byte{}, status.Errorf(codes.NotFound, "No canonical block at slot %d", slot)
+	}
+	return blk, root, nil
+}
+
+// canonicalRootAtSlot returns the root of the canonical block at or before the given slot.
+func (bs *Server) canonicalRootAtSlot(ctx context.Context, headState *pbp2p.BeaconState, slot uint64) ([32]byte, bool, error) {
+	if slot == headState.Slot {
+		headRoot, err := bs.HeadFetcher.HeadRoot(ctx)
+		if err != nil {
+			return [32]byte{}, false, err
+		}
+		return bytesutil.ToBytes32(headRoot), true, nil
+	}
+	if headState.Slot <= slot+params.BeaconConfig().SlotsPerHistoricalRoot {
+		root, err := helpers.BlockRootAtSlot(headState, slot)
+		if err != nil {
+			return [32]byte{}, false, err
+		}
+		return bytesutil.ToBytes32(root), true, nil
+	}
+	roots, err := bs.BeaconDB.BlockRoots(ctx, filters.NewFilter().SetStartSlot(slot).SetEndSlot(slot))
+	if err != nil {
+		return [32]byte{}, false, err
+	}
+	for _, root := range roots {
+		if bs.BeaconDB.IsFinalizedBlock(ctx, root) {
+			return root, true, nil
+		}
+	}
+	return [32]byte{}, false, nil
+}
+
+// StreamBlocksByRange streams the canonical blocks of count slots starting at the start slot and
+// spaced by step slots, in increasing slot order, as seen from the head of the chain. Skipped
+// slots are omitted and the stream ends at the head slot. A request with a zero step or count, or
+// with a count above maxBlocksByRangeCount, is rejected with an InvalidArgument error.
+func (bs *Server) StreamBlocksByRange(req *pb.BlocksByRangeRequest, stream pb.BeaconChainService_StreamBlocksByRangeServer) error {
+	ctx := stream.Context()
+	if req.Step == 0 || req.Count == 0 {
+		return status.Error(codes.InvalidArgument, "Step and count must be greater than zero")
+	}
+	if req.Count > maxBlocksByRangeCount {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Requested %d blocks, maximum is %d per request",
+			req.Count,
+			maxBlocksByRangeCount,
+		)
+	}
+	headState, err := bs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return status.Error(codes.Unavailable, "Head state is not available")
+	}
+
+	for i, slot := uint64(0), req.StartSlot; i < req.Count && slot <= headState.Slot; i, slot = i+1, slot+req.Step {
+		root, ok, err := bs.canonicalRootAtSlot(ctx, headState, slot)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not retrieve block root at slot %d: %v", slot, err)
+		}
+		if !ok {
+			continue
+		}
+		blk, err := bs.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+		}
+		// The block roots of a state repeat the previous block root over skipped slots.
+		if blk == nil || blk.Block.Slot != slot {
+			continue
+		}
+		if err := stream.Send(blk); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send block over stream: %v", err)
+		}
+	}
+	return nil
+}
+
 // GetChainHead retrieves information about the head of the beacon chain from
 // the view of the beacon chain node.
 //
 // This includes the head block slot and root as well as information about
-// the most recent finalized and justified slots.
+// the most recent finalized and justified slots. It returns Unavailable while
+// the node has no head block yet.
 func (bs *Server) GetChainHead(ctx context.Context, _ *ptypes.Empty) (*ethpb.ChainHead, error) {
 	return bs.chainHeadRetrieval(ctx)
 }
@@ -206,6 +361,9 @@ func (bs *Server) StreamChainHead(_ *ptypes.Empty, stream ethpb.BeaconChain_Stre
 // Retrieve chain head information from the DB and the current beacon state.
 func (bs *Server) chainHeadRetrieval(ctx context.Context) (*ethpb.ChainHead, error) {
 	headBlock := bs.HeadFetcher.HeadBlock()
+	if headBlock == nil || headBlock.Block == nil {
+		return nil, status.Error(codes.Unavailable, "Head block is not available")
+	}
 	headBlockRoot, err := ssz.HashTreeRoot(headBlock.Block)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get head block root: %v", err)
@@ -14,13 +14,19 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockRPC "github.com/prysmaticlabs/prysm/beacon-chain/rpc/testing"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestServer_ListBlocks_NoResults(t *testing.T) {
@@ -341,6 +347,224 @@ func TestServer_GetChainHead_NoFinalizedBlock(t *testing.T) {
 	}
 }
 
+func TestServer_GetBlockRoot(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	// Blocks at slots 0, 1 and 3 with slot 2 skipped.
+	roots := make(map[uint64][32]byte)
+	for _, slot := range []uint64{0, 1, 3} {
+		b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}}
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots[slot] = root
+	}
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for _, slot := range []uint64{0, 1, 2} {
+		r := roots[slot]
+		if slot == 2 {
+			r = roots[1]
+		}
+		blockRoots[slot] = r[:]
+	}
+	headRoot := roots[3]
+	bs := &Server{
+		BeaconDB: db,
+		HeadFetcher: &mock.ChainService{
+			State: &pbp2p.BeaconState{Slot: 3, BlockRoots: blockRoots},
+			Root:  headRoot[:],
+		},
+	}
+
+	for _, slot := range []uint64{0, 1, 3} {
+		res, err := bs.GetBlockRoot(ctx, &pb.BlockRootRequest{Slot: slot})
+		if err != nil {
+			t.Fatal(err)
+		}
+		wanted := roots[slot]
+		if !bytes.Equal(res.BlockRoot, wanted[:]) {
+			t.Errorf("Wanted block root %#x at slot %d, received %#x", wanted, slot, res.BlockRoot)
+		}
+	}
+
+	if _, err := bs.GetBlockRoot(ctx, &pb.BlockRootRequest{Slot: 2}); err == nil || !strings.Contains(err.Error(), "No canonical block at slot 2") {
+		t.Errorf("Expected skipped slot to not be found, received %v", err)
+	}
+	if _, err := bs.GetBlockRoot(ctx, &pb.BlockRootRequest{Slot: 4}); err == nil || !strings.Contains(err.Error(), "future slot") {
+		t.Errorf("Expected error when requesting a future slot, received %v", err)
+	}
+}
+
+func TestServer_GetBlockRoot_NoHeadState(t *testing.T) {
+	bs := &Server{HeadFetcher: &mock.ChainService{}}
+	if _, err := bs.GetBlockRoot(context.Background(), &pb.BlockRootRequest{Slot: 0}); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected %v error, received %v", codes.Unavailable, err)
+	}
+}
+
+func TestServer_GetBlock(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The block at slot 1 is skipped.
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	blockRoots[0] = genesisRoot[:]
+	blockRoots[1] = genesisRoot[:]
+	bs := &Server{
+		BeaconDB: db,
+		HeadFetcher: &mock.ChainService{
+			State: &pbp2p.BeaconState{Slot: 2, BlockRoots: blockRoots},
+			Root:  genesisRoot[:],
+		},
+	}
+
+	byRoot, err := bs.GetBlock(ctx, &pb.BeaconBlockRequest{QueryFilter: &pb.BeaconBlockRequest_BlockRoot{BlockRoot: genesisRoot[:]}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(byRoot, genesis) {
+		t.Errorf("Wanted block %v, received %v", genesis, byRoot)
+	}
+	bySlot, err := bs.GetBlock(ctx, &pb.BeaconBlockRequest{QueryFilter: &pb.BeaconBlockRequest_Slot{Slot: 0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(bySlot, genesis) {
+		t.Errorf("Wanted block %v, received %v", genesis, bySlot)
+	}
+
+	if _, err := bs.GetBlock(ctx, &pb.BeaconBlockRequest{QueryFilter: &pb.BeaconBlockRequest_Slot{Slot: 1}}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected skipped slot to not be found, received %v", err)
+	}
+	unknownRoot := [32]byte{'a'}
+	if _, err := bs.GetBlock(ctx, &pb.BeaconBlockRequest{QueryFilter: &pb.BeaconBlockRequest_BlockRoot{BlockRoot: unknownRoot[:]}}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected unknown root to not be found, received %v", err)
+	}
+	if _, err := bs.GetBlock(ctx, &pb.BeaconBlockRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %v error without a query filter, received %v", codes.InvalidArgument, err)
+	}
+}
+
+// blocksStream is a fake block stream collecting every block sent over it.
+type blocksStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	blocks []*ethpb.SignedBeaconBlock
+}
+
+func (s *blocksStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *blocksStream) Send(b *ethpb.SignedBeaconBlock) error {
+	s.blocks = append(s.blocks, b)
+	return nil
+}
+
+func TestServer_StreamBlocksByRange(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	// A chain up to slot 8 with slots 2, 5 and 7 skipped, and a fork block at slot 5.
+	blks := make(map[uint64]*ethpb.SignedBeaconBlock)
+	roots := make(map[uint64][32]byte)
+	parentRoot := [32]byte{}
+	for _, slot := range []uint64{0, 1, 3, 4, 6, 8} {
+		b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}}
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blks[slot] = b
+		roots[slot] = root
+		parentRoot = root
+	}
+	fork := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5, ParentRoot: []byte{'f'}}}
+	if err := db.SaveBlock(ctx, fork); err != nil {
+		t.Fatal(err)
+	}
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	var latest [32]byte
+	for slot := uint64(0); slot < 8; slot++ {
+		if r, ok := roots[slot]; ok {
+			latest = r
+		}
+		blockRoots[slot] = latest[:]
+	}
+	headRoot := roots[8]
+	bs := &Server{
+		BeaconDB: db,
+		HeadFetcher: &mock.ChainService{
+			State: &pbp2p.BeaconState{Slot: 8, BlockRoots: blockRoots},
+			Root:  headRoot[:],
+		},
+	}
+
+	tests := []struct {
+		req   *pb.BlocksByRangeRequest
+		slots []uint64
+	}{
+		{req: &pb.BlocksByRangeRequest{StartSlot: 0, Count: 5, Step: 1}, slots: []uint64{0, 1, 3, 4}},
+		{req: &pb.BlocksByRangeRequest{StartSlot: 0, Count: 5, Step: 2}, slots: []uint64{0, 4, 6, 8}},
+		{req: &pb.BlocksByRangeRequest{StartSlot: 1, Count: 3, Step: 3}, slots: []uint64{1, 4}},
+		{req: &pb.BlocksByRangeRequest{StartSlot: 5, Count: 100, Step: 1}, slots: []uint64{6, 8}},
+		{req: &pb.BlocksByRangeRequest{StartSlot: 9, Count: 10, Step: 1}, slots: nil},
+	}
+	for _, tt := range tests {
+		stream := &blocksStream{ctx: ctx}
+		if err := bs.StreamBlocksByRange(tt.req, stream); err != nil {
+			t.Fatal(err)
+		}
+		if len(stream.blocks) != len(tt.slots) {
+			t.Errorf("Request %v: wanted %d blocks, received %d", tt.req, len(tt.slots), len(stream.blocks))
+			continue
+		}
+		for i, slot := range tt.slots {
+			if !proto.Equal(stream.blocks[i], blks[slot]) {
+				t.Errorf("Request %v: wanted block %v, received %v", tt.req, blks[slot], stream.blocks[i])
+			}
+		}
+	}
+
+	invalid := []*pb.BlocksByRangeRequest{
+		{StartSlot: 0, Count: 5, Step: 0},
+		{StartSlot: 0, Count: 0, Step: 1},
+		{StartSlot: 0, Count: maxBlocksByRangeCount + 1, Step: 1},
+	}
+	for _, req := range invalid {
+		if err := bs.StreamBlocksByRange(req, &blocksStream{ctx: ctx}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Request %v: wanted %v error, received %v", req, codes.InvalidArgument, err)
+		}
+	}
+}
+
+func TestServer_StreamBlocksByRange_NoHeadState(t *testing.T) {
+	bs := &Server{HeadFetcher: &mock.ChainService{}}
+	req := &pb.BlocksByRangeRequest{StartSlot: 0, Count: 1, Step: 1}
+	if err := bs.StreamBlocksByRange(req, &blocksStream{ctx: context.Background()}); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected %v error, received %v", codes.Unavailable, err)
+	}
+}
+
 func TestServer_GetChainHead(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	defer dbTest.TeardownDB(t, db)
@@ -414,6 +638,57 @@ func TestServer_GetChainHead(t *testing.T) {
 	}
 }
 
+func TestServer_GetChainHead_Genesis(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 16)
+	stateRoot, err := ssz.HashTreeRoot(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisBlock := blk.NewGenesisBlock(stateRoot[:])
+	if err := db.SaveBlock(ctx, genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The chain service reports the genesis block root for the checkpoints of the genesis state.
+	genesisCheckpoint := &ethpb.Checkpoint{Epoch: 0, Root: genesisRoot[:]}
+	bs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{Block: genesisBlock, State: genesisState},
+		FinalizationFetcher: &mock.ChainService{
+			FinalizedCheckPoint:         genesisCheckpoint,
+			CurrentJustifiedCheckPoint:  genesisCheckpoint,
+			PreviousJustifiedCheckPoint: genesisCheckpoint},
+	}
+
+	head, err := bs.GetChainHead(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &ethpb.ChainHead{
+		HeadBlockRoot:              genesisRoot[:],
+		FinalizedBlockRoot:         genesisRoot[:],
+		JustifiedBlockRoot:         genesisRoot[:],
+		PreviousJustifiedBlockRoot: genesisRoot[:],
+	}
+	if !proto.Equal(head, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, head)
+	}
+}
+
+func TestServer_GetChainHead_NoHead(t *testing.T) {
+	bs := &Server{HeadFetcher: &mock.ChainService{}}
+	if _, err := bs.GetChainHead(context.Background(), nil); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected %v error, received %v", codes.Unavailable, err)
+	}
+}
+
 func TestServer_StreamChainHead_ContextCanceled(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	defer dbTest.TeardownDB(t, db)
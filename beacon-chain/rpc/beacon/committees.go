@@ -6,6 +6,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,11 +15,18 @@ import (
 // ListBeaconCommittees for a given epoch.
 //
 // If no filter criteria is specified, the response returns
-// all beacon committees for the current epoch. The results are paginated by default.
+// all beacon committees for the current epoch. The committees of the next epoch
+// can be requested as well, as they are already determined by the head state.
+// The committees are paginated by slot, a page holds the committees of page size
+// slots of the epoch.
 func (bs *Server) ListBeaconCommittees(
 	ctx context.Context,
 	req *ethpb.ListCommitteesRequest,
 ) (*ethpb.BeaconCommittees, error) {
+	if int(req.PageSize) > params.BeaconConfig().MaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, params.BeaconConfig().MaxPageSize)
+	}
 
 	var requestingGenesis bool
 	var startSlot uint64
@@ -29,7 +37,7 @@ func (bs *Server) ListBeaconCommittees(
 	case *ethpb.ListCommitteesRequest_Genesis:
 		requestingGenesis = q.Genesis
 	default:
-		startSlot = headSlot
+		startSlot = helpers.StartSlot(helpers.SlotToEpoch(headSlot))
 	}
 
 	var attesterSeed [32]byte
@@ -64,39 +72,46 @@ func (bs *Server) ListBeaconCommittees(
 			)
 		}
 		attesterSeed = bytesutil.ToBytes32(archivedCommitteeInfo.AttesterSeed)
-	} else if !requestingGenesis && helpers.SlotToEpoch(startSlot) == helpers.SlotToEpoch(headSlot) {
-		// Otherwise, we use data from the current epoch.
-		currentEpoch := helpers.SlotToEpoch(headSlot)
-		activeIndices, err = bs.HeadFetcher.HeadValidatorsIndices(currentEpoch)
+	} else if helpers.SlotToEpoch(startSlot) <= helpers.SlotToEpoch(headSlot)+1 {
+		// Otherwise, we use data from the head state for the current or next epoch, as the
+		// active validators and the seed of the next epoch are already known.
+		requestedEpoch := helpers.SlotToEpoch(startSlot)
+		activeIndices, err = bs.HeadFetcher.HeadValidatorsIndices(requestedEpoch)
 		if err != nil {
 			return nil, status.Errorf(
 				codes.Internal,
-				"Could not retrieve active indices for current epoch %d: %v",
-				currentEpoch,
+				"Could not retrieve active indices for epoch %d: %v",
+				requestedEpoch,
 				err,
 			)
 		}
-		attesterSeed, err = bs.HeadFetcher.HeadSeed(currentEpoch)
+		attesterSeed, err = bs.HeadFetcher.HeadSeed(requestedEpoch)
 		if err != nil {
 			return nil, status.Errorf(
 				codes.Internal,
-				"Could not retrieve attester seed for current epoch %d: %v",
-				currentEpoch,
+				"Could not retrieve attester seed for epoch %d: %v",
+				requestedEpoch,
 				err,
 			)
 		}
 	} else {
-		// Otherwise, we are requesting data from the future and we return an error.
+		// Otherwise, we are requesting data beyond the next epoch and we return an error.
 		return nil, status.Errorf(
 			codes.InvalidArgument,
-			"Cannot retrieve information about an epoch in the future, current epoch %d, requesting %d",
+			"Cannot retrieve information about an epoch beyond the next one, current epoch %d, requesting %d",
 			helpers.SlotToEpoch(headSlot),
 			helpers.SlotToEpoch(startSlot),
 		)
 	}
 
+	numSlots := int(params.BeaconConfig().SlotsPerEpoch)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), numSlots)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+
 	committeesList := make(map[uint64]*ethpb.BeaconCommittees_CommitteesList)
-	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+	for slot := startSlot + uint64(start); slot < startSlot+uint64(end); slot++ {
 		var countAtSlot = uint64(len(activeIndices)) / params.BeaconConfig().SlotsPerEpoch / params.BeaconConfig().TargetCommitteeSize
 		if countAtSlot > params.BeaconConfig().MaxCommitteesPerSlot {
 			countAtSlot = params.BeaconConfig().MaxCommitteesPerSlot
@@ -128,5 +143,7 @@ func (bs *Server) ListBeaconCommittees(
 		Epoch:                helpers.SlotToEpoch(startSlot),
 		Committees:           committeesList,
 		ActiveValidatorCount: uint64(len(activeIndices)),
+		NextPageToken:        nextPageToken,
+		TotalSize:            int32(numSlots),
 	}, nil
 }
@@ -14,6 +14,9 @@ import (
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestServer_ListBeaconCommittees(t *testing.T) {
@@ -78,6 +81,7 @@ func TestServer_ListBeaconCommittees(t *testing.T) {
 				Epoch:                0,
 				Committees:           wanted,
 				ActiveValidatorCount: uint64(numValidators),
+				TotalSize:            int32(params.BeaconConfig().SlotsPerEpoch),
 			},
 		},
 	}
@@ -92,6 +96,99 @@ func TestServer_ListBeaconCommittees(t *testing.T) {
 	}
 }
 
+func TestServer_ListBeaconCommittees_CurrentAndNextEpoch(t *testing.T) {
+	numValidators := uint64(256)
+	headState, _ := testutil.DeterministicGenesisState(t, numValidators)
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+	}
+
+	wantedCount := params.BeaconConfig().SlotsPerEpoch * helpers.SlotCommitteeCount(numValidators)
+	for _, epoch := range []uint64{0, 1} {
+		res, err := bs.ListBeaconCommittees(context.Background(), &ethpb.ListCommitteesRequest{
+			QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: epoch},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Epoch != epoch {
+			t.Errorf("Wanted epoch %d, received %d", epoch, res.Epoch)
+		}
+		count := uint64(0)
+		for slot, list := range res.Committees {
+			if helpers.SlotToEpoch(slot) != epoch {
+				t.Errorf("Received committees of slot %d outside of epoch %d", slot, epoch)
+			}
+			count += uint64(len(list.Committees))
+		}
+		if count != wantedCount {
+			t.Errorf("Epoch %d: wanted %d committees, received %d", epoch, wantedCount, count)
+		}
+	}
+
+	_, err := bs.ListBeaconCommittees(context.Background(), &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: 2},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %v error for an epoch beyond the next one, received %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestServer_ListBeaconCommittees_Pagination(t *testing.T) {
+	numValidators := uint64(256)
+	headState, _ := testutil.DeterministicGenesisState(t, numValidators)
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+	}
+	all, err := bs.ListBeaconCommittees(context.Background(), &ethpb.ListCommitteesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pageSize := int32(5)
+	received := make(map[uint64]*ethpb.BeaconCommittees_CommitteesList)
+	req := &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: 0},
+		PageSize:    pageSize,
+	}
+	for {
+		res, err := bs.ListBeaconCommittees(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.TotalSize != int32(params.BeaconConfig().SlotsPerEpoch) {
+			t.Errorf("Wanted total size %d, received %d", params.BeaconConfig().SlotsPerEpoch, res.TotalSize)
+		}
+		if len(res.Committees) > int(pageSize) {
+			t.Errorf("Received committees of %d slots in a page of size %d", len(res.Committees), pageSize)
+		}
+		for slot, list := range res.Committees {
+			if _, ok := received[slot]; ok {
+				t.Errorf("Received committees of slot %d twice", slot)
+			}
+			received[slot] = list
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	if !reflect.DeepEqual(received, all.Committees) {
+		t.Errorf("Wanted the committees of all pages to match the committees of the epoch")
+	}
+
+	_, err = bs.ListBeaconCommittees(context.Background(), &ethpb.ListCommitteesRequest{
+		PageSize: int32(params.BeaconConfig().MaxPageSize + 1),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %v error for a page size above the maximum, received %v", codes.InvalidArgument, err)
+	}
+}
+
 func TestServer_ListBeaconCommittees_FromArchive(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	defer dbTest.TeardownDB(t, db)
@@ -0,0 +1,52 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDepositProof returns the Merkle proof of the deposit at the requested index in the deposit
+// trie of every deposit known to the node, along with the deposit data root used as leaf and the
+// root of the trie. The proof is mixed in with the deposit count, as in the deposit contract, so
+// it verifies against the returned deposit root.
+func (bs *Server) GetDepositProof(ctx context.Context, req *pb.DepositProofRequest) (*pb.DepositProofResponse, error) {
+	deposits := bs.DepositFetcher.AllDeposits(ctx, nil)
+	if req.DepositIndex >= uint64(len(deposits)) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Deposit index %d is out of range, the node knows of %d deposits",
+			req.DepositIndex,
+			len(deposits),
+		)
+	}
+
+	leaves := make([][]byte, len(deposits))
+	for i, dep := range deposits {
+		leaf, err := ssz.HashTreeRoot(dep.Data)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not hash deposit data: %v", err)
+		}
+		leaves[i] = leaf[:]
+	}
+	depositTrie, err := trieutil.GenerateTrieFromItems(leaves, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not generate deposit trie: %v", err)
+	}
+	proof, err := depositTrie.MerkleProof(int(req.DepositIndex))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not generate merkle proof for deposit %d: %v", req.DepositIndex, err)
+	}
+	root := depositTrie.HashTreeRoot()
+	return &pb.DepositProofResponse{
+		Leaf:         leaves[req.DepositIndex],
+		Proof:        proof,
+		DepositRoot:  root[:],
+		DepositCount: uint64(len(deposits)),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetDepositProof(t *testing.T) {
+	ctx := context.Background()
+	deposits, _, _ := testutil.DeterministicDepositsAndKeys(4)
+	depositCache := depositcache.NewDepositCache()
+	for i, dep := range deposits {
+		depositCache.InsertDeposit(ctx, dep, uint64(10+i) /*blockNum*/, int64(i), [32]byte{})
+	}
+	bs := &Server{
+		DepositFetcher: depositCache,
+	}
+
+	var depositRoot []byte
+	for i, dep := range deposits {
+		res, err := bs.GetDepositProof(ctx, &pb.DepositProofRequest{DepositIndex: uint64(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaf, err := ssz.HashTreeRoot(dep.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(res.Leaf, leaf[:]) {
+			t.Errorf("Expected leaf %#x for deposit %d, received %#x", leaf, i, res.Leaf)
+		}
+		if res.DepositCount != uint64(len(deposits)) {
+			t.Errorf("Expected deposit count %d, received %d", len(deposits), res.DepositCount)
+		}
+		if !trieutil.VerifyMerkleProof(res.DepositRoot, res.Leaf, i, res.Proof) {
+			t.Errorf("Could not verify merkle proof of deposit %d", i)
+		}
+		if depositRoot != nil && !bytes.Equal(depositRoot, res.DepositRoot) {
+			t.Errorf("Expected the same deposit root %#x for every deposit, received %#x", depositRoot, res.DepositRoot)
+		}
+		depositRoot = res.DepositRoot
+	}
+}
+
+func TestServer_GetDepositProof_IndexOutOfRange(t *testing.T) {
+	ctx := context.Background()
+	deposits, _, _ := testutil.DeterministicDepositsAndKeys(2)
+	depositCache := depositcache.NewDepositCache()
+	for i, dep := range deposits {
+		depositCache.InsertDeposit(ctx, dep, 10 /*blockNum*/, int64(i), [32]byte{})
+	}
+	bs := &Server{
+		DepositFetcher: depositCache,
+	}
+
+	if _, err := bs.GetDepositProof(ctx, &pb.DepositProofRequest{DepositIndex: 2}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error, received %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package beacon
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDomainData computes the signature domain for the requested epoch and domain type
+// from the fork of the current head state. If the requested epoch falls on or after the
+// next planned fork and the head state has not transitioned to it yet, the domain is
+// computed with the scheduled fork version instead, so validators sign messages for
+// upcoming epochs with the version the chain will have by then.
+func (bs *Server) GetDomainData(ctx context.Context, req *pb.DomainRequest) (*pb.DomainResponse, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	if headState == nil || headState.Fork == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available")
+	}
+	fork := scheduledFork(headState.Fork, req.Epoch)
+	return &pb.DomainResponse{
+		SignatureDomain: helpers.Domain(fork, req.Epoch, req.Domain),
+	}, nil
+}
+
+// GetFork returns the fork of the current head state, which clients need along with the
+// genesis validators root to compute signing domains themselves.
+func (bs *Server) GetFork(ctx context.Context, _ *ptypes.Empty) (*pb.ForkResponse, error) {
+	headState, err := bs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	if headState == nil || headState.Fork == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available")
+	}
+	return &pb.ForkResponse{
+		PreviousVersion: headState.Fork.PreviousVersion,
+		CurrentVersion:  headState.Fork.CurrentVersion,
+		Epoch:           headState.Fork.Epoch,
+	}, nil
+}
+
+// scheduledFork returns the fork to use when signing at epoch. It is the given fork
+// unless the configured next fork is due by epoch and has not been applied yet, in
+// which case the next fork is returned with the given fork's current version as its
+// previous version.
+func scheduledFork(fork *pbp2p.Fork, epoch uint64) *pbp2p.Fork {
+	cfg := params.BeaconConfig()
+	if cfg.NextForkEpoch == cfg.FarFutureEpoch || epoch < cfg.NextForkEpoch || fork.Epoch >= cfg.NextForkEpoch {
+		return fork
+	}
+	return &pbp2p.Fork{
+		PreviousVersion: fork.CurrentVersion,
+		CurrentVersion:  cfg.NextForkVersion,
+		Epoch:           cfg.NextForkEpoch,
+	}
+}
@@ -0,0 +1,133 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetDomainData_GenesisFork(t *testing.T) {
+	genesisVersion := params.BeaconConfig().GenesisForkVersion
+	headState := &pbp2p.BeaconState{
+		Fork: &pbp2p.Fork{
+			PreviousVersion: genesisVersion,
+			CurrentVersion:  genesisVersion,
+			Epoch:           0, /* genesis epoch */
+		},
+	}
+	bs := &Server{HeadFetcher: &mock.ChainService{State: headState}}
+
+	domainType := params.BeaconConfig().DomainBeaconAttester
+	for _, epoch := range []uint64{0, 1, 100} {
+		res, err := bs.GetDomainData(context.Background(), &pb.DomainRequest{Epoch: epoch, Domain: domainType})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := bls.Domain(domainType, genesisVersion)
+		if res.SignatureDomain != want {
+			t.Errorf("Epoch %d: wanted domain %d, received %d", epoch, want, res.SignatureDomain)
+		}
+	}
+}
+
+func TestServer_GetDomainData_ScheduledFork(t *testing.T) {
+	genesisVersion := []byte{0, 0, 0, 0}
+	nextVersion := []byte{1, 0, 0, 0}
+	params.UseMinimalConfig()
+	c := params.MinimalSpecConfig()
+	c.GenesisForkVersion = genesisVersion
+	c.NextForkVersion = nextVersion
+	c.NextForkEpoch = 10
+	params.OverrideBeaconConfig(c)
+	defer params.UseMainnetConfig()
+
+	headState := &pbp2p.BeaconState{
+		Fork: &pbp2p.Fork{
+			PreviousVersion: genesisVersion,
+			CurrentVersion:  genesisVersion,
+			Epoch:           0, /* genesis epoch */
+		},
+	}
+	bs := &Server{HeadFetcher: &mock.ChainService{State: headState}}
+
+	domainType := params.BeaconConfig().DomainBeaconProposer
+	tests := []struct {
+		epoch   uint64
+		version []byte
+	}{
+		{epoch: 0, version: genesisVersion},
+		{epoch: 9, version: genesisVersion},
+		{epoch: 10, version: nextVersion},
+		{epoch: 11, version: nextVersion},
+	}
+	for _, tt := range tests {
+		res, err := bs.GetDomainData(context.Background(), &pb.DomainRequest{Epoch: tt.epoch, Domain: domainType})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := bls.Domain(domainType, tt.version)
+		if res.SignatureDomain != want {
+			t.Errorf("Epoch %d: wanted domain %d, received %d", tt.epoch, want, res.SignatureDomain)
+		}
+	}
+
+	// Once the head state has transitioned to the scheduled fork, its own fork is used,
+	// and epochs before the fork still resolve to the previous version.
+	headState.Fork = &pbp2p.Fork{
+		PreviousVersion: genesisVersion,
+		CurrentVersion:  nextVersion,
+		Epoch:           10,
+	}
+	for _, tt := range tests {
+		res, err := bs.GetDomainData(context.Background(), &pb.DomainRequest{Epoch: tt.epoch, Domain: domainType})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := bls.Domain(domainType, tt.version)
+		if res.SignatureDomain != want {
+			t.Errorf("Epoch %d: wanted domain %d, received %d", tt.epoch, want, res.SignatureDomain)
+		}
+	}
+}
+
+func TestServer_GetFork(t *testing.T) {
+	genesisState, _ := testutil.DeterministicGenesisState(t, 16)
+	genesisState.Fork = &pbp2p.Fork{
+		PreviousVersion: []byte{0, 0, 0, 0},
+		CurrentVersion:  []byte{1, 0, 0, 0},
+		Epoch:           5,
+	}
+	bs := &Server{HeadFetcher: &mock.ChainService{State: genesisState}}
+
+	res, err := bs.GetFork(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.PreviousVersion, genesisState.Fork.PreviousVersion) {
+		t.Errorf("Wanted previous version %#x, received %#x", genesisState.Fork.PreviousVersion, res.PreviousVersion)
+	}
+	if !bytes.Equal(res.CurrentVersion, genesisState.Fork.CurrentVersion) {
+		t.Errorf("Wanted current version %#x, received %#x", genesisState.Fork.CurrentVersion, res.CurrentVersion)
+	}
+	if res.Epoch != genesisState.Fork.Epoch {
+		t.Errorf("Wanted epoch %d, received %d", genesisState.Fork.Epoch, res.Epoch)
+	}
+}
+
+func TestServer_GetFork_NoHeadState(t *testing.T) {
+	bs := &Server{HeadFetcher: &mock.ChainService{State: nil}}
+
+	if _, err := bs.GetFork(context.Background(), &ptypes.Empty{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected %v error, received %v", codes.Unavailable, err)
+	}
+}
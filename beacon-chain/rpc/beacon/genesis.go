@@ -0,0 +1,38 @@
+package beacon
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetGenesis retrieves the genesis time and genesis validators root of the chain, which validator
+// clients need to compute the fork digest and signature domains, along with the address of the
+// deposit contract the chain started from. Both genesis values are read from the genesis state
+// saved in the database.
+func (bs *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*pb.GenesisResponse, error) {
+	genesisState, err := bs.BeaconDB.GenesisState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve genesis state: %v", err)
+	}
+	if genesisState == nil {
+		return nil, status.Error(codes.NotFound, "Genesis state not found, chain has not started yet")
+	}
+	validatorsRoot, err := stateutil.ValidatorRegistryRoot(genesisState.Validators)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute genesis validators root: %v", err)
+	}
+	depositContractAddress, err := bs.BeaconDB.DepositContractAddress(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve deposit contract address: %v", err)
+	}
+	return &pb.GenesisResponse{
+		GenesisTime:            genesisState.GenesisTime,
+		GenesisValidatorsRoot:  validatorsRoot[:],
+		DepositContractAddress: depositContractAddress,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/go-ssz"
+	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestServer_GetGenesis(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisTime := uint64(1578000000)
+	deposits, _, _ := testutil.DeterministicDepositsAndKeys(64)
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisState, err := state.GenesisBeaconState(deposits, genesisTime, eth1Data)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	if err := db.SaveDepositContractAddress(ctx, addr); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &Server{BeaconDB: db}
+	res, err := bs.GetGenesis(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GenesisTime != genesisTime {
+		t.Errorf("Wanted genesis time %d, received %d", genesisTime, res.GenesisTime)
+	}
+	wantedRoot, err := stateutil.ValidatorRegistryRoot(genesisState.Validators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.GenesisValidatorsRoot, wantedRoot[:]) {
+		t.Errorf("Wanted genesis validators root %#x, received %#x", wantedRoot, res.GenesisValidatorsRoot)
+	}
+	if !bytes.Equal(res.DepositContractAddress, addr.Bytes()) {
+		t.Errorf("Wanted deposit contract address %#x, received %#x", addr.Bytes(), res.DepositContractAddress)
+	}
+}
+
+func TestServer_GetGenesis_NotStarted(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+
+	bs := &Server{BeaconDB: db}
+	wanted := "Genesis state not found"
+	if _, err := bs.GetGenesis(context.Background(), &ptypes.Empty{}); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
@@ -6,10 +6,12 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 )
@@ -21,9 +23,12 @@ type Server struct {
 	BeaconDB             db.ReadOnlyDatabase
 	Ctx                  context.Context
 	ChainStartFetcher    powchain.ChainStartFetcher
+	DepositFetcher       depositcache.DepositFetcher
 	HeadFetcher          blockchain.HeadFetcher
 	FinalizationFetcher  blockchain.FinalizationFetcher
 	ParticipationFetcher blockchain.ParticipationFetcher
+	SyncChecker          sync.Checker
+	SyncProgressFetcher  sync.ProgressFetcher
 	StateNotifier        statefeed.Notifier
 	Pool                 attestations.Pool
 	IncomingAttestation  chan *ethpb.Attestation
@@ -0,0 +1,53 @@
+package beacon
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ComputeSigningRoot decodes the SSZ encoded object of the request and returns the root and the
+// domain a remote signer signs it with. The domain type follows from the object type, and the fork
+// version from the fork schedule of the head state at the requested epoch, as in GetDomainData.
+func (bs *Server) ComputeSigningRoot(ctx context.Context, req *pb.SigningRootRequest) (*pb.SigningRootResponse, error) {
+	var obj interface{}
+	var domainType []byte
+	switch req.ObjectType {
+	case pb.SigningRootRequest_ATTESTATION_DATA:
+		obj, domainType = &ethpb.AttestationData{}, params.BeaconConfig().DomainBeaconAttester
+	case pb.SigningRootRequest_BEACON_BLOCK:
+		obj, domainType = &ethpb.BeaconBlock{}, params.BeaconConfig().DomainBeaconProposer
+	case pb.SigningRootRequest_BEACON_BLOCK_HEADER:
+		obj, domainType = &ethpb.BeaconBlockHeader{}, params.BeaconConfig().DomainBeaconProposer
+	case pb.SigningRootRequest_VOLUNTARY_EXIT:
+		obj, domainType = &ethpb.VoluntaryExit{}, params.BeaconConfig().DomainVoluntaryExit
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported object type %v", req.ObjectType)
+	}
+	if err := ssz.Unmarshal(req.Object, obj); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not decode %v: %v", req.ObjectType, err)
+	}
+	root, err := ssz.HashTreeRoot(obj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute root of %v: %v", req.ObjectType, err)
+	}
+
+	headState, err := bs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	if headState == nil || headState.Fork == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available")
+	}
+	fork := scheduledFork(headState.Fork, req.Epoch)
+	return &pb.SigningRootResponse{
+		SigningRoot:     root[:],
+		SignatureDomain: helpers.Domain(fork, req.Epoch, domainType),
+	}, nil
+}
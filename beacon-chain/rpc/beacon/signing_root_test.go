@@ -0,0 +1,102 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_ComputeSigningRoot(t *testing.T) {
+	genesisVersion := params.BeaconConfig().GenesisForkVersion
+	headState := &pbp2p.BeaconState{
+		Fork: &pbp2p.Fork{
+			PreviousVersion: genesisVersion,
+			CurrentVersion:  genesisVersion,
+			Epoch:           0,
+		},
+	}
+	bs := &Server{HeadFetcher: &mock.ChainService{State: headState}}
+
+	tests := []struct {
+		name       string
+		objectType pb.SigningRootRequest_ObjectType
+		obj        interface{}
+		domainType []byte
+	}{
+		{
+			name:       "attestation data",
+			objectType: pb.SigningRootRequest_ATTESTATION_DATA,
+			obj: &ethpb.AttestationData{
+				Slot:            3,
+				CommitteeIndex:  1,
+				BeaconBlockRoot: bytes.Repeat([]byte{'a'}, 32),
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: bytes.Repeat([]byte{'b'}, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 1, Root: bytes.Repeat([]byte{'c'}, 32)},
+			},
+			domainType: params.BeaconConfig().DomainBeaconAttester,
+		},
+		{
+			name:       "block header",
+			objectType: pb.SigningRootRequest_BEACON_BLOCK_HEADER,
+			obj: &ethpb.BeaconBlockHeader{
+				Slot:       9,
+				ParentRoot: bytes.Repeat([]byte{'d'}, 32),
+				StateRoot:  bytes.Repeat([]byte{'e'}, 32),
+				BodyRoot:   bytes.Repeat([]byte{'f'}, 32),
+			},
+			domainType: params.BeaconConfig().DomainBeaconProposer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := ssz.Marshal(tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := bs.ComputeSigningRoot(context.Background(), &pb.SigningRootRequest{
+				ObjectType: tt.objectType,
+				Object:     enc,
+				Epoch:      1,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantRoot, err := ssz.HashTreeRoot(tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(res.SigningRoot, wantRoot[:]) {
+				t.Errorf("Wanted signing root %#x, received %#x", wantRoot, res.SigningRoot)
+			}
+			if want := bls.Domain(tt.domainType, genesisVersion); res.SignatureDomain != want {
+				t.Errorf("Wanted domain %d, received %d", want, res.SignatureDomain)
+			}
+		})
+	}
+}
+
+func TestServer_ComputeSigningRoot_InvalidObject(t *testing.T) {
+	headState := &pbp2p.BeaconState{Fork: &pbp2p.Fork{}}
+	bs := &Server{HeadFetcher: &mock.ChainService{State: headState}}
+
+	if _, err := bs.ComputeSigningRoot(context.Background(), &pb.SigningRootRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted code %v for an unknown object type, received %v", codes.InvalidArgument, err)
+	}
+	req := &pb.SigningRootRequest{
+		ObjectType: pb.SigningRootRequest_VOLUNTARY_EXIT,
+		Object:     []byte{1, 2, 3},
+	}
+	if _, err := bs.ComputeSigningRoot(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted code %v for a malformed object, received %v", codes.InvalidArgument, err)
+	}
+}
@@ -0,0 +1,51 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// GetSpec retrieves the beacon chain config the node is running with, such as the mainnet or
+// minimal config along with any override, so clients can check they use the same values. Values
+// are keyed by their name in the spec config files when they have one, or by their field name
+// otherwise. Byte values are hex encoded.
+func (bs *Server) GetSpec(ctx context.Context, _ *ptypes.Empty) (*pb.SpecResponse, error) {
+	return &pb.SpecResponse{Data: configValues(params.BeaconConfig())}, nil
+}
+
+// configValues returns the string representation of every exported field of the config.
+func configValues(cfg *params.BeaconChainConfig) map[string]string {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+	data := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		key := field.Tag.Get("yaml")
+		if key == "" {
+			key = field.Name
+		}
+		value := v.Field(i)
+		switch {
+		case (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && field.Type.Elem().Kind() == reflect.Uint8:
+			data[key] = fmt.Sprintf("%#x", byteSlice(value))
+		default:
+			data[key] = fmt.Sprintf("%v", value.Interface())
+		}
+	}
+	return data
+}
+
+// byteSlice returns the bytes of a byte slice or array value.
+func byteSlice(value reflect.Value) []byte {
+	b := make([]byte, value.Len())
+	reflect.Copy(reflect.ValueOf(b), value)
+	return b
+}
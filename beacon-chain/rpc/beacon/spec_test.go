@@ -0,0 +1,37 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestServer_GetSpec(t *testing.T) {
+	params.UseMinimalConfig()
+	defer params.UseMainnetConfig()
+
+	bs := &Server{}
+	res, err := bs.GetSpec(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := map[string]string{
+		"SLOTS_PER_EPOCH":       "8",
+		"SECONDS_PER_SLOT":      "6",
+		"GENESIS_FORK_VERSION":  "0x00000000",
+		"TARGET_COMMITTEE_SIZE": "4",
+		"MaxCommitteesPerSlot":  "4",
+	}
+	for key, value := range wanted {
+		got, ok := res.Data[key]
+		if !ok {
+			t.Errorf("Expected %s in spec", key)
+			continue
+		}
+		if got != value {
+			t.Errorf("Wanted %s to be %s, received %s", key, value, got)
+		}
+	}
+}
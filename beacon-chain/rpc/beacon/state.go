@@ -0,0 +1,111 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// stateChunkSize is the maximum number of bytes of an SSZ encoded state sent in a single
+// message, keeping every message well below the default gRPC message size limit.
+const stateChunkSize = 1 << 20
+
+// StateEncodingMetadataKey is the gRPC metadata key with which a client of GetBeaconStateSSZ
+// lists the state encodings it accepts, and with which the server reports, in the response
+// header, the encoding of the streamed state. The supported encodings are the ones of the p2p
+// layer: encoder.SSZ, the default, and encoder.SSZSnappy.
+const StateEncodingMetadataKey = "state-encoding"
+
+// GetBeaconStateSSZ streams the SSZ encoding of a beacon state saved in the database, in chunks
+// of at most stateChunkSize bytes. The state is retrieved either by the root it was saved under
+// or by slot, in which case the state of the canonical block at or before the slot is returned.
+// The encoding is compressed with snappy if the client accepts it, see StateEncodingMetadataKey,
+// in which case the chunks and their sizes are the ones of the compressed encoding.
+func (bs *Server) GetBeaconStateSSZ(req *pb.BeaconStateRequest, stream pb.BeaconChainService_GetBeaconStateSSZServer) error {
+	ctx := stream.Context()
+
+	var root [32]byte
+	switch q := req.QueryFilter.(type) {
+	case *pb.BeaconStateRequest_StateRoot:
+		root = bytesutil.ToBytes32(q.StateRoot)
+	case *pb.BeaconStateRequest_Slot:
+		headState, err := bs.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not get head state: %v", err)
+		}
+		if q.Slot > headState.Slot {
+			return status.Errorf(
+				codes.InvalidArgument,
+				"Cannot retrieve state of a future slot, head slot %d, requesting %d",
+				headState.Slot,
+				q.Slot,
+			)
+		}
+		r, ok, err := bs.canonicalRootAtSlot(ctx, headState, q.Slot)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not retrieve block root at slot %d: %v", q.Slot, err)
+		}
+		if !ok {
+			return status.Errorf(codes.NotFound, "No canonical block at slot %d", q.Slot)
+		}
+		root = r
+	default:
+		return status.Error(codes.InvalidArgument, "Must specify a state root or slot")
+	}
+
+	st, err := bs.BeaconDB.State(ctx, root)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not retrieve state: %v", err)
+	}
+	if st == nil {
+		return status.Errorf(codes.NotFound, "No state found at root %#x", root)
+	}
+	enc, err := ssz.Marshal(st)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not marshal state: %v", err)
+	}
+	encoding := encoder.SSZ
+	if acceptsStateEncoding(ctx, encoder.SSZSnappy) {
+		encoding = encoder.SSZSnappy
+		enc = snappy.Encode(nil /*dst*/, enc)
+	}
+	if err := stream.SetHeader(metadata.Pairs(StateEncodingMetadataKey, encoding)); err != nil {
+		return status.Errorf(codes.Internal, "Could not set response header: %v", err)
+	}
+	for offset := 0; offset < len(enc); offset += stateChunkSize {
+		end := offset + stateChunkSize
+		if end > len(enc) {
+			end = len(enc)
+		}
+		chunk := &pb.BeaconStateChunk{
+			Data:      enc[offset:end],
+			Offset:    uint64(offset),
+			TotalSize: uint64(len(enc)),
+		}
+		if err := stream.Send(chunk); err != nil {
+			return status.Errorf(codes.Internal, "Could not send state chunk over stream: %v", err)
+		}
+	}
+	return nil
+}
+
+// acceptsStateEncoding returns whether the client listed the encoding in the request metadata.
+func acceptsStateEncoding(ctx context.Context, encoding string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, e := range md.Get(StateEncodingMetadataKey) {
+		if e == encoding {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package beacon
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/go-ssz"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// stateStream is a fake state stream collecting every chunk sent over it.
+type stateStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+	chunks []*pb.BeaconStateChunk
+}
+
+func (s *stateStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *stateStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stateStream) Send(chunk *pb.BeaconStateChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestServer_GetBeaconStateSSZ_ByRoot(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	root := [32]byte{'a'}
+	if err := db.SaveState(ctx, beaconState, root); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &Server{BeaconDB: db}
+	stream := &stateStream{ctx: ctx}
+	req := &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_StateRoot{StateRoot: root[:]}}
+	if err := bs.GetBeaconStateSSZ(req, stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.chunks) < 2 {
+		t.Errorf("Expected the state to be split in several chunks, received %d", len(stream.chunks))
+	}
+	if got := stream.header.Get(StateEncodingMetadataKey); len(got) != 1 || got[0] != encoder.SSZ {
+		t.Errorf("Expected %s encoding in the response header, received %v", encoder.SSZ, got)
+	}
+
+	var enc []byte
+	for _, chunk := range stream.chunks {
+		if chunk.Offset != uint64(len(enc)) {
+			t.Fatalf("Expected chunk at offset %d, received offset %d", len(enc), chunk.Offset)
+		}
+		if len(chunk.Data) > stateChunkSize {
+			t.Errorf("Chunk of %d bytes exceeds the chunk size %d", len(chunk.Data), stateChunkSize)
+		}
+		enc = append(enc, chunk.Data...)
+	}
+	if stream.chunks[0].TotalSize != uint64(len(enc)) {
+		t.Errorf("Expected total size %d, received %d", len(enc), stream.chunks[0].TotalSize)
+	}
+	decoded := &pbp2p.BeaconState{}
+	if err := ssz.Unmarshal(enc, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(decoded, beaconState) {
+		t.Error("Decoded state is not equal to the saved state")
+	}
+}
+
+func TestServer_GetBeaconStateSSZ_SnappyRoundTrip(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	root := [32]byte{'a'}
+	if err := db.SaveState(ctx, beaconState, root); err != nil {
+		t.Fatal(err)
+	}
+	uncompressed, err := ssz.Marshal(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &Server{BeaconDB: db}
+	md := metadata.Pairs(StateEncodingMetadataKey, encoder.SSZSnappy)
+	stream := &stateStream{ctx: metadata.NewIncomingContext(ctx, md)}
+	req := &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_StateRoot{StateRoot: root[:]}}
+	if err := bs.GetBeaconStateSSZ(req, stream); err != nil {
+		t.Fatal(err)
+	}
+	if got := stream.header.Get(StateEncodingMetadataKey); len(got) != 1 || got[0] != encoder.SSZSnappy {
+		t.Fatalf("Expected %s encoding in the response header, received %v", encoder.SSZSnappy, got)
+	}
+
+	var enc []byte
+	for _, chunk := range stream.chunks {
+		enc = append(enc, chunk.Data...)
+	}
+	if stream.chunks[0].TotalSize != uint64(len(enc)) {
+		t.Errorf("Expected total size %d, received %d", len(enc), stream.chunks[0].TotalSize)
+	}
+	if len(enc) >= len(uncompressed) {
+		t.Errorf("Expected the compressed state to be smaller than %d bytes, received %d", len(uncompressed), len(enc))
+	}
+	decompressed, err := snappy.Decode(nil /*dst*/, enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &pbp2p.BeaconState{}
+	if err := ssz.Unmarshal(decompressed, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(decoded, beaconState) {
+		t.Error("Decoded state is not equal to the saved state")
+	}
+}
+
+func TestServer_GetBeaconStateSSZ_UnknownRoot(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &Server{BeaconDB: db}
+	stream := &stateStream{ctx: ctx}
+	req := &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_StateRoot{StateRoot: []byte{'b'}}}
+	err := bs.GetBeaconStateSSZ(req, stream)
+	if err == nil || !strings.Contains(err.Error(), "No state found") {
+		t.Errorf("Expected not found error, received %v", err)
+	}
+	if len(stream.chunks) != 0 {
+		t.Errorf("Expected no chunks to be sent, received %d", len(stream.chunks))
+	}
+}
+
+func TestServer_GetBeaconStateSSZ_ImportRoundTrip(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	importDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, importDB)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	root := [32]byte{'a'}
+	if err := db.SaveState(ctx, beaconState, root); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &Server{BeaconDB: db}
+	stream := &stateStream{ctx: ctx}
+	req := &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_StateRoot{StateRoot: root[:]}}
+	if err := bs.GetBeaconStateSSZ(req, stream); err != nil {
+		t.Fatal(err)
+	}
+	var enc []byte
+	for _, chunk := range stream.chunks {
+		enc = append(enc, chunk.Data...)
+	}
+	if err := importDB.SaveStateFromSSZ(ctx, enc); err != nil {
+		t.Fatal(err)
+	}
+	headState, err := importDB.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(headState, beaconState) {
+		t.Error("Imported state is not equal to the exported state")
+	}
+}
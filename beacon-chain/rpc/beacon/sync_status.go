@@ -0,0 +1,35 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSyncStatus reports whether the node is syncing, its head slot and the highest slot known
+// from its peers. While the head is behind, the response estimates the time left to catch up
+// from the recent block processing rate, unless no block was processed recently.
+func (bs *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*pb.SyncStatusResponse, error) {
+	if bs.SyncChecker == nil || bs.SyncProgressFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Sync service is not available")
+	}
+	headSlot := bs.HeadFetcher.HeadSlot()
+	highestSlot := bs.SyncProgressFetcher.HighestKnownSlot()
+	if highestSlot < headSlot {
+		highestSlot = headSlot
+	}
+	res := &pb.SyncStatusResponse{
+		Syncing:          bs.SyncChecker.Syncing(),
+		HeadSlot:         headSlot,
+		HighestKnownSlot: highestSlot,
+	}
+	if rate, ok := bs.SyncProgressFetcher.BlocksPerSecond(); ok && rate > 0 && highestSlot > headSlot {
+		remaining := time.Duration(float64(highestSlot-headSlot) / rate * float64(time.Second))
+		res.EstimatedTimeToSync = ptypes.DurationProto(remaining)
+	}
+	return res, nil
+}
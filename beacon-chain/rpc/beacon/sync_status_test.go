@@ -0,0 +1,46 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestServer_GetSyncStatus(t *testing.T) {
+	syncService := &mockSync.Sync{IsSyncing: true, HighestSlot: 1000, BlocksRate: 10}
+	bs := &Server{
+		HeadFetcher:         &mock.ChainService{State: &pbp2p.BeaconState{Slot: 200}},
+		SyncChecker:         syncService,
+		SyncProgressFetcher: syncService,
+	}
+
+	res, err := bs.GetSyncStatus(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Syncing || res.HeadSlot != 200 || res.HighestKnownSlot != 1000 {
+		t.Errorf("Wanted syncing from slot 200 to slot 1000, received %v", res)
+	}
+	estimate, err := ptypes.DurationFromProto(res.EstimatedTimeToSync)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if estimate != 80*time.Second {
+		t.Errorf("Wanted an estimated time to sync of %v, received %v", 80*time.Second, estimate)
+	}
+
+	// Without a recent block processing rate, the estimate is omitted.
+	syncService.BlocksRate = 0
+	res, err = bs.GetSyncStatus(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.EstimatedTimeToSync != nil {
+		t.Errorf("Wanted no estimated time to sync, received %v", res.EstimatedTimeToSync)
+	}
+}
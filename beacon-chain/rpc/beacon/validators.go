@@ -59,7 +59,7 @@ func (bs *Server) ListValidatorBalances(
 			return nil, status.Errorf(
 				codes.NotFound,
 				"Could not retrieve data for epoch %d, perhaps --archive in the running beacon node is disabled",
-				0,
+				epoch,
 			)
 		}
 	} else if epoch == helpers.CurrentEpoch(headState) {
@@ -307,8 +307,8 @@ func (bs *Server) GetValidator(
 
 // GetValidatorActiveSetChanges retrieves the active set changes for a given epoch.
 //
-// This data includes any activations, voluntary exits, and involuntary
-// ejections.
+// This data includes any activations, voluntary exits, slashings, and involuntary
+// ejections, reported both as validator indices and public keys.
 func (bs *Server) GetValidatorActiveSetChanges(
 	ctx context.Context, req *ethpb.GetValidatorActiveSetChangesRequest,
 ) (*ethpb.ActiveSetChanges, error) {
@@ -367,24 +367,48 @@ func (bs *Server) GetValidatorActiveSetChanges(
 		)
 	}
 
+	// Validators are ejected once their effective balance drops to the ejection balance, an exited
+	// validator no longer earns rewards so its effective balance tells ejections and voluntary exits apart.
+	voluntaryExitIndices := make([]uint64, 0)
+	ejectedIndices := make([]uint64, 0)
+	for _, idx := range exitedIndices {
+		if idx >= uint64(len(headState.Validators)) {
+			return nil, status.Errorf(codes.Internal, "Exited validator index %d out of range", idx)
+		}
+		if headState.Validators[idx].EffectiveBalance <= params.BeaconConfig().EjectionBalance {
+			ejectedIndices = append(ejectedIndices, idx)
+		} else {
+			voluntaryExitIndices = append(voluntaryExitIndices, idx)
+		}
+	}
+
 	// We retrieve the public keys for the indices.
 	activatedKeys := make([][]byte, len(activatedIndices))
 	slashedKeys := make([][]byte, len(slashedIndices))
-	exitedKeys := make([][]byte, len(exitedIndices))
+	exitedKeys := make([][]byte, len(voluntaryExitIndices))
+	ejectedKeys := make([][]byte, len(ejectedIndices))
 	for i, idx := range activatedIndices {
 		activatedKeys[i] = headState.Validators[idx].PublicKey
 	}
 	for i, idx := range slashedIndices {
 		slashedKeys[i] = headState.Validators[idx].PublicKey
 	}
-	for i, idx := range exitedIndices {
+	for i, idx := range voluntaryExitIndices {
 		exitedKeys[i] = headState.Validators[idx].PublicKey
 	}
+	for i, idx := range ejectedIndices {
+		ejectedKeys[i] = headState.Validators[idx].PublicKey
+	}
 	return &ethpb.ActiveSetChanges{
 		Epoch:               requestedEpoch,
 		ActivatedPublicKeys: activatedKeys,
+		ActivatedIndices:    activatedIndices,
 		ExitedPublicKeys:    exitedKeys,
+		ExitedIndices:       voluntaryExitIndices,
 		SlashedPublicKeys:   slashedKeys,
+		SlashedIndices:      slashedIndices,
+		EjectedPublicKeys:   ejectedKeys,
+		EjectedIndices:      ejectedIndices,
 	}, nil
 }
 
@@ -424,7 +448,7 @@ func (bs *Server) GetValidatorParticipation(
 			return nil, status.Errorf(
 				codes.NotFound,
 				"Could not retrieve data for epoch %d, perhaps --archive in the running beacon node is disabled",
-				0,
+				requestedEpoch,
 			)
 		}
 		return &ethpb.ValidatorParticipationResponse{
@@ -471,7 +495,9 @@ func (bs *Server) GetValidatorParticipation(
 	}, nil
 }
 
-// GetValidatorQueue retrieves the current validator queue information.
+// GetValidatorQueue retrieves the current validator queue information: the validators awaiting
+// activation ordered by activation eligibility epoch then index, the validators awaiting exit
+// ordered by withdrawable epoch then index, and the churn limit of the current epoch.
 func (bs *Server) GetValidatorQueue(
 	ctx context.Context, _ *ptypes.Empty,
 ) (*ethpb.ValidatorQueue, error) {
@@ -495,11 +521,20 @@ func (bs *Server) GetValidatorQueue(
 			awaitingExit = append(awaitingExit, uint64(idx))
 		}
 	}
+	// Ties are broken by validator index, as in the activation queue of the state transition.
 	sort.Slice(activationQ, func(i, j int) bool {
-		return headState.Validators[i].ActivationEligibilityEpoch < headState.Validators[j].ActivationEligibilityEpoch
+		vi, vj := headState.Validators[activationQ[i]], headState.Validators[activationQ[j]]
+		if vi.ActivationEligibilityEpoch == vj.ActivationEligibilityEpoch {
+			return activationQ[i] < activationQ[j]
+		}
+		return vi.ActivationEligibilityEpoch < vj.ActivationEligibilityEpoch
 	})
 	sort.Slice(awaitingExit, func(i, j int) bool {
-		return headState.Validators[i].WithdrawableEpoch < headState.Validators[j].WithdrawableEpoch
+		vi, vj := headState.Validators[awaitingExit[i]], headState.Validators[awaitingExit[j]]
+		if vi.WithdrawableEpoch == vj.WithdrawableEpoch {
+			return awaitingExit[i] < awaitingExit[j]
+		}
+		return vi.WithdrawableEpoch < vj.WithdrawableEpoch
 	})
 
 	// Only activate just enough validators according to the activation churn limit.
@@ -555,9 +590,11 @@ func (bs *Server) GetValidatorQueue(
 	}
 
 	return &ethpb.ValidatorQueue{
-		ChurnLimit:           churnLimit,
-		ActivationPublicKeys: activationQueueKeys,
-		ExitPublicKeys:       exitQueueKeys,
+		ChurnLimit:                 churnLimit,
+		ActivationPublicKeys:       activationQueueKeys,
+		ExitPublicKeys:             exitQueueKeys,
+		ActivationValidatorIndices: activationQ,
+		ExitValidatorIndices:       exitQueueIndices,
 	}, nil
 }
 
@@ -583,7 +620,8 @@ func (bs *Server) GetValidatorPerformance(
 	missingValidators := make([][]byte, 0)
 	for i, key := range req.PublicKeys {
 		index, ok, err := bs.BeaconDB.ValidatorIndex(ctx, key)
-		if err != nil || !ok {
+		// A validator whose deposit was indexed but which is not in the state yet is missing too.
+		if err != nil || !ok || index >= uint64(len(headState.Balances)) {
 			missingValidators = append(missingValidators, key)
 			balances[i] = 0
 			continue
@@ -601,7 +639,10 @@ func (bs *Server) GetValidatorPerformance(
 		return nil, status.Errorf(codes.Internal, "Could not retrieve total active balance: %v", err)
 	}
 
-	avgBalance := float32(totalActiveBalance / activeCount)
+	var avgBalance float32
+	if activeCount > 0 {
+		avgBalance = float32(totalActiveBalance / activeCount)
+	}
 	return &ethpb.ValidatorPerformanceResponse{
 		Balances:                      balances,
 		AverageActiveValidatorBalance: avgBalance,
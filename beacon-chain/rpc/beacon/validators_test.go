@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
@@ -17,10 +18,14 @@ import (
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -380,6 +385,73 @@ func TestServer_ListValidatorBalances_Pagination_CustomPageSizes(t *testing.T) {
 	}
 }
 
+func TestServer_ListValidatorBalances_Pagination_AllPages(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+
+	count := 100
+	setupValidators(t, db, count)
+
+	headState, err := db.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+
+	filtered := make([]uint64, 0, count/2)
+	for i := 0; i < count; i += 2 {
+		filtered = append(filtered, uint64(i))
+	}
+	tests := []struct {
+		req    *ethpb.ListValidatorBalancesRequest
+		wanted []uint64
+	}{
+		{req: &ethpb.ListValidatorBalancesRequest{PageSize: 7}},
+		{req: &ethpb.ListValidatorBalancesRequest{Indices: filtered, PageSize: 8}, wanted: filtered},
+	}
+	for _, test := range tests {
+		wanted := test.wanted
+		if wanted == nil {
+			wanted = make([]uint64, count)
+			for i := range wanted {
+				wanted[i] = uint64(i)
+			}
+		}
+		seen := make(map[uint64]bool)
+		received := make([]uint64, 0, len(wanted))
+		for {
+			res, err := bs.ListValidatorBalances(context.Background(), test.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Balances) > int(test.req.PageSize) {
+				t.Fatalf("Expected at most %d balances in a page, received %d", test.req.PageSize, len(res.Balances))
+			}
+			for _, b := range res.Balances {
+				if seen[b.Index] {
+					t.Fatalf("Validator %d returned more than once", b.Index)
+				}
+				seen[b.Index] = true
+				if b.Balance != b.Index {
+					t.Errorf("Expected balance %d for validator %d, received %d", b.Index, b.Index, b.Balance)
+				}
+				received = append(received, b.Index)
+			}
+			if res.NextPageToken == "" {
+				break
+			}
+			test.req.PageToken = res.NextPageToken
+		}
+		if !reflect.DeepEqual(received, wanted) {
+			t.Errorf("Expected validators %v across all pages, received %v", wanted, received)
+		}
+	}
+}
+
 func TestServer_ListValidatorBalances_OutOfRange(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	defer dbTest.TeardownDB(t, db)
@@ -402,6 +474,31 @@ func TestServer_ListValidatorBalances_OutOfRange(t *testing.T) {
 	}
 }
 
+func TestServer_ListValidatorBalances_FromArchive_NotArchived(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	validators, balances := setupValidators(t, db, 10)
+
+	bs := &Server{
+		BeaconDB: db,
+		HeadFetcher: &mock.ChainService{
+			State: &pbp2p.BeaconState{
+				Slot:       params.BeaconConfig().SlotsPerEpoch * 3,
+				Validators: validators,
+				Balances:   balances,
+			},
+		},
+	}
+
+	req := &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 2},
+	}
+	wanted := "Could not retrieve data for epoch 2"
+	if _, err := bs.ListValidatorBalances(context.Background(), req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
 func TestServer_ListValidatorBalances_FromArchive(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	defer dbTest.TeardownDB(t, db)
@@ -989,6 +1086,48 @@ func TestServer_GetValidator(t *testing.T) {
 	}
 }
 
+func TestServer_GetValidator_GenesisRecord(t *testing.T) {
+	headState, _ := testutil.DeterministicGenesisState(t, 16)
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs := &Server{HeadFetcher: &mock.ChainService{State: headState}}
+
+	idx := uint64(7)
+	want := &ethpb.Validator{
+		PublicKey:                  deposits[idx].Data.PublicKey,
+		WithdrawalCredentials:      deposits[idx].Data.WithdrawalCredentials,
+		EffectiveBalance:           params.BeaconConfig().MaxEffectiveBalance,
+		Slashed:                    false,
+		ActivationEligibilityEpoch: 0,
+		ActivationEpoch:            0,
+		ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
+	}
+	for _, req := range []*ethpb.GetValidatorRequest{
+		{QueryFilter: &ethpb.GetValidatorRequest_Index{Index: idx}},
+		{QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: deposits[idx].Data.PublicKey}},
+	} {
+		res, err := bs.GetValidator(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(res, want) {
+			t.Errorf("Wanted %v, received %v", want, res)
+		}
+	}
+
+	unknownKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	copy(unknownKey, "unknown")
+	_, err = bs.GetValidator(context.Background(), &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: unknownKey},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected %v error for an unknown public key, received %v", codes.NotFound, err)
+	}
+}
+
 func TestServer_GetValidatorActiveSetChanges_CannotRequestFutureEpoch(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	defer dbTest.TeardownDB(t, db)
@@ -1041,6 +1180,7 @@ func TestServer_GetValidatorActiveSetChanges(t *testing.T) {
 		headState.Validators[i] = &ethpb.Validator{
 			ActivationEpoch:   activationEpoch,
 			PublicKey:         []byte(strconv.Itoa(i)),
+			EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
 			WithdrawableEpoch: withdrawableEpoch,
 			Slashed:           slashed,
 			ExitEpoch:         exitEpoch,
@@ -1072,8 +1212,75 @@ func TestServer_GetValidatorActiveSetChanges(t *testing.T) {
 	wanted := &ethpb.ActiveSetChanges{
 		Epoch:               0,
 		ActivatedPublicKeys: wantedActive,
+		ActivatedIndices:    []uint64{0, 2, 4},
 		ExitedPublicKeys:    wantedExited,
+		ExitedIndices:       []uint64{5},
 		SlashedPublicKeys:   wantedSlashed,
+		SlashedIndices:      []uint64{3},
+	}
+	if !proto.Equal(wanted, res) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
+
+func TestServer_GetValidatorActiveSetChanges_SlashedAndEjected(t *testing.T) {
+	ctx := context.Background()
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	headState := &pbp2p.BeaconState{
+		Slot: 0,
+		Validators: []*ethpb.Validator{
+			// Active since genesis, no change.
+			{
+				PublicKey:         []byte("0"),
+				EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
+				ActivationEpoch:   0,
+				ExitEpoch:         farFuture,
+				WithdrawableEpoch: farFuture,
+			},
+			// Newly activated.
+			{
+				PublicKey:         []byte("1"),
+				EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
+				ActivationEpoch:   helpers.DelayedActivationExitEpoch(0),
+				ExitEpoch:         farFuture,
+				WithdrawableEpoch: farFuture,
+			},
+			// Slashed.
+			{
+				PublicKey:         []byte("2"),
+				EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
+				ActivationEpoch:   0,
+				ExitEpoch:         farFuture,
+				WithdrawableEpoch: params.BeaconConfig().EpochsPerSlashingsVector,
+				Slashed:           true,
+			},
+			// Ejected with an effective balance at the ejection balance.
+			{
+				PublicKey:         []byte("3"),
+				EffectiveBalance:  params.BeaconConfig().EjectionBalance,
+				ActivationEpoch:   0,
+				ExitEpoch:         0,
+				WithdrawableEpoch: params.BeaconConfig().MinValidatorWithdrawabilityDelay,
+			},
+		},
+	}
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+	}
+	res, err := bs.GetValidatorActiveSetChanges(ctx, &ethpb.GetValidatorActiveSetChangesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &ethpb.ActiveSetChanges{
+		Epoch:               0,
+		ActivatedPublicKeys: [][]byte{[]byte("1")},
+		ActivatedIndices:    []uint64{1},
+		SlashedPublicKeys:   [][]byte{[]byte("2")},
+		SlashedIndices:      []uint64{2},
+		EjectedPublicKeys:   [][]byte{[]byte("3")},
+		EjectedIndices:      []uint64{3},
 	}
 	if !proto.Equal(wanted, res) {
 		t.Errorf("Wanted %v, received %v", wanted, res)
@@ -1104,7 +1311,8 @@ func TestServer_GetValidatorActiveSetChanges_FromArchive(t *testing.T) {
 			exitedIndices = append(exitedIndices, uint64(i))
 		}
 		headState.Validators[i] = &ethpb.Validator{
-			PublicKey: []byte(strconv.Itoa(i)),
+			PublicKey:        []byte(strconv.Itoa(i)),
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
 		}
 	}
 	archivedChanges := &pbp2p.ArchivedActiveSetChanges{
@@ -1146,8 +1354,11 @@ func TestServer_GetValidatorActiveSetChanges_FromArchive(t *testing.T) {
 	wanted := &ethpb.ActiveSetChanges{
 		Epoch:               0,
 		ActivatedPublicKeys: wantedActive,
+		ActivatedIndices:    []uint64{0, 2, 4},
 		ExitedPublicKeys:    wantedExited,
+		ExitedIndices:       []uint64{5},
 		SlashedPublicKeys:   wantedSlashed,
+		SlashedIndices:      []uint64{3},
 	}
 	if !proto.Equal(wanted, res) {
 		t.Errorf("Wanted %v, received %v", wanted, res)
@@ -1218,6 +1429,55 @@ func TestServer_GetValidatorQueue_PendingActivation(t *testing.T) {
 	}
 }
 
+func TestServer_GetValidatorQueue_ActivationOrder(t *testing.T) {
+	eligibilityEpochs := []uint64{2, 1, 2, 1, 3}
+	validators := make([]*ethpb.Validator, len(eligibilityEpochs))
+	for i, epoch := range eligibilityEpochs {
+		validators[i] = &ethpb.Validator{
+			ActivationEpoch:            helpers.DelayedActivationExitEpoch(0),
+			ActivationEligibilityEpoch: epoch,
+			ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+			PublicKey:                  []byte(strconv.Itoa(i)),
+		}
+	}
+	// An active validator is not part of the queue.
+	validators = append(validators, &ethpb.Validator{
+		ActivationEligibilityEpoch: 0,
+		ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+		PublicKey:                  []byte("active"),
+	})
+	headState := &pbp2p.BeaconState{
+		Validators: validators,
+		FinalizedCheckpoint: &ethpb.Checkpoint{
+			Epoch: 0,
+		},
+	}
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+	}
+	res, err := bs.GetValidatorQueue(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Validators are ordered by activation eligibility epoch, then by index.
+	wantedIndices := []uint64{1, 3, 0, 2, 4}
+	if !reflect.DeepEqual(res.ActivationValidatorIndices, wantedIndices) {
+		t.Errorf("Wanted %v, received %v", wantedIndices, res.ActivationValidatorIndices)
+	}
+	wantedKeys := make([][]byte, len(wantedIndices))
+	for i, idx := range wantedIndices {
+		wantedKeys[i] = validators[idx].PublicKey
+	}
+	if !reflect.DeepEqual(res.ActivationPublicKeys, wantedKeys) {
+		t.Errorf("Wanted %v, received %v", wantedKeys, res.ActivationPublicKeys)
+	}
+	if len(res.ExitValidatorIndices) != 0 {
+		t.Errorf("Expected no validators awaiting exit, received %v", res.ExitValidatorIndices)
+	}
+}
+
 func TestServer_GetValidatorQueue_PendingExit(t *testing.T) {
 	headState := &pbp2p.BeaconState{
 		Validators: []*ethpb.Validator{
@@ -1365,6 +1625,14 @@ func TestServer_GetValidatorParticipation_FromArchive(t *testing.T) {
 	}); err == nil {
 		t.Error("Expected error when data from archive is not found, received nil")
 	}
+	wanted := fmt.Sprintf("Could not retrieve data for epoch %d", epoch-3)
+	if _, err := bs.GetValidatorParticipation(ctx, &ethpb.GetValidatorParticipationRequest{
+		QueryFilter: &ethpb.GetValidatorParticipationRequest_Epoch{
+			Epoch: epoch - 3,
+		},
+	}); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %q, received %v", wanted, err)
+	}
 
 	want := &ethpb.ValidatorParticipationResponse{
 		Epoch:         epoch - 2,
@@ -1666,3 +1934,55 @@ func setupValidators(t testing.TB, db db.Database, count int) ([]*ethpb.Validato
 	}
 	return validators, balances
 }
+
+func TestServer_GetValidatorPerformance_BalanceDelta(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	idx := uint64(3)
+	pubKey := headState.Validators[idx].PublicKey
+	if err := db.SaveValidatorIndex(ctx, pubKey, idx); err != nil {
+		t.Fatal(err)
+	}
+	unknownKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	copy(unknownKey, "unknown")
+
+	// No attestations are included, so the validator is penalized at the end of the
+	// first epoch after genesis.
+	nextSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	nextState, err := state.ProcessSlots(ctx, proto.Clone(headState).(*pbp2p.BeaconState), nextSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDelta := int64(nextState.Balances[idx]) - int64(headState.Balances[idx])
+	if wantDelta == 0 {
+		t.Fatal("Expected the validator balance to change over the epoch")
+	}
+
+	bs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+	req := &ethpb.ValidatorPerformanceRequest{
+		Slot:       headState.Slot,
+		PublicKeys: [][]byte{pubKey, unknownKey},
+	}
+	before, err := bs.GetValidatorPerformance(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(before.MissingValidators) != 1 || !bytes.Equal(before.MissingValidators[0], unknownKey) {
+		t.Errorf("Wanted missing validators %#x, received %#x", [][]byte{unknownKey}, before.MissingValidators)
+	}
+
+	req.Slot = nextSlot
+	after, err := bs.GetValidatorPerformance(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delta := int64(after.Balances[0]) - int64(before.Balances[0]); delta != wantDelta {
+		t.Errorf("Wanted balance delta %d, received %d", wantDelta, delta)
+	}
+}
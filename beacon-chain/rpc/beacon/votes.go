@@ -0,0 +1,94 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetIndividualVotes reports, for each requested validator index, whether the validator voted for
+// the correct source, target and head in the requested epoch, along with its effective balance and
+// the inclusion of its earliest attestation. The votes are computed from the attestations pending
+// in the head state, so only the previous epoch of the head can be requested. Validators which were
+// not active in the requested epoch are flagged as such and never reported as having voted.
+func (bs *Server) GetIndividualVotes(ctx context.Context, req *pb.IndividualVotesRequest) (*pb.IndividualVotesResponse, error) {
+	headState, err := bs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available")
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+	prevEpoch := helpers.PrevEpoch(headState)
+	if req.Epoch >= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve votes for an epoch in progress or in the future, current epoch %d, requesting %d",
+			currentEpoch,
+			req.Epoch,
+		)
+	}
+	if req.Epoch < prevEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve votes for an epoch before the previous epoch %d, requesting %d",
+			prevEpoch,
+			req.Epoch,
+		)
+	}
+	for _, idx := range req.Indices {
+		if idx >= uint64(len(headState.Validators)) {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator index %d is out of range", idx)
+		}
+	}
+
+	// The votes are accumulated here rather than with precompute.ProcessAttestations, which
+	// also overwrites the balances reported in the epoch processing metrics.
+	vp, _ := precompute.New(ctx, headState)
+	for _, a := range headState.PreviousEpochAttestations {
+		record := &precompute.Validator{}
+		record.IsPrevEpochAttester, record.IsPrevEpochTargetAttester, record.IsPrevEpochHeadAttester, err = precompute.AttestedPrevEpoch(headState, a)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not check attestation votes: %v", err)
+		}
+		committee, err := helpers.BeaconCommitteeFromState(headState, a.Data.Slot, a.Data.CommitteeIndex)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get committee: %v", err)
+		}
+		indices, err := helpers.AttestingIndices(a.AggregationBits, committee)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get attesting indices: %v", err)
+		}
+		vp = precompute.UpdateValidator(vp, record, indices, a, a.Data.Slot)
+	}
+
+	votes := make([]*pb.IndividualVote, len(req.Indices))
+	for i, idx := range req.Indices {
+		v := vp[idx]
+		vote := &pb.IndividualVote{
+			ValidatorIndex:   idx,
+			IsActiveInEpoch:  v.IsActivePrevEpoch,
+			IsSlashed:        v.IsSlashed,
+			EffectiveBalance: v.CurrentEpochEffectiveBalance,
+		}
+		if v.IsActivePrevEpoch {
+			vote.VotedSource = v.IsPrevEpochAttester
+			vote.VotedTarget = v.IsPrevEpochTargetAttester
+			vote.VotedHead = v.IsPrevEpochHeadAttester
+		}
+		if vote.VotedSource {
+			vote.InclusionSlot = v.InclusionSlot
+			vote.InclusionDistance = v.InclusionDistance
+		}
+		votes[i] = vote
+	}
+	return &pb.IndividualVotesResponse{
+		Epoch: req.Epoch,
+		Votes: votes,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetIndividualVotes(t *testing.T) {
+	helpers.ClearCache()
+	defer helpers.ClearCache()
+
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	inactiveIdx := uint64(63)
+	headState.Validators[inactiveIdx].ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+	headState.Slot = params.BeaconConfig().SlotsPerEpoch
+	for i := uint64(0); i < params.BeaconConfig().SlotsPerEpoch; i++ {
+		root := make([]byte, 32)
+		root[0] = byte(i + 1)
+		headState.BlockRoots[i] = root
+	}
+	targetRoot := headState.BlockRoots[0]
+
+	// Builds a pending attestation for the first committee of the slot in which the committee
+	// members at the given positions voted, and returns their validator indices.
+	pendingAttestation := func(slot uint64, headRoot []byte, targetRoot []byte, positions ...uint64) (*pbp2p.PendingAttestation, []uint64) {
+		committee, err := helpers.BeaconCommitteeFromState(headState, slot, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		voters := make([]uint64, len(positions))
+		for i, p := range positions {
+			bits.SetBitAt(p, true)
+			voters[i] = committee[p]
+		}
+		return &pbp2p.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: headRoot,
+				Source:          &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+				Target:          &ethpb.Checkpoint{Root: targetRoot},
+			},
+			AggregationBits: bits,
+			InclusionDelay:  1,
+		}, voters
+	}
+	wrongRoot := []byte{'w', 'r', 'o', 'n', 'g', 31: 0}
+	correct, correctVoters := pendingAttestation(0, headState.BlockRoots[0], targetRoot, 0, 1)
+	wrongHead, wrongHeadVoters := pendingAttestation(1, wrongRoot, targetRoot, 0)
+	wrongTarget, wrongTargetVoters := pendingAttestation(2, headState.BlockRoots[2], wrongRoot, 0)
+	headState.PreviousEpochAttestations = []*pbp2p.PendingAttestation{correct, wrongHead, wrongTarget}
+
+	committee, err := helpers.BeaconCommitteeFromState(headState, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonVoter := committee[2]
+
+	bs := &Server{HeadFetcher: &mock.ChainService{State: headState}}
+	res, err := bs.GetIndividualVotes(context.Background(), &pb.IndividualVotesRequest{
+		Epoch:   0,
+		Indices: []uint64{correctVoters[0], correctVoters[1], wrongHeadVoters[0], wrongTargetVoters[0], nonVoter, inactiveIdx},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	balance := params.BeaconConfig().MaxEffectiveBalance
+	want := []*pb.IndividualVote{
+		{ValidatorIndex: correctVoters[0], IsActiveInEpoch: true, VotedSource: true, VotedTarget: true, VotedHead: true, EffectiveBalance: balance, InclusionSlot: 1, InclusionDistance: 1},
+		{ValidatorIndex: correctVoters[1], IsActiveInEpoch: true, VotedSource: true, VotedTarget: true, VotedHead: true, EffectiveBalance: balance, InclusionSlot: 1, InclusionDistance: 1},
+		{ValidatorIndex: wrongHeadVoters[0], IsActiveInEpoch: true, VotedSource: true, VotedTarget: true, EffectiveBalance: balance, InclusionSlot: 2, InclusionDistance: 1},
+		{ValidatorIndex: wrongTargetVoters[0], IsActiveInEpoch: true, VotedSource: true, VotedHead: true, EffectiveBalance: balance, InclusionSlot: 3, InclusionDistance: 1},
+		{ValidatorIndex: nonVoter, IsActiveInEpoch: true, EffectiveBalance: balance},
+		{ValidatorIndex: inactiveIdx, EffectiveBalance: balance},
+	}
+	if len(res.Votes) != len(want) {
+		t.Fatalf("Wanted %d votes, received %d", len(want), len(res.Votes))
+	}
+	for i := range want {
+		if !proto.Equal(res.Votes[i], want[i]) {
+			t.Errorf("Wanted vote %v, received %v", want[i], res.Votes[i])
+		}
+	}
+}
+
+func TestServer_GetIndividualVotes_InvalidEpoch(t *testing.T) {
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	headState.Slot = 3 * params.BeaconConfig().SlotsPerEpoch
+	bs := &Server{HeadFetcher: &mock.ChainService{State: headState}}
+
+	for _, epoch := range []uint64{0, 1, 3, 4} {
+		_, err := bs.GetIndividualVotes(context.Background(), &pb.IndividualVotesRequest{Epoch: epoch})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Epoch %d: expected %v error, received %v", epoch, codes.InvalidArgument, err)
+		}
+	}
+	if _, err := bs.GetIndividualVotes(context.Background(), &pb.IndividualVotesRequest{Epoch: 2, Indices: []uint64{64}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %v error for an unknown validator, received %v", codes.InvalidArgument, err)
+	}
+}
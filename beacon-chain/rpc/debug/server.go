@@ -0,0 +1,33 @@
+// Package debug defines a gRPC server exposing endpoints meant for operators debugging a beacon
+// node. It is only registered when debug RPC endpoints are enabled.
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Debug service.
+type Server struct {
+	HeadFetcher blockchain.HeadFetcher
+	HeadUpdater blockchain.HeadUpdater
+}
+
+// ForceHeadUpdate re-runs fork choice and updates the head block and state of the node if fork
+// choice returns a different head, for when the head of the node seems stuck. It returns the root
+// and slot of the resulting head.
+func (ds *Server) ForceHeadUpdate(ctx context.Context, _ *ptypes.Empty) (*pb.HeadUpdateResponse, error) {
+	headRoot, err := ds.HeadUpdater.UpdateHead(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not update head: %v", err)
+	}
+	return &pb.HeadUpdateResponse{
+		HeadRoot: headRoot,
+		HeadSlot: ds.HeadFetcher.HeadSlot(),
+	}, nil
+}
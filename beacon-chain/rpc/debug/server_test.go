@@ -0,0 +1,30 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestServer_ForceHeadUpdate(t *testing.T) {
+	root := bytes.Repeat([]byte{'a'}, 32)
+	chainService := &mock.ChainService{Root: root, State: &pbp2p.BeaconState{Slot: 7}}
+	ds := &Server{
+		HeadFetcher: chainService,
+		HeadUpdater: chainService,
+	}
+	res, err := ds.ForceHeadUpdate(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.HeadRoot, root) {
+		t.Errorf("Wanted head root %#x, received %#x", root, res.HeadRoot)
+	}
+	if res.HeadSlot != 7 {
+		t.Errorf("Wanted head slot 7, received %d", res.HeadSlot)
+	}
+}
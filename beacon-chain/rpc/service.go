@@ -20,10 +20,13 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/aggregator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
@@ -50,68 +53,82 @@ func init() {
 
 // Service defining an RPC server for a beacon node.
 type Service struct {
-	ctx                    context.Context
-	cancel                 context.CancelFunc
-	beaconDB               db.ReadOnlyDatabase
-	headFetcher            blockchain.HeadFetcher
-	forkFetcher            blockchain.ForkFetcher
-	finalizationFetcher    blockchain.FinalizationFetcher
-	participationFetcher   blockchain.ParticipationFetcher
-	genesisTimeFetcher     blockchain.GenesisTimeFetcher
-	attestationReceiver    blockchain.AttestationReceiver
-	blockReceiver          blockchain.BlockReceiver
-	powChainService        powchain.Chain
-	chainStartFetcher      powchain.ChainStartFetcher
-	mockEth1Votes          bool
-	attestationsPool       attestations.Pool
-	syncService            sync.Checker
-	port                   string
-	listener               net.Listener
-	withCert               string
-	withKey                string
-	grpcServer             *grpc.Server
-	canonicalStateChan     chan *pbp2p.BeaconState
-	incomingAttestation    chan *ethpb.Attestation
-	credentialError        error
-	p2p                    p2p.Broadcaster
-	peersFetcher           p2p.PeersProvider
-	depositFetcher         depositcache.DepositFetcher
-	pendingDepositFetcher  depositcache.PendingDepositsFetcher
-	stateNotifier          statefeed.Notifier
-	operationNotifier      opfeed.Notifier
-	slasherConn            *grpc.ClientConn
-	slasherProvider        string
-	slasherCert            string
-	slasherCredentialError error
-	slasherClient          slashpb.SlasherClient
+	ctx                           context.Context
+	cancel                        context.CancelFunc
+	beaconDB                      db.ReadOnlyDatabase
+	headFetcher                   blockchain.HeadFetcher
+	forkFetcher                   blockchain.ForkFetcher
+	finalizationFetcher           blockchain.FinalizationFetcher
+	participationFetcher          blockchain.ParticipationFetcher
+	genesisTimeFetcher            blockchain.GenesisTimeFetcher
+	attestationReceiver           blockchain.AttestationReceiver
+	blockReceiver                 blockchain.BlockReceiver
+	powChainService               powchain.Chain
+	chainStartFetcher             powchain.ChainStartFetcher
+	mockEth1Votes                 bool
+	attestationsPool              attestations.Pool
+	exitPool                      voluntaryexits.PoolManager
+	slashingsPool                 slashings.PoolManager
+	syncService                   sync.Checker
+	syncProgressFetcher           sync.ProgressFetcher
+	subnetIDs                     *cache.SubnetIDs
+	port                          string
+	listener                      net.Listener
+	withCert                      string
+	withKey                       string
+	grpcServer                    *grpc.Server
+	canonicalStateChan            chan *pbp2p.BeaconState
+	incomingAttestation           chan *ethpb.Attestation
+	credentialError               error
+	p2p                           p2p.Broadcaster
+	peersFetcher                  p2p.PeersProvider
+	depositFetcher                depositcache.DepositFetcher
+	pendingDepositFetcher         depositcache.PendingDepositsFetcher
+	stateNotifier                 statefeed.Notifier
+	operationNotifier             opfeed.Notifier
+	slasherConn                   *grpc.ClientConn
+	slasherProvider               string
+	slasherCert                   string
+	slasherCredentialError        error
+	slasherClient                 slashpb.SlasherClient
+	maxValidatorsPerDutiesRequest int
+	headUpdater                   blockchain.HeadUpdater
+	enableDebugRPCEndpoints       bool
 }
 
 // Config options for the beacon node RPC server.
 type Config struct {
-	Port                  string
-	CertFlag              string
-	KeyFlag               string
-	BeaconDB              db.ReadOnlyDatabase
-	HeadFetcher           blockchain.HeadFetcher
-	ForkFetcher           blockchain.ForkFetcher
-	FinalizationFetcher   blockchain.FinalizationFetcher
-	ParticipationFetcher  blockchain.ParticipationFetcher
-	AttestationReceiver   blockchain.AttestationReceiver
-	BlockReceiver         blockchain.BlockReceiver
-	POWChainService       powchain.Chain
-	ChainStartFetcher     powchain.ChainStartFetcher
-	GenesisTimeFetcher    blockchain.GenesisTimeFetcher
-	MockEth1Votes         bool
-	AttestationsPool      attestations.Pool
-	SyncService           sync.Checker
-	Broadcaster           p2p.Broadcaster
-	PeersFetcher          p2p.PeersProvider
-	DepositFetcher        depositcache.DepositFetcher
-	PendingDepositFetcher depositcache.PendingDepositsFetcher
-	SlasherProvider       string
-	SlasherCert           string
-	StateNotifier         statefeed.Notifier
-	OperationNotifier     opfeed.Notifier
+	Port                          string
+	CertFlag                      string
+	KeyFlag                       string
+	BeaconDB                      db.ReadOnlyDatabase
+	HeadFetcher                   blockchain.HeadFetcher
+	ForkFetcher                   blockchain.ForkFetcher
+	FinalizationFetcher           blockchain.FinalizationFetcher
+	ParticipationFetcher          blockchain.ParticipationFetcher
+	AttestationReceiver           blockchain.AttestationReceiver
+	BlockReceiver                 blockchain.BlockReceiver
+	POWChainService               powchain.Chain
+	ChainStartFetcher             powchain.ChainStartFetcher
+	GenesisTimeFetcher            blockchain.GenesisTimeFetcher
+	MockEth1Votes                 bool
+	AttestationsPool              attestations.Pool
+	ExitPool                      voluntaryexits.PoolManager
+	SlashingsPool                 slashings.PoolManager
+	SyncService                   sync.Checker
+	SyncProgressFetcher           sync.ProgressFetcher
+	SubnetIDs                     *cache.SubnetIDs
+	Broadcaster                   p2p.Broadcaster
+	PeersFetcher                  p2p.PeersProvider
+	DepositFetcher                depositcache.DepositFetcher
+	PendingDepositFetcher         depositcache.PendingDepositsFetcher
+	SlasherProvider               string
+	SlasherCert                   string
+	StateNotifier                 statefeed.Notifier
+	OperationNotifier             opfeed.Notifier
+	MaxValidatorsPerDutiesRequest int
+	HeadUpdater                   blockchain.HeadUpdater
+	EnableDebugRPCEndpoints       bool
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -119,34 +136,41 @@ type Config struct {
 func NewService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
-		ctx:                   ctx,
-		cancel:                cancel,
-		beaconDB:              cfg.BeaconDB,
-		headFetcher:           cfg.HeadFetcher,
-		forkFetcher:           cfg.ForkFetcher,
-		finalizationFetcher:   cfg.FinalizationFetcher,
-		participationFetcher:  cfg.ParticipationFetcher,
-		genesisTimeFetcher:    cfg.GenesisTimeFetcher,
-		attestationReceiver:   cfg.AttestationReceiver,
-		blockReceiver:         cfg.BlockReceiver,
-		p2p:                   cfg.Broadcaster,
-		peersFetcher:          cfg.PeersFetcher,
-		powChainService:       cfg.POWChainService,
-		chainStartFetcher:     cfg.ChainStartFetcher,
-		mockEth1Votes:         cfg.MockEth1Votes,
-		attestationsPool:      cfg.AttestationsPool,
-		syncService:           cfg.SyncService,
-		port:                  cfg.Port,
-		withCert:              cfg.CertFlag,
-		withKey:               cfg.KeyFlag,
-		depositFetcher:        cfg.DepositFetcher,
-		pendingDepositFetcher: cfg.PendingDepositFetcher,
-		canonicalStateChan:    make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
-		incomingAttestation:   make(chan *ethpb.Attestation, params.BeaconConfig().DefaultBufferSize),
-		stateNotifier:         cfg.StateNotifier,
-		operationNotifier:     cfg.OperationNotifier,
-		slasherProvider:       cfg.SlasherProvider,
-		slasherCert:           cfg.SlasherCert,
+		ctx:                           ctx,
+		cancel:                        cancel,
+		beaconDB:                      cfg.BeaconDB,
+		headFetcher:                   cfg.HeadFetcher,
+		forkFetcher:                   cfg.ForkFetcher,
+		finalizationFetcher:           cfg.FinalizationFetcher,
+		participationFetcher:          cfg.ParticipationFetcher,
+		genesisTimeFetcher:            cfg.GenesisTimeFetcher,
+		attestationReceiver:           cfg.AttestationReceiver,
+		blockReceiver:                 cfg.BlockReceiver,
+		p2p:                           cfg.Broadcaster,
+		peersFetcher:                  cfg.PeersFetcher,
+		powChainService:               cfg.POWChainService,
+		chainStartFetcher:             cfg.ChainStartFetcher,
+		mockEth1Votes:                 cfg.MockEth1Votes,
+		attestationsPool:              cfg.AttestationsPool,
+		exitPool:                      cfg.ExitPool,
+		slashingsPool:                 cfg.SlashingsPool,
+		syncService:                   cfg.SyncService,
+		syncProgressFetcher:           cfg.SyncProgressFetcher,
+		subnetIDs:                     cfg.SubnetIDs,
+		port:                          cfg.Port,
+		withCert:                      cfg.CertFlag,
+		withKey:                       cfg.KeyFlag,
+		depositFetcher:                cfg.DepositFetcher,
+		pendingDepositFetcher:         cfg.PendingDepositFetcher,
+		canonicalStateChan:            make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
+		incomingAttestation:           make(chan *ethpb.Attestation, params.BeaconConfig().DefaultBufferSize),
+		stateNotifier:                 cfg.StateNotifier,
+		operationNotifier:             cfg.OperationNotifier,
+		slasherProvider:               cfg.SlasherProvider,
+		slasherCert:                   cfg.SlasherCert,
+		maxValidatorsPerDutiesRequest: cfg.MaxValidatorsPerDutiesRequest,
+		headUpdater:                   cfg.HeadUpdater,
+		enableDebugRPCEndpoints:       cfg.EnableDebugRPCEndpoints,
 	}
 }
 
@@ -194,27 +218,32 @@ func (s *Service) Start() {
 	genesisTime := s.genesisTimeFetcher.GenesisTime()
 	ticker := slotutil.GetSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
 	validatorServer := &validator.Server{
-		Ctx:                    s.ctx,
-		BeaconDB:               s.beaconDB,
-		AttestationCache:       cache.NewAttestationCache(),
-		AttPool:                s.attestationsPool,
-		HeadFetcher:            s.headFetcher,
-		ForkFetcher:            s.forkFetcher,
-		FinalizationFetcher:    s.finalizationFetcher,
-		CanonicalStateChan:     s.canonicalStateChan,
-		BlockFetcher:           s.powChainService,
-		DepositFetcher:         s.depositFetcher,
-		ChainStartFetcher:      s.chainStartFetcher,
-		Eth1InfoFetcher:        s.powChainService,
-		SyncChecker:            s.syncService,
-		StateNotifier:          s.stateNotifier,
-		OperationNotifier:      s.operationNotifier,
-		P2P:                    s.p2p,
-		BlockReceiver:          s.blockReceiver,
-		MockEth1Votes:          s.mockEth1Votes,
-		Eth1BlockFetcher:       s.powChainService,
-		PendingDepositsFetcher: s.pendingDepositFetcher,
-		GenesisTime:            genesisTime,
+		Ctx:                           s.ctx,
+		BeaconDB:                      s.beaconDB,
+		AttestationCache:              cache.NewAttestationCache(),
+		AttPool:                       s.attestationsPool,
+		ExitPool:                      s.exitPool,
+		SlashingsPool:                 s.slashingsPool,
+		HeadFetcher:                   s.headFetcher,
+		ForkFetcher:                   s.forkFetcher,
+		FinalizationFetcher:           s.finalizationFetcher,
+		CanonicalStateChan:            s.canonicalStateChan,
+		BlockFetcher:                  s.powChainService,
+		DepositFetcher:                s.depositFetcher,
+		ChainStartFetcher:             s.chainStartFetcher,
+		Eth1InfoFetcher:               s.powChainService,
+		SyncChecker:                   s.syncService,
+		StateNotifier:                 s.stateNotifier,
+		OperationNotifier:             s.operationNotifier,
+		P2P:                           s.p2p,
+		BlockReceiver:                 s.blockReceiver,
+		MockEth1Votes:                 s.mockEth1Votes,
+		Eth1BlockFetcher:              s.powChainService,
+		PendingDepositsFetcher:        s.pendingDepositFetcher,
+		GenesisTime:                   genesisTime,
+		BoundaryStateCache:            cache.NewCheckpointStateCache(),
+		SubnetIDs:                     s.subnetIDs,
+		MaxValidatorsPerDutiesRequest: s.maxValidatorsPerDutiesRequest,
 	}
 	nodeServer := &node.Server{
 		BeaconDB:           s.beaconDB,
@@ -230,7 +259,10 @@ func (s *Service) Start() {
 		HeadFetcher:          s.headFetcher,
 		FinalizationFetcher:  s.finalizationFetcher,
 		ParticipationFetcher: s.participationFetcher,
+		SyncChecker:          s.syncService,
+		SyncProgressFetcher:  s.syncProgressFetcher,
 		ChainStartFetcher:    s.chainStartFetcher,
+		DepositFetcher:       s.depositFetcher,
 		CanonicalStateChan:   s.canonicalStateChan,
 		StateNotifier:        s.stateNotifier,
 		SlotTicker:           ticker,
@@ -243,9 +275,18 @@ func (s *Service) Start() {
 		P2p:         s.p2p,
 	}
 	pb.RegisterAggregatorServiceServer(s.grpcServer, aggregatorServer)
+	pb.RegisterDutiesServiceServer(s.grpcServer, validatorServer)
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+	pb.RegisterBeaconChainServiceServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	if s.enableDebugRPCEndpoints {
+		debugServer := &debug.Server{
+			HeadFetcher: s.headFetcher,
+			HeadUpdater: s.headUpdater,
+		}
+		pb.RegisterDebugServiceServer(s.grpcServer, debugServer)
+	}
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
@@ -77,3 +77,31 @@ func TestRPC_InsecureEndpoint(t *testing.T) {
 
 	rpcService.Stop()
 }
+
+func TestRPC_ComputeBlockStateRootRegistered(t *testing.T) {
+	chainService := &mock.ChainService{Genesis: time.Now()}
+	rpcService := NewService(context.Background(), &Config{
+		Port:                "7779",
+		SyncService:         &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:       chainService,
+		GenesisTimeFetcher:  chainService,
+		AttestationReceiver: chainService,
+		HeadFetcher:         chainService,
+		POWChainService:     &mockPOW.POWChain{},
+		StateNotifier:       chainService.StateNotifier(),
+	})
+
+	rpcService.Start()
+	defer rpcService.Stop()
+
+	info, ok := rpcService.grpcServer.GetServiceInfo()["ethereum.beacon.rpc.v1.DutiesService"]
+	if !ok {
+		t.Fatal("Duties service is not registered")
+	}
+	for _, m := range info.Methods {
+		if m.Name == "ComputeBlockStateRoot" {
+			return
+		}
+	}
+	t.Error("ComputeBlockStateRoot is not registered on the duties service")
+}
@@ -1,74 +1,677 @@
 package validator
 
 import (
+	"bytes"
 	"context"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// dutiesContextCheckInterval is the number of requested validators processed between checks of
+// the request context, so large requests stop promptly once the caller goes away.
+const dutiesContextCheckInterval = 64
+
+// maxDutiesEpochLookahead is the maximum number of epochs following the requested epoch that
+// duties can be requested for at once.
+const maxDutiesEpochLookahead = 2
+
+// defaultMaxValidatorsPerDutiesRequest is the maximum number of public keys and indices accepted
+// in a duties request when the server does not specify its own limit. It leaves room for the
+// largest operators to request all their validators at once.
+const defaultMaxValidatorsPerDutiesRequest = 1 << 15
+
 // GetDuties returns the committee assignment response from a given validator public key.
 // The committee assignment response contains the following fields for the current and previous epoch:
-//	1.) The list of validators in the committee.
-//	2.) The shard to which the committee is assigned.
+//	1.) The ordered list of validator indices in the committee, as shuffled by the beacon committee
+//	    computation, so a client can derive its position within the committee.
+//	2.) The index of the committee within the slot.
 //	3.) The slot at which the committee is assigned.
-//	4.) The bool signaling if the validator is expected to propose a block at the assigned slot.
+//	4.) The slots at which the validator is expected to propose a block.
+//	5.) The length of the committee and the position of the validator within it, which determine
+//	    the aggregation bits of the attestations of the validator.
+// A validator requested more than once gets a single duty, and the response maps every requested
+// public key and index to the position of its duty.
+// When the request carries the dependent root of a previous response and the duties are still
+// based on that root, an empty response flagged as unchanged is returned instead.
+// With an epoch lookahead, the duties of every epoch from the requested epoch to the end of the
+// lookahead are also returned per epoch. Proposer slots are only known up to the current epoch.
+// With a committee index filter, only the duties of validators assigned to one of the filtered
+// committees are returned, and the positions of the requested validators are left out since
+// the omitted ones have no duty to point to.
+//
+// Requests for more validators than the server's maximum are rejected with an InvalidArgument error.
+// An Unavailable error is returned while the node is syncing or has no head state to compute
+// duties from yet, as both resolve on their own and clients should retry later.
 func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
 	if vs.SyncChecker.Syncing() {
-		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+		return nil, status.Error(codes.Unavailable, "beacon node is syncing to latest head")
 	}
+	if err := dutiesContextErr(ctx); err != nil {
+		return nil, err
+	}
+	currentEpoch := helpers.SlotToEpoch(vs.HeadFetcher.HeadSlot())
+	dutiesRequestsCounter.WithLabelValues(dutiesEpochLabel(req.Epoch, currentEpoch)).Inc()
+	dutiesRequestKeysHistogram.Observe(float64(len(req.PublicKeys) + len(req.Indices)))
+	start := time.Now()
+	defer func() {
+		dutiesComputationLatency.Observe(time.Since(start).Seconds())
+	}()
 
-	s, err := vs.HeadFetcher.HeadState(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	maxValidators := vs.MaxValidatorsPerDutiesRequest
+	if maxValidators <= 0 {
+		maxValidators = defaultMaxValidatorsPerDutiesRequest
+	}
+	if len(req.PublicKeys)+len(req.Indices) > maxValidators {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"requested duties of %d validators, more than the maximum of %d",
+			len(req.PublicKeys)+len(req.Indices),
+			maxValidators,
+		)
+	}
+	if req.EpochLookahead > maxDutiesEpochLookahead {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch lookahead %d is greater than the maximum of %d",
+			req.EpochLookahead,
+			maxDutiesEpochLookahead,
+		)
 	}
 
-	// Advance state with empty transitions up to the requested epoch start slot.
-	if epochStartSlot := helpers.StartSlot(req.Epoch); s.Slot < epochStartSlot {
-		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
+	// Every epoch is computed from the same head root and state, even if the head moves in the
+	// meantime.
+	root := bytesutil.ToBytes32(req.BlockRoot)
+	var headState *pbp2p.BeaconState
+	if len(req.BlockRoot) == 0 {
+		headRoot, s, err := vs.HeadFetcher.HeadRootAndStateReadOnly(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+			return nil, status.Errorf(codes.Internal, "Could not get head: %v", err)
+		}
+		if s == nil {
+			return nil, status.Error(codes.Unavailable, "head state not available yet")
 		}
+		root = bytesutil.ToBytes32(headRoot)
+		headState = s
+	}
+	assignments, err := vs.requestedAssignments(ctx, req, root, headState, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	// Duties based on the same dependent root as a previous response have not changed.
+	if req.EpochLookahead == 0 &&
+		len(req.PreviousDependentRoot) > 0 &&
+		bytes.Equal(req.PreviousDependentRoot, assignments.dependentRoot[:]) {
+		return &ethpb.DutiesResponse{
+			Duties:    make([]*ethpb.DutiesResponse_Duty, 0),
+			Unchanged: true,
+		}, nil
 	}
 
-	committeeAssignments, proposerIndexToSlot, err := helpers.CommitteeAssignments(s, req.Epoch)
+	validators, positions, err := vs.requestedValidators(ctx, req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+		return nil, err
+	}
+	duties, err := assignedDuties(ctx, validators, assignments)
+	if err != nil {
+		return nil, err
+	}
+	res := &ethpb.DutiesResponse{
+		Duties:             duties,
+		RequestDutyIndices: positions,
+	}
+	if len(req.CommitteeIndexFilter) > 0 {
+		res.Duties = filterDutiesByCommittee(duties, req.CommitteeIndexFilter)
+		res.RequestDutyIndices = nil
+	}
+	if req.EpochLookahead == 0 {
+		return res, nil
 	}
 
+	res.EpochDuties = []*ethpb.DutiesResponse_EpochDuties{{Epoch: req.Epoch, Duties: res.Duties}}
+	for epoch := req.Epoch + 1; epoch <= req.Epoch+req.EpochLookahead; epoch++ {
+		assignments, err := vs.requestedAssignments(ctx, req, root, headState, epoch)
+		if err != nil {
+			return nil, err
+		}
+		duties, err := assignedDuties(ctx, validators, assignments)
+		if err != nil {
+			return nil, err
+		}
+		if len(req.CommitteeIndexFilter) > 0 {
+			duties = filterDutiesByCommittee(duties, req.CommitteeIndexFilter)
+		}
+		res.EpochDuties = append(res.EpochDuties, &ethpb.DutiesResponse_EpochDuties{
+			Epoch:  epoch,
+			Duties: duties,
+		})
+	}
+	return res, nil
+}
+
+// requestedAssignments returns the assignments of the epoch as seen from the given root, which is
+// either the block root of the request or the head root along with the head state.
+func (vs *Server) requestedAssignments(
+	ctx context.Context,
+	req *ethpb.DutiesRequest,
+	root [32]byte,
+	headState *pbp2p.BeaconState,
+	epoch uint64,
+) (*epochAssignments, error) {
+	if len(req.BlockRoot) > 0 {
+		return vs.assignmentsAtBlockRoot(ctx, epoch, root)
+	}
+	return vs.assignmentsForEpoch(ctx, epoch, root, headState)
+}
+
+// assignedDuties returns the duty of every requested validator from the assignments of an epoch.
+func assignedDuties(ctx context.Context, validators []*requestedValidator, assignments *epochAssignments) ([]*ethpb.DutiesResponse_Duty, error) {
 	var validatorAssignments []*ethpb.DutiesResponse_Duty
-	for _, pubKey := range req.PublicKeys {
-		if ctx.Err() != nil {
-			return nil, status.Errorf(codes.Aborted, "Could not continue fetching assignments: %v", ctx.Err())
+	for i, v := range validators {
+		if i%dutiesContextCheckInterval == 0 {
+			if err := dutiesContextErr(ctx); err != nil {
+				return nil, err
+			}
 		}
 		// Default assignment.
 		assignment := &ethpb.DutiesResponse_Duty{
-			PublicKey: pubKey,
+			PublicKey:     v.pubKey,
+			DependentRoot: assignments.dependentRoot[:],
 		}
 
-		idx, ok, err := vs.BeaconDB.ValidatorIndex(ctx, pubKey)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not fetch validator idx for public key %#x: %v", pubKey, err)
+		if v.known && v.index < uint64(len(assignments.statuses)) {
+			assignment.Status = assignments.statuses[v.index]
 		}
-		if ok {
-			ca, ok := committeeAssignments[idx]
+		assignment.InCurrentSyncCommittee = assignments.currentSyncCommittee[string(v.pubKey)]
+		assignment.InNextSyncCommittee = assignments.nextSyncCommittee[string(v.pubKey)]
+		// Slashed validators are no longer expected to attest or propose.
+		if v.known && assignment.Status != ethpb.ValidatorStatus_EXITED_SLASHED {
+			ca, ok := assignments.committeeAssignments[v.index]
 			if ok {
 				assignment.Committee = ca.Committee
-				assignment.Status = ethpb.ValidatorStatus_ACTIVE
-				assignment.PublicKey = pubKey
 				assignment.AttesterSlot = ca.AttesterSlot
-				assignment.ProposerSlot = proposerIndexToSlot[idx]
+				assignment.ProposerSlots = assignments.proposerSlots[v.index]
+				if len(assignment.ProposerSlots) > 0 {
+					assignment.ProposerSlot = assignment.ProposerSlots[0]
+				}
 				assignment.CommitteeIndex = ca.CommitteeIndex
+				assignment.CommitteeLength = uint64(len(ca.Committee))
+				assignment.ValidatorCommitteeIndex = committeePosition(ca.Committee, v.index)
 			}
 		}
 
 		validatorAssignments = append(validatorAssignments, assignment)
 	}
+	return validatorAssignments, nil
+}
+
+// filterDutiesByCommittee returns the duties of the validators assigned to one of the given
+// committee indices. Duties without a committee assignment are omitted, as their committee
+// index is unset.
+func filterDutiesByCommittee(duties []*ethpb.DutiesResponse_Duty, committeeIndices []uint64) []*ethpb.DutiesResponse_Duty {
+	wanted := make(map[uint64]bool, len(committeeIndices))
+	for _, idx := range committeeIndices {
+		wanted[idx] = true
+	}
+	filtered := make([]*ethpb.DutiesResponse_Duty, 0, len(duties))
+	for _, duty := range duties {
+		if len(duty.Committee) > 0 && wanted[duty.CommitteeIndex] {
+			filtered = append(filtered, duty)
+		}
+	}
+	return filtered
+}
+
+// StreamDuties sends the duties of the requested validators and then listens for processed
+// blocks and reorgs, pushing a fresh response whenever the duties of the subscribed validators
+// change, such as on an epoch transition or a reorg. Duties are streamed for the requested epoch until
+// the head moves past it, after which the current epoch of the head is used.
+func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream pb.DutiesService_StreamDutiesServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := vs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	res, err := vs.streamedDuties(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(res); err != nil {
+		return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
+	}
 
-	return &ethpb.DutiesResponse{
-		Duties: validatorAssignments,
-	}, nil
+	for {
+		select {
+		case event := <-stateChannel:
+			// A reorg may change the duties even if the new head was processed earlier.
+			if (event.Type != statefeed.BlockProcessed && event.Type != statefeed.Reorg) || vs.SyncChecker.Syncing() {
+				continue
+			}
+			nextRes, err := vs.streamedDuties(stream.Context(), req)
+			if err != nil {
+				return err
+			}
+			// Only notify the validator client when its duties actually changed.
+			if proto.Equal(nextRes, res) {
+				continue
+			}
+			res = nextRes
+			if err := stream.Send(res); err != nil {
+				return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Stream context canceled")
+		case <-vs.Ctx.Done():
+			return status.Error(codes.Canceled, "RPC context canceled")
+		}
+	}
+}
+
+// streamedDuties returns the duties of a streaming request for the later of the requested
+// epoch and the current epoch of the head.
+func (vs *Server) streamedDuties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	epoch := helpers.SlotToEpoch(vs.HeadFetcher.HeadSlot())
+	if req.Epoch > epoch {
+		epoch = req.Epoch
+	}
+	return vs.GetDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:                epoch,
+		PublicKeys:           req.PublicKeys,
+		Indices:              req.Indices,
+		CommitteeIndexFilter: req.CommitteeIndexFilter,
+	})
+}
+
+// requestedValidator is a validator whose duties are requested either by public key or by index.
+type requestedValidator struct {
+	pubKey []byte
+	index  uint64
+	// known is false when no validator index exists for the requested public key.
+	known bool
+}
+
+// requestedValidators merges the public keys and validator indices of a duties request into a
+// single list of unique validators, in first-seen request order. Validators requested by index
+// are resolved from the head state. Malformed public keys are returned as unknown validators
+// unless the request is strict. Along with the validators, it returns the position in that list
+// of every requested public key followed by every requested index, so that a validator requested
+// several times is only looked up once.
+func (vs *Server) requestedValidators(ctx context.Context, req *ethpb.DutiesRequest) ([]*requestedValidator, []uint64, error) {
+	validators := make([]*requestedValidator, 0, len(req.PublicKeys)+len(req.Indices))
+	positions := make([]uint64, 0, len(req.PublicKeys)+len(req.Indices))
+	seenKeys := make(map[string]uint64, len(req.PublicKeys)+len(req.Indices))
+	var headIndices map[string]uint64
+	for i, pubKey := range req.PublicKeys {
+		if i%dutiesContextCheckInterval == 0 {
+			if err := dutiesContextErr(ctx); err != nil {
+				return nil, nil, err
+			}
+		}
+		if pos, ok := seenKeys[string(pubKey)]; ok {
+			positions = append(positions, pos)
+			continue
+		}
+		pos := uint64(len(validators))
+		seenKeys[string(pubKey)] = pos
+		positions = append(positions, pos)
+		// A malformed key only fails the request in strict mode, otherwise it is reported
+		// with an unknown status so the duties of the other keys are still served.
+		if len(pubKey) != params.BeaconConfig().BLSPubkeyLength {
+			if req.Strict {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "incorrect key length for public key %#x", pubKey)
+			}
+			validators = append(validators, &requestedValidator{pubKey: pubKey})
+			continue
+		}
+		idx, ok, err := vs.BeaconDB.ValidatorIndex(ctx, pubKey)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "Could not fetch validator idx for public key %#x: %v", pubKey, err)
+		}
+		// The database mapping may be missing for a validator of the head state, in which case
+		// the validator is looked up in the registry and the mapping is backfilled.
+		if !ok {
+			if headIndices == nil {
+				headIndices, err = vs.headValidatorIndices(ctx)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			idx, ok = headIndices[string(pubKey)]
+			if ok {
+				if err := vs.BeaconDB.SaveValidatorIndex(ctx, pubKey, idx); err != nil {
+					log.WithError(err).Warnf("Could not save validator index of public key %#x", pubKey)
+				}
+			}
+		}
+		validators = append(validators, &requestedValidator{pubKey: pubKey, index: idx, known: ok})
+	}
+	if len(req.Indices) == 0 {
+		return validators, positions, nil
+	}
+
+	headState, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, nil, status.Error(codes.Unavailable, "head state not available yet")
+	}
+	for i, idx := range req.Indices {
+		if i%dutiesContextCheckInterval == 0 {
+			if err := dutiesContextErr(ctx); err != nil {
+				return nil, nil, err
+			}
+		}
+		if idx >= uint64(len(headState.Validators)) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "validator index %d out of range", idx)
+		}
+		pubKey := headState.Validators[idx].PublicKey
+		if pos, ok := seenKeys[string(pubKey)]; ok {
+			positions = append(positions, pos)
+			continue
+		}
+		pos := uint64(len(validators))
+		seenKeys[string(pubKey)] = pos
+		positions = append(positions, pos)
+		validators = append(validators, &requestedValidator{pubKey: pubKey, index: idx, known: true})
+	}
+	return validators, positions, nil
+}
+
+// headValidatorIndices maps the public key of every validator of the head state to its index.
+func (vs *Server) headValidatorIndices(ctx context.Context) (map[string]uint64, error) {
+	headState, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "head state not available yet")
+	}
+	indices := make(map[string]uint64, len(headState.Validators))
+	for i, v := range headState.Validators {
+		indices[string(v.PublicKey)] = uint64(i)
+	}
+	return indices, nil
+}
+
+// assignmentsForEpoch returns the committee assignments and proposer slots of every validator for
+// the requested epoch as seen from the given head root and state. The result is computed once per
+// (epoch, head root) and shared by every request hitting the assignments cache.
+func (vs *Server) assignmentsForEpoch(ctx context.Context, epoch uint64, headRoot [32]byte, s *pbp2p.BeaconState) (*epochAssignments, error) {
+	c, err := vs.dutiesAssignmentsCache()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not initialize assignments cache: %v", err)
+	}
+	key := assignmentsCacheKey{epoch: epoch, headRoot: headRoot, epochStarted: vs.epochStarted(epoch)}
+	if cached, ok := c.get(key); ok {
+		return cached, nil
+	}
+
+	assignments, err := vs.computeEpochAssignments(ctx, s, headRoot, epoch)
+	if err != nil {
+		return nil, err
+	}
+	c.add(key, assignments)
+	return assignments, nil
+}
+
+// assignmentsAtBlockRoot returns the committee assignments and proposer slots of every validator
+// for the requested epoch as seen from the state saved in the database at the given block root.
+// These are not cached, as they are only requested for historical lookups.
+func (vs *Server) assignmentsAtBlockRoot(ctx context.Context, epoch uint64, root [32]byte) (*epochAssignments, error) {
+	s, err := vs.BeaconDB.State(ctx, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state at root %#x: %v", root, err)
+	}
+	if s == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find state at root %#x", root)
+	}
+	return vs.computeEpochAssignments(ctx, s, root, epoch)
+}
+
+// computeEpochAssignments computes the committee assignments, proposer slots and statuses of
+// every validator for the requested epoch, advancing a copy of the given state up to the epoch if
+// needed. The given state is never modified, so it may be shared with concurrent readers. The
+// block root of the given state is used as dependent root when the state has not moved past the
+// dependent slot yet.
+//
+// Committees of the epoch after the one of the state are computed from the state as is: they are
+// shuffled with the RANDAO mix of the epoch before the current one, which is final, and activations
+// and exits initiated from now on only take effect several epochs later, so the active validators
+// of the next epoch are already known as well.
+func (vs *Server) computeEpochAssignments(ctx context.Context, s *pbp2p.BeaconState, root [32]byte, epoch uint64) (*epochAssignments, error) {
+	stateEpoch := helpers.CurrentEpoch(s)
+	dependentRoot, err := dutiesDependentRoot(s, root, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute dependent root: %v", err)
+	}
+
+	// Advance state with empty transitions up to the requested epoch start slot when the epoch is
+	// further ahead than the next one. The state is advanced as well when it lags behind the wall
+	// clock which already reached the epoch: the slots up to the epoch are known to be empty so
+	// far, so the proposers of the epoch can be computed from the advanced state.
+	lagging := epoch > stateEpoch && vs.epochStarted(epoch)
+	if epoch > stateEpoch+1 || lagging {
+		s, err = vs.epochBoundaryState(ctx, s, root, epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if lagging {
+		stateEpoch = epoch
+	}
+
+	// The assignment helpers move the slot of the state they are given through the epoch.
+	s = shallowCopyState(s)
+	committeeAssignments, _, err := helpers.CommitteeAssignments(s, epoch)
+	if errors.Cause(err) == helpers.ErrNoActiveValidators {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not compute committee assignments: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+	// Proposers of an epoch ahead of the state are not known until the RANDAO mix of the
+	// current epoch is final, so proposer slots are left empty rather than guessed.
+	proposerSlots := make(map[uint64][]uint64)
+	if epoch <= stateEpoch {
+		proposerSlots, err = proposerSlotsAtEpoch(s, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute proposer slots: %v", err)
+		}
+	}
+	statuses := make([]ethpb.ValidatorStatus, len(s.Validators))
+	for i, v := range s.Validators {
+		statuses[i] = dutyStatus(v, epoch)
+	}
+	assignments := &epochAssignments{
+		committeeAssignments: committeeAssignments,
+		proposerSlots:        proposerSlots,
+		statuses:             statuses,
+		dependentRoot:        dependentRoot,
+	}
+	if featureconfig.Get().EnableSyncCommitteeDuties {
+		assignments.currentSyncCommittee, assignments.nextSyncCommittee = syncCommitteeMembers(s)
+	}
+	return assignments, nil
+}
+
+// epochStarted returns whether the wall clock reached the start slot of the given epoch. It is
+// false while the genesis time of the server is unknown.
+func (vs *Server) epochStarted(epoch uint64) bool {
+	if vs.GenesisTime.IsZero() {
+		return false
+	}
+	return slotutil.SlotsSinceGenesis(vs.GenesisTime) >= helpers.StartSlot(epoch)
+}
+
+// epochBoundaryState advances a state with empty slots up to the start slot of the given epoch.
+// The block root of the state is the last block before the epoch boundary, so advanced states are
+// cached by epoch and block root: a reorg replacing that block changes the key and never hits the
+// entries derived from the replaced block, which are eventually trimmed from the bounded cache.
+// States are always recomputed when the server has no boundary state cache.
+func (vs *Server) epochBoundaryState(ctx context.Context, s *pbp2p.BeaconState, root [32]byte, epoch uint64) (*pbp2p.BeaconState, error) {
+	cp := &ethpb.Checkpoint{Epoch: epoch, Root: root[:]}
+	if vs.BoundaryStateCache != nil {
+		cached, err := vs.BoundaryStateCache.StateByCheckpoint(cp)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get epoch boundary state from cache: %v", err)
+		}
+		if cached != nil {
+			return cached, nil
+		}
+	}
+
+	epochStartSlot := helpers.StartSlot(epoch)
+	s, err := state.ProcessSlots(ctx, proto.Clone(s).(*pbp2p.BeaconState), epochStartSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+	}
+	if vs.BoundaryStateCache != nil {
+		if err := vs.BoundaryStateCache.AddCheckpointState(&cache.CheckpointState{
+			Checkpoint: cp,
+			State:      proto.Clone(s).(*pbp2p.BeaconState),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not cache epoch boundary state: %v", err)
+		}
+	}
+	return s, nil
+}
+
+// shallowCopyState returns a copy of the given state which shares every slice and message of the
+// state, so that setting a top level field of the copy leaves the state untouched. Nothing
+// reachable from the copy may be modified in place.
+func shallowCopyState(s *pbp2p.BeaconState) *pbp2p.BeaconState {
+	return &pbp2p.BeaconState{
+		GenesisTime:                 s.GenesisTime,
+		Slot:                        s.Slot,
+		Fork:                        s.Fork,
+		LatestBlockHeader:           s.LatestBlockHeader,
+		BlockRoots:                  s.BlockRoots,
+		StateRoots:                  s.StateRoots,
+		HistoricalRoots:             s.HistoricalRoots,
+		Eth1Data:                    s.Eth1Data,
+		Eth1DataVotes:               s.Eth1DataVotes,
+		Eth1DepositIndex:            s.Eth1DepositIndex,
+		Validators:                  s.Validators,
+		Balances:                    s.Balances,
+		RandaoMixes:                 s.RandaoMixes,
+		Slashings:                   s.Slashings,
+		PreviousEpochAttestations:   s.PreviousEpochAttestations,
+		CurrentEpochAttestations:    s.CurrentEpochAttestations,
+		JustificationBits:           s.JustificationBits,
+		PreviousJustifiedCheckpoint: s.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  s.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         s.FinalizedCheckpoint,
+	}
+}
+
+// dutiesDependentRoot returns the root of the last block at or before the last slot of the
+// epoch preceding the given epoch, as seen from a state and its block root. Duties of the
+// genesis epoch depend on the genesis block.
+func dutiesDependentRoot(s *pbp2p.BeaconState, root [32]byte, epoch uint64) ([32]byte, error) {
+	var dependentSlot uint64
+	if epoch > 0 {
+		dependentSlot = helpers.StartSlot(epoch) - 1
+	}
+	// The block roots of the state only cover the slots before the state slot, later slots
+	// can only have the block the state is at.
+	if dependentSlot >= s.Slot {
+		return root, nil
+	}
+	r, err := helpers.BlockRootAtSlot(s, dependentSlot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return bytesutil.ToBytes32(r), nil
+}
+
+// dutiesAssignmentsCache lazily initializes the assignments cache with the configured size.
+func (vs *Server) dutiesAssignmentsCache() (*assignmentsCache, error) {
+	vs.assignmentsCacheLock.Lock()
+	defer vs.assignmentsCacheLock.Unlock()
+
+	if vs.assignmentsCache == nil {
+		c, err := newAssignmentsCache(vs.AssignmentsCacheSize)
+		if err != nil {
+			return nil, err
+		}
+		vs.assignmentsCache = c
+	}
+	return vs.assignmentsCache, nil
+}
+
+// committeePosition returns the position of a validator index within a committee. The validator
+// must be a member of the committee.
+func committeePosition(committee []uint64, validatorIdx uint64) uint64 {
+	for i, idx := range committee {
+		if idx == validatorIdx {
+			return uint64(i)
+		}
+	}
+	return 0
+}
+
+// dutiesContextErr returns a Canceled or DeadlineExceeded status error once the context of a
+// duties request is done, and nil otherwise.
+func dutiesContextErr(ctx context.Context) error {
+	switch ctx.Err() {
+	case nil:
+		return nil
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, "Duties request deadline exceeded")
+	default:
+		return status.Errorf(codes.Canceled, "Duties request canceled: %v", ctx.Err())
+	}
+}
+
+// proposerSlotsAtEpoch returns a map of validator indices to the slots at which they are
+// assigned to propose a block in the given epoch. A validator may be selected as proposer
+// for more than one slot of the same epoch.
+func proposerSlotsAtEpoch(s *pbp2p.BeaconState, epoch uint64) (map[uint64][]uint64, error) {
+	proposers, err := helpers.ProposerAssignments(s, epoch)
+	if err != nil {
+		return nil, err
+	}
+	proposerSlots := make(map[uint64][]uint64)
+	startSlot := helpers.StartSlot(epoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		i := proposers[slot]
+		proposerSlots[i] = append(proposerSlots[i], slot)
+	}
+	return proposerSlots, nil
+}
+
+// dutyStatus returns the status of a validator at the given epoch. A slashed validator is
+// reported as such from the moment it is slashed, as it is no longer expected to perform duties.
+func dutyStatus(v *ethpb.Validator, epoch uint64) ethpb.ValidatorStatus {
+	switch {
+	case epoch < v.ActivationEpoch:
+		return ethpb.ValidatorStatus_PENDING_ACTIVE
+	case v.Slashed:
+		return ethpb.ValidatorStatus_EXITED_SLASHED
+	case v.ExitEpoch == params.BeaconConfig().FarFutureEpoch:
+		return ethpb.ValidatorStatus_ACTIVE
+	case epoch >= v.WithdrawableEpoch:
+		return ethpb.ValidatorStatus_WITHDRAWABLE
+	case epoch >= v.ExitEpoch:
+		return ethpb.ValidatorStatus_EXITED
+	default:
+		return ethpb.ValidatorStatus_INITIATED_EXIT
+	}
 }
@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+// defaultAssignmentsCacheSize is the number of committee assignment computations kept in
+// memory when the server does not specify its own cache size. Duties are usually requested
+// for the current and next epoch, so a handful of entries covers back to back requests.
+const defaultAssignmentsCacheSize = 8
+
+var (
+	assignmentsCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "duties_assignments_cache_hit",
+		Help: "The total number of cache hits on the duties committee assignments cache.",
+	})
+	assignmentsCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "duties_assignments_cache_miss",
+		Help: "The total number of cache misses on the duties committee assignments cache.",
+	})
+)
+
+// assignmentsCacheKey identifies a committee assignment computation by the requested
+// epoch and the head block root the computation was derived from. Assignments computed once the
+// wall clock reached the epoch also carry the proposer slots, so they are kept apart from the
+// ones computed before.
+type assignmentsCacheKey struct {
+	epoch        uint64
+	headRoot     [32]byte
+	epochStarted bool
+}
+
+// epochAssignments holds the committee assignments, proposer slots and statuses of every
+// validator for a given epoch, along with the block root they depend on and the members of the
+// sync committees, if any. The values are shared between callers and must be treated as read only.
+type epochAssignments struct {
+	committeeAssignments map[uint64]*helpers.CommitteeAssignmentContainer
+	proposerSlots        map[uint64][]uint64
+	statuses             []ethpb.ValidatorStatus
+	dependentRoot        [32]byte
+	currentSyncCommittee map[string]bool
+	nextSyncCommittee    map[string]bool
+}
+
+// assignmentsCache is an LRU cache of epoch assignments keyed by epoch and head root. All
+// entries are purged whenever a request arrives for a different head root than the one the
+// cache was last populated with.
+type assignmentsCache struct {
+	cache    *lru.Cache
+	headRoot [32]byte
+	lock     sync.Mutex
+}
+
+// newAssignmentsCache creates an assignments cache holding at most size entries.
+func newAssignmentsCache(size int) (*assignmentsCache, error) {
+	if size <= 0 {
+		size = defaultAssignmentsCacheSize
+	}
+	c, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &assignmentsCache{cache: c}, nil
+}
+
+// get returns the cached epoch assignments for the key, if any. A key with a head root
+// different from the last seen head root invalidates every entry in the cache.
+func (c *assignmentsCache) get(key assignmentsCacheKey) (*epochAssignments, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if key.headRoot != c.headRoot {
+		c.cache.Purge()
+		c.headRoot = key.headRoot
+	}
+	obj, ok := c.cache.Get(key)
+	if !ok {
+		assignmentsCacheMiss.Inc()
+		return nil, false
+	}
+	assignmentsCacheHit.Inc()
+	return obj.(*epochAssignments), true
+}
+
+// add inserts the epoch assignments for the key. Entries computed against a stale head root
+// are dropped since they would be purged on the next lookup anyway.
+func (c *assignmentsCache) add(key assignmentsCacheKey, assignments *epochAssignments) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if key.headRoot != c.headRoot {
+		return
+	}
+	c.cache.Add(key, assignments)
+}
+
+// len returns the number of entries currently in the cache.
+func (c *assignmentsCache) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.cache.Len()
+}
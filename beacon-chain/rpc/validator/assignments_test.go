@@ -1,22 +1,37 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
+	"math/rand"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // pubKey is a helper to generate a well-formed public key.
@@ -55,6 +70,65 @@ func TestGetDuties_NextEpoch_WrongPubkeyLength(t *testing.T) {
 	}
 }
 
+func TestGetDuties_MalformedKeyInBatch(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := db.SaveValidatorIndex(ctx, beaconState.Validators[i].PublicKey, uint64(i)); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{beaconState.Validators[0].PublicKey, {1}, beaconState.Validators[1].PublicKey},
+		Epoch:      0,
+	}
+	res, err := vs.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if len(res.Duties) != 3 {
+		t.Fatalf("Expected 3 duties, received %d", len(res.Duties))
+	}
+	malformed := res.Duties[1]
+	if malformed.Status != ethpb.ValidatorStatus_UNKNOWN_STATUS {
+		t.Errorf("Expected malformed key status %v, received %v", ethpb.ValidatorStatus_UNKNOWN_STATUS, malformed.Status)
+	}
+	if !bytes.Equal(malformed.PublicKey, []byte{1}) {
+		t.Errorf("Expected malformed key %#x, received %#x", []byte{1}, malformed.PublicKey)
+	}
+	if len(malformed.Committee) != 0 {
+		t.Errorf("Expected no committee for malformed key, received %v", malformed.Committee)
+	}
+	for _, i := range []int{0, 2} {
+		if res.Duties[i].Status != ethpb.ValidatorStatus_ACTIVE {
+			t.Errorf("Expected status %v for duty %d, received %v", ethpb.ValidatorStatus_ACTIVE, i, res.Duties[i].Status)
+		}
+		if len(res.Duties[i].Committee) == 0 {
+			t.Errorf("Expected committee for duty %d", i)
+		}
+	}
+
+	req.Strict = true
+	want := "incorrect key length"
+	if _, err := vs.GetDuties(ctx, req); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %q, received %v", want, err)
+	}
+}
+
 func TestGetDuties_NextEpoch_CantFindValidatorIdx(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	defer dbutil.TeardownDB(t, db)
@@ -84,6 +158,60 @@ func TestGetDuties_NextEpoch_CantFindValidatorIdx(t *testing.T) {
 	}
 }
 
+func TestGetDuties_MissingValidatorIndexFromState(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	// The validator is in the state but its index was never saved to the database.
+	idx := uint64(5)
+	pubKey := beaconState.Validators[idx].PublicKey
+	if _, ok, err := db.ValidatorIndex(ctx, pubKey); err != nil || ok {
+		t.Fatalf("Expected no validator index in the database, received ok %v, err %v", ok, err)
+	}
+
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{pubKey},
+		Epoch:      0,
+	})
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if res.Duties[0].Status != ethpb.ValidatorStatus_ACTIVE {
+		t.Errorf("Expected status %v, received %v", ethpb.ValidatorStatus_ACTIVE, res.Duties[0].Status)
+	}
+	inCommittee := false
+	for _, i := range res.Duties[0].Committee {
+		if i == idx {
+			inCommittee = true
+		}
+	}
+	if !inCommittee {
+		t.Errorf("Expected validator %d to be in its committee %v", idx, res.Duties[0].Committee)
+	}
+
+	savedIdx, ok, err := db.ValidatorIndex(ctx, pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || savedIdx != idx {
+		t.Errorf("Expected validator index %d to be saved, received %d (found %v)", idx, savedIdx, ok)
+	}
+}
+
 func TestGetDuties_OK(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	defer dbutil.TeardownDB(t, db)
@@ -159,6 +287,89 @@ func TestGetDuties_OK(t *testing.T) {
 	}
 }
 
+func TestGetDuties_CommitteeContents(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	indices := make([]uint64, len(beaconState.Validators))
+	for i := 0; i < len(indices); i++ {
+		indices[i] = uint64(i)
+	}
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: 0})
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	for i, duty := range res.Duties {
+		wanted, err := helpers.BeaconCommitteeFromState(beaconState, duty.AttesterSlot, duty.CommitteeIndex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(duty.Committee, wanted) {
+			t.Errorf("Wanted committee %v for validator %d, received %v", wanted, i, duty.Committee)
+		}
+		position := -1
+		for j, idx := range duty.Committee {
+			if idx == uint64(i) {
+				position = j
+			}
+		}
+		if position < 0 {
+			t.Errorf("Expected validator %d to be in its committee %v", i, duty.Committee)
+		}
+	}
+}
+
+func TestGetDuties_CommitteePosition(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	first := uint64(0)
+	last := uint64(len(beaconState.Validators) - 1)
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: []uint64{first, last}, Epoch: 0})
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	for i, idx := range []uint64{first, last} {
+		duty := res.Duties[i]
+		committee, err := helpers.BeaconCommitteeFromState(beaconState, duty.AttesterSlot, duty.CommitteeIndex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if duty.CommitteeLength != uint64(len(committee)) {
+			t.Errorf("Wanted committee length %d for validator %d, received %d", len(committee), idx, duty.CommitteeLength)
+		}
+		if duty.ValidatorCommitteeIndex >= uint64(len(committee)) || committee[duty.ValidatorCommitteeIndex] != idx {
+			t.Errorf("Wanted validator %d at position %d of committee %v", idx, duty.ValidatorCommitteeIndex, committee)
+		}
+	}
+}
+
 func TestGetDuties_CurrentEpoch_ShouldNotFail(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	defer dbutil.TeardownDB(t, db)
@@ -220,6 +431,121 @@ func TestGetDuties_CurrentEpoch_ShouldNotFail(t *testing.T) {
 	}
 }
 
+func TestGetDuties_NextEpoch_StableAcrossHeadSlots(t *testing.T) {
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	indices := make([]uint64, len(beaconState.Validators))
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+
+	// The committees of the next epoch computed from the head state must match the ones of the
+	// state processed up to the start of that epoch.
+	nextEpoch := helpers.NextEpoch(beaconState)
+	boundaryState, err := state.ProcessSlots(ctx, proto.Clone(beaconState).(*pbp2p.BeaconState), helpers.StartSlot(nextEpoch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted, _, err := helpers.CommitteeAssignments(boundaryState, nextEpoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, slot := range []uint64{0, params.BeaconConfig().SlotsPerEpoch / 2, params.BeaconConfig().SlotsPerEpoch - 1} {
+		// Committees are cached by seed, which is the same for both states.
+		helpers.ClearCache()
+		headState := proto.Clone(beaconState).(*pbp2p.BeaconState)
+		headState.Slot = slot
+		vs := &Server{
+			HeadFetcher: &mockChain.ChainService{State: headState, Root: genesisRoot[:]},
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+		}
+		res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: nextEpoch})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, duty := range res.Duties {
+			want := wanted[indices[i]]
+			if duty.AttesterSlot != want.AttesterSlot || duty.CommitteeIndex != want.CommitteeIndex {
+				t.Errorf(
+					"Head slot %d: wanted validator %d to attest at slot %d in committee %d, received slot %d in committee %d",
+					slot, indices[i], want.AttesterSlot, want.CommitteeIndex, duty.AttesterSlot, duty.CommitteeIndex,
+				)
+			}
+			if !reflect.DeepEqual(duty.Committee, want.Committee) {
+				t.Errorf("Head slot %d: wanted committee %v for validator %d, received %v", slot, want.Committee, indices[i], duty.Committee)
+			}
+		}
+	}
+}
+
+func TestGetDuties_LaggingHead_AdvancesToRequestedEpoch(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	beaconState.Slot = 5
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	indices := make([]uint64, len(beaconState.Validators))
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+
+	// The wall clock is already in the next epoch while the head state lags behind, so the duties
+	// must match the ones of the head state processed up to the start of that epoch.
+	epoch := helpers.NextEpoch(beaconState)
+	boundaryState, err := state.ProcessSlots(ctx, proto.Clone(beaconState).(*pbp2p.BeaconState), helpers.StartSlot(epoch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted, _, err := helpers.CommitteeAssignments(proto.Clone(boundaryState).(*pbp2p.BeaconState), epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedProposerSlots := make(map[uint64][]uint64)
+	for slot := helpers.StartSlot(epoch); slot < helpers.StartSlot(epoch+1); slot++ {
+		boundaryState.Slot = slot
+		proposer, err := helpers.BeaconProposerIndex(boundaryState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantedProposerSlots[proposer] = append(wantedProposerSlots[proposer], slot)
+	}
+
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		GenesisTime: genesisTimeAtSlot(helpers.StartSlot(epoch) + 1),
+	}
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: epoch})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, duty := range res.Duties {
+		want := wanted[indices[i]]
+		if duty.AttesterSlot != want.AttesterSlot || duty.CommitteeIndex != want.CommitteeIndex {
+			t.Errorf(
+				"Wanted validator %d to attest at slot %d in committee %d, received slot %d in committee %d",
+				indices[i], want.AttesterSlot, want.CommitteeIndex, duty.AttesterSlot, duty.CommitteeIndex,
+			)
+		}
+		if !reflect.DeepEqual(duty.ProposerSlots, wantedProposerSlots[indices[i]]) {
+			t.Errorf("Wanted proposer slots %v for validator %d, received %v", wantedProposerSlots[indices[i]], indices[i], duty.ProposerSlots)
+		}
+	}
+	if beaconState.Slot != 5 {
+		t.Errorf("Expected head state to stay at slot 5, received slot %d", beaconState.Slot)
+	}
+}
+
 func TestGetDuties_MultipleKeys_OK(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	defer dbutil.TeardownDB(t, db)
@@ -289,75 +615,1331 @@ func TestGetDuties_MultipleKeys_OK(t *testing.T) {
 	}
 }
 
-func TestGetDuties_SyncNotReady(t *testing.T) {
-	vs := &Server{
-		SyncChecker: &mockSync.Sync{IsSyncing: true},
-	}
-	_, err := vs.GetDuties(context.Background(), &ethpb.DutiesRequest{})
-	if strings.Contains(err.Error(), "syncing to latest head") {
-		t.Error("Did not get wanted error")
-	}
-}
-
-func BenchmarkCommitteeAssignment(b *testing.B) {
-	db := dbutil.SetupDB(b)
-	defer dbutil.TeardownDB(b, db)
+func TestGetDuties_ProposerSlots(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
 	ctx := context.Background()
 
+	bState, _ := testutil.DeterministicGenesisState(t, 64)
 	genesis := blk.NewGenesisBlock([]byte{})
-	depChainStart := uint64(8192 * 2)
-	deposits, _, _ := testutil.DeterministicDepositsAndKeys(depChainStart)
-	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
-	if err != nil {
-		b.Fatal(err)
-	}
-	state, err := state.GenesisBeaconState(deposits, 0, eth1Data)
-	if err != nil {
-		b.Fatalf("Could not setup genesis state: %v", err)
-	}
 	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
 	if err != nil {
-		b.Fatalf("Could not get signing root %v", err)
+		t.Fatalf("Could not get signing root %v", err)
 	}
-
-	var wg sync.WaitGroup
-	numOfValidators := int(depChainStart)
-	errs := make(chan error, numOfValidators)
-	for i := 0; i < numOfValidators; i++ {
-		wg.Add(1)
-		go func(index int) {
-			errs <- db.SaveValidatorIndex(ctx, deposits[index].Data.PublicKey, uint64(index))
-			wg.Done()
-		}(i)
+	pks := make([][]byte, len(bState.Validators))
+	for i, v := range bState.Validators {
+		pks[i] = v.PublicKey
+		if err := db.SaveValidatorIndex(ctx, v.PublicKey, uint64(i)); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
 	}
-	wg.Wait()
-	close(errs)
-	for err := range errs {
+
+	// Compute the expected proposers from copies of the state at every slot of the epoch.
+	wantedProposers := make(map[uint64]uint64)
+	for slot := uint64(0); slot < params.BeaconConfig().SlotsPerEpoch; slot++ {
+		st := proto.Clone(bState).(*pbp2p.BeaconState)
+		st.Slot = slot
+		idx, err := helpers.BeaconProposerIndex(st)
 		if err != nil {
-			b.Fatalf("Could not save validator index: %v", err)
+			t.Fatal(err)
 		}
+		wantedProposers[slot] = idx
 	}
 
 	vs := &Server{
 		BeaconDB:    db,
-		HeadFetcher: &mockChain.ChainService{State: state, Root: genesisRoot[:]},
+		HeadFetcher: &mockChain.ChainService{State: bState, Root: genesisRoot[:]},
 		SyncChecker: &mockSync.Sync{IsSyncing: false},
 	}
 
-	// Create request for all validators in the system.
-	pks := make([][]byte, len(deposits))
-	for i, deposit := range deposits {
-		pks[i] = deposit.Data.PublicKey
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{PublicKeys: pks, Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
 	}
-	req := &ethpb.DutiesRequest{
-		PublicKeys: pks,
-		Epoch:      0,
+	proposerSlotCount := 0
+	for i, duty := range res.Duties {
+		for _, slot := range duty.ProposerSlots {
+			proposerSlotCount++
+			if wantedProposers[slot] != uint64(i) {
+				t.Errorf("Expected proposer of slot %d to be %d, received %d", slot, wantedProposers[slot], i)
+			}
+		}
+		if len(duty.ProposerSlots) > 0 && duty.ProposerSlot != duty.ProposerSlots[0] {
+			t.Errorf("Expected proposer slot %d, received %d", duty.ProposerSlots[0], duty.ProposerSlot)
+		}
 	}
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := vs.GetDuties(context.Background(), req)
-		if err != nil {
-			b.Error(err)
+	if proposerSlotCount != int(params.BeaconConfig().SlotsPerEpoch) {
+		t.Errorf("Expected %d proposer slots, received %d", params.BeaconConfig().SlotsPerEpoch, proposerSlotCount)
+	}
+
+	// Proposers of the next epoch are not known yet.
+	res, err = vs.GetDuties(ctx, &ethpb.DutiesRequest{PublicKeys: pks, Epoch: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, duty := range res.Duties {
+		if len(duty.ProposerSlots) != 0 {
+			t.Errorf("Expected no proposer slots for next epoch, received %v", duty.ProposerSlots)
 		}
 	}
 }
+
+func TestGetDuties_ByIndices(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	pubKey0 := bState.Validators[0].PublicKey
+	if err := db.SaveValidatorIndex(ctx, pubKey0, 0); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: bState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	byKey, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{PublicKeys: [][]byte{pubKey0}, Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byIndex, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: []uint64{0}, Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(byKey.Duties[0], byIndex.Duties[0]) {
+		t.Errorf("Wanted duty %v, received %v", byKey.Duties[0], byIndex.Duties[0])
+	}
+
+	// Validator 0 is requested both by public key and by index.
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{pubKey0},
+		Indices:    []uint64{0, 1, 1},
+		Epoch:      0,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Duties) != 2 {
+		t.Fatalf("Expected 2 duties, received %d", len(res.Duties))
+	}
+	if !bytes.Equal(res.Duties[0].PublicKey, pubKey0) {
+		t.Errorf("Expected first duty for public key %#x, received %#x", pubKey0, res.Duties[0].PublicKey)
+	}
+	if !bytes.Equal(res.Duties[1].PublicKey, bState.Validators[1].PublicKey) {
+		t.Errorf("Expected second duty for public key %#x, received %#x", bState.Validators[1].PublicKey, res.Duties[1].PublicKey)
+	}
+	if res.Duties[1].Status != ethpb.ValidatorStatus_ACTIVE {
+		t.Errorf("Expected active status, received %v", res.Duties[1].Status)
+	}
+}
+
+func TestGetDuties_EpochLookahead(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	indices := make([]uint64, len(bState.Validators))
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: bState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: 0, EpochLookahead: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.EpochDuties) != 2 {
+		t.Fatalf("Expected duties of 2 epochs, received %d", len(res.EpochDuties))
+	}
+	for i, epochDuties := range res.EpochDuties {
+		epoch := uint64(i)
+		if epochDuties.Epoch != epoch {
+			t.Errorf("Expected duties of epoch %d, received epoch %d", epoch, epochDuties.Epoch)
+		}
+		wanted, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: epoch})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(epochDuties.Duties) != len(wanted.Duties) {
+			t.Fatalf("Expected %d duties in epoch %d, received %d", len(wanted.Duties), epoch, len(epochDuties.Duties))
+		}
+		proposerSlotCount := 0
+		for j, duty := range epochDuties.Duties {
+			if !proto.Equal(duty, wanted.Duties[j]) {
+				t.Errorf("Expected duty %v in epoch %d, received %v", wanted.Duties[j], epoch, duty)
+			}
+			if helpers.SlotToEpoch(duty.AttesterSlot) != epoch {
+				t.Errorf("Expected attester slot in epoch %d, received slot %d", epoch, duty.AttesterSlot)
+			}
+			proposerSlotCount += len(duty.ProposerSlots)
+		}
+		// Proposers are only known up to the current epoch.
+		if epoch == 0 && proposerSlotCount != int(params.BeaconConfig().SlotsPerEpoch) {
+			t.Errorf("Expected %d proposer slots in epoch 0, received %d", params.BeaconConfig().SlotsPerEpoch, proposerSlotCount)
+		}
+		if epoch > 0 && proposerSlotCount != 0 {
+			t.Errorf("Expected no proposer slots in epoch %d, received %d", epoch, proposerSlotCount)
+		}
+	}
+	if !reflect.DeepEqual(res.Duties, res.EpochDuties[0].Duties) {
+		t.Error("Expected duties of the requested epoch to match the duties of the first epoch")
+	}
+
+	_, err = vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: 0, EpochLookahead: 3})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for a lookahead over the maximum, received %v", err)
+	}
+}
+
+func TestGetDuties_CommitteeIndexFilter(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	indices := make([]uint64, len(bState.Validators))
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: bState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	all, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wanted []*ethpb.DutiesResponse_Duty
+	for _, duty := range all.Duties {
+		if duty.CommitteeIndex == 1 {
+			wanted = append(wanted, duty)
+		}
+	}
+	if len(wanted) == 0 || len(wanted) == len(all.Duties) {
+		t.Fatalf("Expected validators in several committees, received %d of %d in committee 1", len(wanted), len(all.Duties))
+	}
+
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: 0, CommitteeIndexFilter: []uint64{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Duties) != len(wanted) {
+		t.Fatalf("Expected %d duties, received %d", len(wanted), len(res.Duties))
+	}
+	for i, duty := range res.Duties {
+		if duty.CommitteeIndex != 1 {
+			t.Errorf("Expected only duties of committee 1, received committee %d", duty.CommitteeIndex)
+		}
+		if !proto.Equal(duty, wanted[i]) {
+			t.Errorf("Expected duty %v, received %v", wanted[i], duty)
+		}
+	}
+	if len(res.RequestDutyIndices) != 0 {
+		t.Errorf("Expected no request duty indices with a committee index filter, received %v", res.RequestDutyIndices)
+	}
+}
+
+func TestGetDuties_AtBlockRoot(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	slashedState := proto.Clone(beaconState).(*pbp2p.BeaconState)
+	slashedState.Validators[0].Slashed = true
+	activeRoot := [32]byte{'a'}
+	slashedRoot := [32]byte{'b'}
+	if err := db.SaveState(ctx, beaconState, activeRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, slashedState, slashedRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	req := &ethpb.DutiesRequest{
+		Indices:   []uint64{0},
+		Epoch:     0,
+		BlockRoot: activeRoot[:],
+	}
+	res, err := vs.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if res.Duties[0].Status != ethpb.ValidatorStatus_ACTIVE {
+		t.Errorf("Expected status %v, received %v", ethpb.ValidatorStatus_ACTIVE, res.Duties[0].Status)
+	}
+	if len(res.Duties[0].Committee) == 0 {
+		t.Error("Expected active validator to be assigned to a committee")
+	}
+
+	req.BlockRoot = slashedRoot[:]
+	res, err = vs.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if res.Duties[0].Status != ethpb.ValidatorStatus_EXITED_SLASHED {
+		t.Errorf("Expected status %v, received %v", ethpb.ValidatorStatus_EXITED_SLASHED, res.Duties[0].Status)
+	}
+	if len(res.Duties[0].Committee) != 0 {
+		t.Errorf("Expected no committee for slashed validator, received %v", res.Duties[0].Committee)
+	}
+
+	req.BlockRoot = []byte{'c'}
+	want := "Could not find state at root"
+	if _, err := vs.GetDuties(ctx, req); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %q, received %v", want, err)
+	}
+}
+
+func TestGetDuties_DependentRoot(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	// At genesis, the duties of the current and next epoch depend on the genesis block.
+	for _, epoch := range []uint64{0, 1} {
+		res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: []uint64{0}, Epoch: epoch})
+		if err != nil {
+			t.Fatalf("Could not call epoch committee assignment %v", err)
+		}
+		if !bytes.Equal(res.Duties[0].DependentRoot, genesisRoot[:]) {
+			t.Errorf("Wanted dependent root %#x for epoch %d, received %#x", genesisRoot, epoch, res.Duties[0].DependentRoot)
+		}
+	}
+
+	// Once the chain moved into epoch 1, its duties depend on the last block of epoch 0 while
+	// the duties of epoch 0 still depend on the genesis block.
+	headState := proto.Clone(beaconState).(*pbp2p.BeaconState)
+	headState.Slot = params.BeaconConfig().SlotsPerEpoch + 2
+	for i := uint64(0); i < headState.Slot; i++ {
+		r := [32]byte{byte(i + 1)}
+		headState.BlockRoots[i] = r[:]
+	}
+	headState.BlockRoots[0] = genesisRoot[:]
+	headRoot := [32]byte{'h'}
+	vs = &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: headState, Root: headRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	wanted := [][]byte{
+		genesisRoot[:],
+		headState.BlockRoots[params.BeaconConfig().SlotsPerEpoch-1],
+		headRoot[:],
+	}
+	for epoch, root := range wanted {
+		res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: []uint64{0}, Epoch: uint64(epoch)})
+		if err != nil {
+			t.Fatalf("Could not call epoch committee assignment %v", err)
+		}
+		if !bytes.Equal(res.Duties[0].DependentRoot, root) {
+			t.Errorf("Wanted dependent root %#x for epoch %d, received %#x", root, epoch, res.Duties[0].DependentRoot)
+		}
+	}
+}
+
+func TestGetDuties_PreviousDependentRoot(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	beaconState.Slot = params.BeaconConfig().SlotsPerEpoch + 2
+	for i := uint64(0); i < beaconState.Slot; i++ {
+		r := [32]byte{byte(i + 1)}
+		beaconState.BlockRoots[i] = r[:]
+	}
+	headRoot := [32]byte{'h'}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: headRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	req := &ethpb.DutiesRequest{Indices: []uint64{0, 1}, Epoch: 1}
+	res, err := vs.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if res.Unchanged || len(res.Duties) != 2 {
+		t.Fatalf("Expected the full set of duties, received %v", res)
+	}
+	dependentRoot := res.Duties[0].DependentRoot
+
+	// The same dependent root for the same epoch returns nothing.
+	req.PreviousDependentRoot = dependentRoot
+	res, err = vs.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if !res.Unchanged {
+		t.Error("Expected duties to be flagged as unchanged")
+	}
+	if len(res.Duties) != 0 {
+		t.Errorf("Expected no duties, received %v", res.Duties)
+	}
+
+	// A new epoch depends on a different root and returns everything.
+	req.Epoch = 2
+	res, err = vs.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if res.Unchanged {
+		t.Error("Expected duties of a new epoch to not be flagged as unchanged")
+	}
+	if len(res.Duties) != 2 {
+		t.Errorf("Expected the full set of duties, received %v", res.Duties)
+	}
+	if bytes.Equal(res.Duties[0].DependentRoot, dependentRoot) {
+		t.Errorf("Expected a different dependent root than %#x", dependentRoot)
+	}
+}
+
+func TestGetDuties_IndexOutOfRange(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+
+	bState, _ := testutil.DeterministicGenesisState(t, 64)
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: bState, Root: []byte{'a'}},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{bState.Validators[0].PublicKey},
+		Indices:    []uint64{64},
+		Epoch:      0,
+	}
+	want := "validator index 64 out of range"
+	if _, err := vs.GetDuties(context.Background(), req); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %q, received %v", want, err)
+	}
+}
+
+func TestGetDuties_MaxValidatorsPerRequest(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+
+	bState, _ := testutil.DeterministicGenesisState(t, 64)
+	for i := uint64(0); i < 2; i++ {
+		if err := db.SaveValidatorIndex(context.Background(), bState.Validators[i].PublicKey, i); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+	vs := &Server{
+		BeaconDB:                      db,
+		HeadFetcher:                   &mockChain.ChainService{State: bState, Root: []byte{'a'}},
+		SyncChecker:                   &mockSync.Sync{IsSyncing: false},
+		MaxValidatorsPerDutiesRequest: 4,
+	}
+
+	// Public keys and indices both count towards the limit.
+	atLimit := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{bState.Validators[0].PublicKey, bState.Validators[1].PublicKey},
+		Indices:    []uint64{2, 3},
+		Epoch:      0,
+	}
+	res, err := vs.GetDuties(context.Background(), atLimit)
+	if err != nil {
+		t.Fatalf("Expected a request at the limit to succeed, received %v", err)
+	}
+	if len(res.Duties) != 4 {
+		t.Errorf("Expected 4 duties, received %d", len(res.Duties))
+	}
+
+	overLimit := &ethpb.DutiesRequest{
+		PublicKeys: atLimit.PublicKeys,
+		Indices:    []uint64{2, 3, 4},
+		Epoch:      0,
+	}
+	if _, err := vs.GetDuties(context.Background(), overLimit); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %v error for a request over the limit, received %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestGetDuties_ValidatorStatuses(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bState, _ := testutil.DeterministicGenesisState(t, 64)
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	// Validator 1 is slashed, validator 2 has exited, validator 3 is exiting and validator 4
+	// is pending activation.
+	bState.Validators[1].Slashed = true
+	bState.Validators[1].ExitEpoch = 10
+	bState.Validators[1].WithdrawableEpoch = 20
+	bState.Validators[2].ExitEpoch = 0
+	bState.Validators[2].WithdrawableEpoch = 10
+	bState.Validators[3].ExitEpoch = 10
+	bState.Validators[3].WithdrawableEpoch = 20
+	bState.Validators[4].ActivationEpoch = farFutureEpoch
+	bState.Validators[4].ActivationEligibilityEpoch = farFutureEpoch
+
+	pks := make([][]byte, 5)
+	for i := 0; i < len(pks); i++ {
+		pks[i] = bState.Validators[i].PublicKey
+		if err := db.SaveValidatorIndex(ctx, pks[i], uint64(i)); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: bState, Root: []byte{'a'}},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{PublicKeys: pks, Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wanted := []ethpb.ValidatorStatus{
+		ethpb.ValidatorStatus_ACTIVE,
+		ethpb.ValidatorStatus_EXITED_SLASHED,
+		ethpb.ValidatorStatus_EXITED,
+		ethpb.ValidatorStatus_INITIATED_EXIT,
+		ethpb.ValidatorStatus_PENDING_ACTIVE,
+	}
+	for i, duty := range res.Duties {
+		if duty.Status != wanted[i] {
+			t.Errorf("Expected status %v for validator %d, received %v", wanted[i], i, duty.Status)
+		}
+	}
+
+	slashedDuty := res.Duties[1]
+	if slashedDuty.AttesterSlot != 0 || len(slashedDuty.Committee) != 0 {
+		t.Errorf("Expected no attester duty for slashed validator, received slot %d in committee %v",
+			slashedDuty.AttesterSlot, slashedDuty.Committee)
+	}
+	if len(slashedDuty.ProposerSlots) != 0 {
+		t.Errorf("Expected no proposer duty for slashed validator, received %v", slashedDuty.ProposerSlots)
+	}
+	if len(res.Duties[3].Committee) == 0 {
+		t.Error("Expected exiting validator to still be assigned to a committee")
+	}
+}
+
+// dutiesStream is a fake duties stream forwarding every response sent over it to a channel.
+type dutiesStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.DutiesResponse
+}
+
+func (s *dutiesStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *dutiesStream) Send(res *ethpb.DutiesResponse) error {
+	s.sent <- res
+	return nil
+}
+
+// headChainService guards the head of a mock chain service, so that a test can move the head
+// while a stream reads it.
+type headChainService struct {
+	*mockChain.ChainService
+	lock sync.RWMutex
+}
+
+func (c *headChainService) setHead(s *pbp2p.BeaconState, root []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.State = s
+	c.Root = root
+}
+
+func (c *headChainService) HeadSlot() uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.ChainService.HeadSlot()
+}
+
+func (c *headChainService) HeadRoot(ctx context.Context) ([]byte, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.ChainService.HeadRoot(ctx)
+}
+
+func (c *headChainService) HeadState(ctx context.Context) (*pbp2p.BeaconState, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.ChainService.HeadState(ctx)
+}
+
+func (c *headChainService) HeadStateReadOnly(ctx context.Context) (*pbp2p.BeaconState, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.ChainService.HeadStateReadOnly(ctx)
+}
+
+func (c *headChainService) HeadRootAndStateReadOnly(ctx context.Context) ([]byte, *pbp2p.BeaconState, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.ChainService.HeadRootAndStateReadOnly(ctx)
+}
+
+func TestStreamDuties_PushesOnEpochTransition(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	pubKeys := make([][]byte, 4)
+	for i := 0; i < len(pubKeys); i++ {
+		pubKeys[i] = beaconState.Validators[i].PublicKey
+		if err := db.SaveValidatorIndex(ctx, pubKeys[i], uint64(i)); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+	nextEpochState, err := state.ProcessSlots(ctx, proto.Clone(beaconState).(*pbp2p.BeaconState), params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not process slots: %v", err)
+	}
+
+	chainService := &headChainService{
+		ChainService: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+	}
+	vs := &Server{
+		Ctx:           ctx,
+		BeaconDB:      db,
+		HeadFetcher:   chainService,
+		SyncChecker:   &mockSync.Sync{IsSyncing: false},
+		StateNotifier: chainService.StateNotifier(),
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := &dutiesStream{
+		ctx:  streamCtx,
+		sent: make(chan *ethpb.DutiesResponse, 3),
+	}
+	exitRoutine := make(chan error)
+	go func() {
+		exitRoutine <- vs.StreamDuties(&ethpb.DutiesRequest{PublicKeys: pubKeys}, stream)
+	}()
+	initialRes := <-stream.sent
+
+	// A block within the same epoch does not change any duty and is not pushed.
+	vs.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{BlockRoot: genesisRoot},
+	})
+
+	chainService.setHead(nextEpochState, []byte{'a'})
+	vs.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{BlockRoot: bytesutil.ToBytes32([]byte{'a'})},
+	})
+	nextRes := <-stream.sent
+
+	cancel()
+	if err := <-exitRoutine; err == nil || !strings.Contains(err.Error(), "Stream context canceled") {
+		t.Errorf("Expected stream to be canceled, received %v", err)
+	}
+	if len(stream.sent) != 0 {
+		t.Errorf("Expected exactly 2 pushes, received %d", 2+len(stream.sent))
+	}
+	for i, duty := range initialRes.Duties {
+		if helpers.SlotToEpoch(duty.AttesterSlot) != 0 {
+			t.Errorf("Expected initial attester slot of validator %d in epoch 0, received slot %d", i, duty.AttesterSlot)
+		}
+	}
+	for i, duty := range nextRes.Duties {
+		if helpers.SlotToEpoch(duty.AttesterSlot) != 1 {
+			t.Errorf("Expected pushed attester slot of validator %d in epoch 1, received slot %d", i, duty.AttesterSlot)
+		}
+	}
+}
+
+func TestGetDuties_ConcurrentHeadUpdates(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	nextEpochState, err := state.ProcessSlots(ctx, proto.Clone(beaconState).(*pbp2p.BeaconState), params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not process slots: %v", err)
+	}
+
+	chainService := &headChainService{
+		ChainService: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: chainService,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	indices := make([]uint64, len(beaconState.Validators))
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: indices, Epoch: 1}); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	// The head moves back and forth between both states while duties are computed from them.
+	for i := 0; i < 8; i++ {
+		if i%2 == 0 {
+			chainService.setHead(nextEpochState, []byte{'a'})
+		} else {
+			chainService.setHead(beaconState, genesisRoot[:])
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	if beaconState.Slot != 0 {
+		t.Errorf("Expected head state to stay at slot 0, received slot %d", beaconState.Slot)
+	}
+	if nextEpochState.Slot != params.BeaconConfig().SlotsPerEpoch {
+		t.Errorf("Expected head state to stay at slot %d, received slot %d", params.BeaconConfig().SlotsPerEpoch, nextEpochState.Slot)
+	}
+}
+
+func TestGetDuties_BoundaryStateCache(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+
+	otherState := proto.Clone(beaconState).(*pbp2p.BeaconState)
+	otherState.Validators[0].Slashed = true
+	boundaryCache := cache.NewCheckpointStateCache()
+	cached := &Server{
+		BeaconDB:           db,
+		HeadFetcher:        &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker:        &mockSync.Sync{IsSyncing: false},
+		BoundaryStateCache: boundaryCache,
+	}
+	uncached := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	indices := make([]uint64, len(beaconState.Validators))
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+	// Committees of the next epoch are computed without advancing the head state, so the
+	// boundary state is only needed for epochs further ahead.
+	req := &ethpb.DutiesRequest{Indices: indices, Epoch: 2}
+	res, err := cached.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted, err := uncached.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Expected duties %v, received %v", wanted, res)
+	}
+
+	cp := &ethpb.Checkpoint{Epoch: 2, Root: genesisRoot[:]}
+	boundaryState, err := boundaryCache.StateByCheckpoint(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if boundaryState == nil {
+		t.Fatal("Expected epoch boundary state to be cached")
+	}
+	if boundaryState.Slot != helpers.StartSlot(2) {
+		t.Errorf("Expected cached state at slot %d, received %d", helpers.StartSlot(2), boundaryState.Slot)
+	}
+
+	// A cached boundary state is returned without advancing the given state again.
+	s, err := cached.epochBoundaryState(ctx, otherState, genesisRoot, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Validators[0].Slashed {
+		t.Error("Expected the cached epoch boundary state to be returned")
+	}
+	// A different boundary block root, such as after a reorg, does not hit the cache.
+	s, err = cached.epochBoundaryState(ctx, otherState, [32]byte{'a'}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.Validators[0].Slashed {
+		t.Error("Expected the epoch boundary state to be recomputed for a different block root")
+	}
+}
+
+func TestGetDuties_SyncNotReady(t *testing.T) {
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+	_, err := vs.GetDuties(context.Background(), &ethpb.DutiesRequest{})
+	if err == nil || !strings.Contains(err.Error(), "beacon node is syncing to latest head") {
+		t.Errorf("Did not get wanted error, received %v", err)
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Wanted code %v, received %v", codes.Unavailable, status.Code(err))
+	}
+}
+
+func TestGetDuties_HeadStateNotAvailable(t *testing.T) {
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: nil, Root: make([]byte, 32)},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	_, err := vs.GetDuties(context.Background(), &ethpb.DutiesRequest{})
+	if err == nil || !strings.Contains(err.Error(), "head state not available yet") {
+		t.Errorf("Did not get wanted error, received %v", err)
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Wanted code %v, received %v", codes.Unavailable, status.Code(err))
+	}
+}
+
+func TestGetDuties_DuplicateKeys(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := db.SaveValidatorIndex(ctx, beaconState.Validators[i].PublicKey, uint64(i)); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	keyA := beaconState.Validators[0].PublicKey
+	keyB := beaconState.Validators[1].PublicKey
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{keyA, keyA, keyB, keyA},
+		Indices:    []uint64{0, 1},
+	}
+	res, err := vs.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Duties) != 2 {
+		t.Fatalf("Wanted 2 duties, received %d", len(res.Duties))
+	}
+	if !bytes.Equal(res.Duties[0].PublicKey, keyA) || !bytes.Equal(res.Duties[1].PublicKey, keyB) {
+		t.Error("Wanted duties in first-seen request order")
+	}
+	wanted := []uint64{0, 0, 1, 0, 0, 1}
+	if !reflect.DeepEqual(res.RequestDutyIndices, wanted) {
+		t.Errorf("Wanted request duty indices %v, received %v", wanted, res.RequestDutyIndices)
+	}
+}
+
+// cancelingDB cancels the request context once a given number of validator indices have
+// been looked up, simulating a caller going away partway through a duties request.
+type cancelingDB struct {
+	db.ReadOnlyDatabase
+	cancel      context.CancelFunc
+	cancelAfter int
+	lookups     int
+}
+
+func (c *cancelingDB) ValidatorIndex(ctx context.Context, publicKey []byte) (uint64, bool, error) {
+	c.lookups++
+	if c.lookups == c.cancelAfter {
+		c.cancel()
+	}
+	return c.ReadOnlyDatabase.ValidatorIndex(ctx, publicKey)
+}
+
+func TestGetDuties_ContextCanceled(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cdb := &cancelingDB{ReadOnlyDatabase: db, cancel: cancel, cancelAfter: 100}
+	vs := &Server{
+		BeaconDB:    cdb,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	numKeys := 1000
+	pubKeys := make([][]byte, numKeys)
+	for i := 0; i < numKeys; i++ {
+		pubKeys[i] = pubKey(uint64(i))
+	}
+	_, err = vs.GetDuties(ctx, &ethpb.DutiesRequest{PublicKeys: pubKeys})
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("Wanted code %v, received %v", codes.Canceled, err)
+	}
+	if cdb.lookups >= numKeys {
+		t.Errorf("Wanted the request to stop before looking up all %d keys, looked up %d", numKeys, cdb.lookups)
+	}
+}
+
+func TestGetDuties_AssignmentsCache_Eviction(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	if err := db.SaveValidatorIndex(ctx, beaconState.Validators[0].PublicKey, 0); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
+	}
+
+	vs := &Server{
+		BeaconDB:             db,
+		HeadFetcher:          &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker:          &mockSync.Sync{IsSyncing: false},
+		AssignmentsCacheSize: 1,
+	}
+
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{beaconState.Validators[0].PublicKey},
+		Epoch:      0,
+	}
+	if _, err := vs.GetDuties(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := vs.assignmentsCache.get(assignmentsCacheKey{epoch: 0, headRoot: genesisRoot}); !ok {
+		t.Error("Expected epoch 0 assignments to be cached")
+	}
+
+	req.Epoch = 1
+	if _, err := vs.GetDuties(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if vs.assignmentsCache.len() != 1 {
+		t.Errorf("Expected cache size of 1, received %d", vs.assignmentsCache.len())
+	}
+	if _, ok := vs.assignmentsCache.get(assignmentsCacheKey{epoch: 0, headRoot: genesisRoot}); ok {
+		t.Error("Expected epoch 0 assignments to be evicted")
+	}
+	if _, ok := vs.assignmentsCache.get(assignmentsCacheKey{epoch: 1, headRoot: genesisRoot}); !ok {
+		t.Error("Expected epoch 1 assignments to be cached")
+	}
+}
+
+func TestGetDuties_AssignmentsCache_HeadRootChange(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+
+	chainService := &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: chainService,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{beaconState.Validators[0].PublicKey},
+		Epoch:      0,
+	}
+	if _, err := vs.GetDuties(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if vs.assignmentsCache.len() != 1 {
+		t.Fatalf("Expected cache size of 1, received %d", vs.assignmentsCache.len())
+	}
+
+	newRoot := [32]byte{'a'}
+	chainService.Root = newRoot[:]
+	if _, err := vs.GetDuties(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if vs.assignmentsCache.len() != 1 {
+		t.Errorf("Expected cache size of 1, received %d", vs.assignmentsCache.len())
+	}
+	if _, ok := vs.assignmentsCache.get(assignmentsCacheKey{epoch: 0, headRoot: newRoot}); !ok {
+		t.Error("Expected assignments for new head root to be cached")
+	}
+	if _, ok := vs.assignmentsCache.get(assignmentsCacheKey{epoch: 0, headRoot: genesisRoot}); ok {
+		t.Error("Expected assignments for old head root to be invalidated")
+	}
+}
+
+func TestGetDuties_AssignmentsCache_ConcurrentRequests(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	for i, v := range beaconState.Validators {
+		if err := db.SaveValidatorIndex(ctx, v.PublicKey, uint64(i)); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{beaconState.Validators[0].PublicKey, beaconState.Validators[1].PublicKey},
+		Epoch:      0,
+	}
+	want, err := vs.GetDuties(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := vs.GetDuties(ctx, req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for j, duty := range res.Duties {
+				if duty.AttesterSlot != want.Duties[j].AttesterSlot {
+					errs <- fmt.Errorf("wanted attester slot %d, received %d", want.Duties[j].AttesterSlot, duty.AttesterSlot)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func BenchmarkCommitteeAssignment(b *testing.B) {
+	db := dbutil.SetupDB(b)
+	defer dbutil.TeardownDB(b, db)
+	ctx := context.Background()
+
+	genesis := blk.NewGenesisBlock([]byte{})
+	depChainStart := uint64(8192 * 2)
+	deposits, _, _ := testutil.DeterministicDepositsAndKeys(depChainStart)
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	if err != nil {
+		b.Fatal(err)
+	}
+	state, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	if err != nil {
+		b.Fatalf("Could not setup genesis state: %v", err)
+	}
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		b.Fatalf("Could not get signing root %v", err)
+	}
+
+	var wg sync.WaitGroup
+	numOfValidators := int(depChainStart)
+	errs := make(chan error, numOfValidators)
+	for i := 0; i < numOfValidators; i++ {
+		wg.Add(1)
+		go func(index int) {
+			errs <- db.SaveValidatorIndex(ctx, deposits[index].Data.PublicKey, uint64(index))
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			b.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: state, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	// Create request for all validators in the system.
+	pks := make([][]byte, len(deposits))
+	for i, deposit := range deposits {
+		pks[i] = deposit.Data.PublicKey
+	}
+	req := &ethpb.DutiesRequest{
+		PublicKeys: pks,
+		Epoch:      0,
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := vs.GetDuties(context.Background(), req)
+		if err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+// churnedState returns a state advanced the given number of epochs from a genesis state of the
+// given number of validators, with as many validators initiating their exit and new validators
+// entering the activation queue as the churn limit allows at every epoch. The validators churning
+// are drawn from a generator of the given seed, so the resulting state is reproducible.
+func churnedState(b *testing.B, numValidators uint64, epochs uint64, seed int64) *pbp2p.BeaconState {
+	ctx := context.Background()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(numValidators)
+	if err != nil {
+		b.Fatal(err)
+	}
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	if err != nil {
+		b.Fatal(err)
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	if err != nil {
+		b.Fatalf("Could not setup genesis state: %v", err)
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	for epoch := uint64(0); epoch < epochs; epoch++ {
+		activeCount, err := helpers.ActiveValidatorCount(beaconState, helpers.CurrentEpoch(beaconState))
+		if err != nil {
+			b.Fatal(err)
+		}
+		churn, err := helpers.ValidatorChurnLimit(activeCount)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for i := uint64(0); i < churn; i++ {
+			// Exits already initiated are a no-op, so a few epochs may churn less than the limit.
+			idx := uint64(r.Int63n(int64(len(beaconState.Validators))))
+			beaconState, err = validators.InitiateValidatorExit(beaconState, idx)
+			if err != nil {
+				b.Fatal(err)
+			}
+			// The new validators are eligible as of the finalized checkpoint, so the epoch
+			// transitions activate them as the churn limit allows.
+			beaconState.Validators = append(beaconState.Validators, &ethpb.Validator{
+				PublicKey:                  pubKey(uint64(len(beaconState.Validators))),
+				WithdrawalCredentials:      make([]byte, 32),
+				EffectiveBalance:           params.BeaconConfig().MaxEffectiveBalance,
+				ActivationEligibilityEpoch: beaconState.FinalizedCheckpoint.Epoch,
+				ActivationEpoch:            params.BeaconConfig().FarFutureEpoch,
+				ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+				WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
+			})
+			beaconState.Balances = append(beaconState.Balances, params.BeaconConfig().MaxEffectiveBalance)
+		}
+		beaconState, err = state.ProcessSlots(ctx, beaconState, helpers.StartSlot(epoch+1))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	return beaconState
+}
+
+// BenchmarkGetDuties_ChurnedRegistry measures the duties of every validator of a registry which
+// went through several epochs of exits and activations. The assignments and committee caches are
+// cleared at every iteration, so each request shuffles the churned registry again.
+func BenchmarkGetDuties_ChurnedRegistry(b *testing.B) {
+	beaconState := churnedState(b, 8192, 8, 42)
+	indices := make([]uint64, len(beaconState.Validators))
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: []byte{'a'}},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	req := &ethpb.DutiesRequest{
+		Indices: indices,
+		Epoch:   helpers.CurrentEpoch(beaconState),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		vs.assignmentsCache = nil
+		helpers.ClearCache()
+		b.StartTimer()
+		if _, err := vs.GetDuties(context.Background(), req); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetDuties_BoundaryStateCache(b *testing.B) {
+	db := dbutil.SetupDB(b)
+	defer dbutil.TeardownDB(b, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(b, 1024)
+	root := [32]byte{'a'}
+	if err := db.SaveState(ctx, beaconState, root); err != nil {
+		b.Fatal(err)
+	}
+	// Duties at a saved block root bypass the assignments cache, so every request has to
+	// advance the state to the boundary of the epoch after next unless the boundary state is cached.
+	req := &ethpb.DutiesRequest{
+		Indices:   []uint64{0, 1, 2, 3},
+		Epoch:     2,
+		BlockRoot: root[:],
+	}
+	benchmarks := []struct {
+		name  string
+		cache *cache.CheckpointStateCache
+	}{
+		{name: "cached", cache: cache.NewCheckpointStateCache()},
+		{name: "uncached"},
+	}
+	for _, bm := range benchmarks {
+		vs := &Server{
+			BeaconDB:           db,
+			HeadFetcher:        &mockChain.ChainService{State: beaconState, Root: root[:]},
+			SyncChecker:        &mockSync.Sync{IsSyncing: false},
+			BoundaryStateCache: bm.cache,
+		}
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := vs.GetDuties(ctx, req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetDuties_NoActiveValidators(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 2)
+	for _, v := range beaconState.Validators {
+		v.ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+	}
+	block := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(ctx, block); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	genesisRoot, err := ssz.HashTreeRoot(block.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{beaconState.Validators[0].PublicKey},
+		Epoch:      0,
+	}
+	_, err = vs.GetDuties(ctx, req)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Wanted code %v, received %v", codes.FailedPrecondition, err)
+	}
+	if !strings.Contains(err.Error(), "no active validators") {
+		t.Errorf("Expected error about no active validators, received %v", err)
+	}
+}
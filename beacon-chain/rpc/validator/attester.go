@@ -10,13 +10,17 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // GetAttestationData requests that the beacon node produce an attestation data object,
-// which the validator acting as an attester will then sign.
+// which the validator acting as an attester will then sign. Requests for slots ahead of the
+// current slot, or more than an epoch behind it, are rejected as the resulting attestation
+// could not be included in a block.
 func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
 	ctx, span := trace.StartSpan(ctx, "AttesterServer.RequestAttestation")
 	defer span.End()
@@ -28,6 +32,9 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 	if vs.SyncChecker.Syncing() {
 		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
 	}
+	if err := vs.validateAttestationSlot(req.Slot); err != nil {
+		return nil, err
+	}
 
 	res, err := vs.AttestationCache.Get(ctx, req)
 	if err != nil {
@@ -101,6 +108,29 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 	return res, nil
 }
 
+// validateAttestationSlot checks the requested slot is within an epoch of the current slot. A
+// single slot ahead is tolerated to account for clock disparity between nodes.
+func (vs *Server) validateAttestationSlot(slot uint64) error {
+	currentSlot := slotutil.SlotsSinceGenesis(vs.GenesisTime)
+	if slot > currentSlot+1 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Cannot request attestation data for future slot %d, current slot %d",
+			slot,
+			currentSlot,
+		)
+	}
+	if slot+params.BeaconConfig().SlotsPerEpoch < currentSlot {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Cannot request attestation data for slot %d, more than an epoch before current slot %d",
+			slot,
+			currentSlot,
+		)
+	}
+	return nil
+}
+
 // ProposeAttestation is a function called by an attester to vote
 // on a block via an attestation object as defined in the Ethereum Serenity specification.
 func (vs *Server) ProposeAttestation(ctx context.Context, att *ethpb.Attestation) (*ethpb.AttestResponse, error) {
@@ -113,8 +143,20 @@ func (vs *Server) ProposeAttestation(ctx context.Context, att *ethpb.Attestation
 		return nil, status.Errorf(codes.Internal, "Could not tree hash attestation: %v", err)
 	}
 
-	// Broadcast the new attestation to the network.
-	if err := vs.P2P.Broadcast(ctx, att); err != nil {
+	// Broadcast the new attestation to the network, on the subnet its committee maps to.
+	headState, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available")
+	}
+	activeCount, err := helpers.ActiveValidatorCount(headState, helpers.SlotToEpoch(att.Data.Slot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	subnet := helpers.ComputeSubnetForAttestation(helpers.SlotCommitteeCount(activeCount), att.Data.Slot, att.Data.CommitteeIndex)
+	if err := vs.P2P.BroadcastAttestation(ctx, subnet, att); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not broadcast attestation: %v", err)
 	}
 
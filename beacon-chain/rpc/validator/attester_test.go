@@ -1,16 +1,19 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
@@ -20,6 +23,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 func init() {
@@ -27,18 +32,16 @@ func init() {
 	params.OverrideBeaconConfig(params.MinimalSpecConfig())
 }
 
+// genesisTimeAtSlot returns a genesis time for which the current slot is the given slot.
+func genesisTimeAtSlot(slot uint64) time.Time {
+	return roughtime.Now().Add(-time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+}
+
 func TestProposeAttestation_OK(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	defer dbutil.TeardownDB(t, db)
 	ctx := context.Background()
 
-	attesterServer := &Server{
-		HeadFetcher:      &mock.ChainService{},
-		P2P:              &mockp2p.MockBroadcaster{},
-		BeaconDB:         db,
-		AttestationCache: cache.NewAttestationCache(),
-		AttPool:          attestations.NewPool(),
-	}
 	head := &ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{
 			Slot:       999,
@@ -74,11 +77,22 @@ func TestProposeAttestation_OK(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	broadcaster := &mockp2p.MockBroadcaster{}
+	attesterServer := &Server{
+		HeadFetcher:      &mock.ChainService{State: state, Root: root[:]},
+		P2P:              broadcaster,
+		BeaconDB:         db,
+		AttestationCache: cache.NewAttestationCache(),
+		AttPool:          attestations.NewPool(),
+	}
+
 	sk := bls.RandKey()
 	sig := sk.Sign([]byte("dummy_test_data"), 0 /*domain*/)
 	req := &ethpb.Attestation{
 		Signature: sig.Marshal(),
 		Data: &ethpb.AttestationData{
+			Slot:            state.Slot,
+			CommitteeIndex:  1,
 			BeaconBlockRoot: root[:],
 			Source:          &ethpb.Checkpoint{},
 			Target:          &ethpb.Checkpoint{},
@@ -87,6 +101,12 @@ func TestProposeAttestation_OK(t *testing.T) {
 	if _, err := attesterServer.ProposeAttestation(context.Background(), req); err != nil {
 		t.Errorf("Could not attest head correctly: %v", err)
 	}
+
+	committeesPerSlot := helpers.SlotCommitteeCount(uint64(len(validators)))
+	wanted := helpers.ComputeSubnetForAttestation(committeesPerSlot, req.Data.Slot, req.Data.CommitteeIndex)
+	if len(broadcaster.BroadcastedSubnets) != 1 || broadcaster.BroadcastedSubnets[0] != wanted {
+		t.Errorf("Wanted attestation broadcast to subnet %d, got %v", wanted, broadcaster.BroadcastedSubnets)
+	}
 }
 
 func TestProposeAttestation_IncorrectSignature(t *testing.T) {
@@ -158,6 +178,7 @@ func TestGetAttestationData_OK(t *testing.T) {
 		AttestationCache:    cache.NewAttestationCache(),
 		HeadFetcher:         &mock.ChainService{State: beaconState, Root: blockRoot[:]},
 		FinalizationFetcher: &mock.ChainService{CurrentJustifiedCheckPoint: beaconState.CurrentJustifiedCheckpoint},
+		GenesisTime:         genesisTimeAtSlot(beaconState.Slot),
 	}
 	if err := db.SaveState(ctx, beaconState, blockRoot); err != nil {
 		t.Fatal(err)
@@ -264,6 +285,7 @@ func TestAttestationDataAtSlot_handlesFarAwayJustifiedEpoch(t *testing.T) {
 		HeadFetcher:         &mock.ChainService{State: beaconState, Root: blockRoot[:]},
 		FinalizationFetcher: &mock.ChainService{CurrentJustifiedCheckPoint: beaconState.CurrentJustifiedCheckpoint},
 		SyncChecker:         &mockSync.Sync{IsSyncing: false},
+		GenesisTime:         genesisTimeAtSlot(beaconState.Slot),
 	}
 	if err := db.SaveState(ctx, beaconState, blockRoot); err != nil {
 		t.Fatal(err)
@@ -314,6 +336,7 @@ func TestAttestationDataSlot_handlesInProgressRequest(t *testing.T) {
 	server := &Server{
 		AttestationCache: cache.NewAttestationCache(),
 		SyncChecker:      &mockSync.Sync{IsSyncing: false},
+		GenesisTime:      genesisTimeAtSlot(2),
 	}
 
 	req := &ethpb.AttestationDataRequest{
@@ -357,3 +380,58 @@ func TestAttestationDataSlot_handlesInProgressRequest(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestGetAttestationData_GenesisState(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	attesterServer := &Server{
+		SyncChecker:      &mockSync.Sync{IsSyncing: false},
+		AttestationCache: cache.NewAttestationCache(),
+		HeadFetcher:      &mock.ChainService{State: beaconState, Root: genesisRoot[:]},
+		GenesisTime:      genesisTimeAtSlot(0),
+	}
+
+	req := &ethpb.AttestationDataRequest{Slot: 0, CommitteeIndex: 0}
+	res, err := attesterServer.GetAttestationData(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// At genesis the source is the genesis justified checkpoint and the target is the genesis block.
+	wantedSource := &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)}
+	if !proto.Equal(res.Source, wantedSource) {
+		t.Errorf("Wanted source %v, received %v", wantedSource, res.Source)
+	}
+	wantedTarget := &ethpb.Checkpoint{Epoch: 0, Root: genesisRoot[:]}
+	if !proto.Equal(res.Target, wantedTarget) {
+		t.Errorf("Wanted target %v, received %v", wantedTarget, res.Target)
+	}
+	if !bytes.Equal(res.BeaconBlockRoot, genesisRoot[:]) {
+		t.Errorf("Wanted beacon block root %#x, received %#x", genesisRoot, res.BeaconBlockRoot)
+	}
+}
+
+func TestGetAttestationData_SlotOutOfRange(t *testing.T) {
+	currentSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+	attesterServer := &Server{
+		SyncChecker:      &mockSync.Sync{IsSyncing: false},
+		AttestationCache: cache.NewAttestationCache(),
+		GenesisTime:      genesisTimeAtSlot(currentSlot),
+	}
+	tests := []struct {
+		slot   uint64
+		errMsg string
+	}{
+		{slot: currentSlot + 2, errMsg: "future slot"},
+		{slot: currentSlot - params.BeaconConfig().SlotsPerEpoch - 1, errMsg: "more than an epoch before"},
+	}
+	for _, tt := range tests {
+		_, err := attesterServer.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: tt.slot})
+		if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+			t.Errorf("Slot %d: wanted error containing %q, received %v", tt.slot, tt.errMsg, err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DetectDoubleVote checks whether an attestation conflicts with the attestations previously seen
+// by this server from the same validators. An attestation conflicts when one of its attesters
+// already voted for different data with the same target epoch (double vote), or when its source
+// and target surround or are surrounded by a previous vote of that attester (surround vote).
+// Attestations which do not conflict are recorded for the following checks, votes of finalized
+// epochs are forgotten.
+func (vs *Server) DetectDoubleVote(ctx context.Context, att *ethpb.Attestation) (bool, error) {
+	if att == nil || att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return false, status.Error(codes.InvalidArgument, "Incomplete attestation data")
+	}
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	committee, err := helpers.BeaconCommitteeFromState(headState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Could not get committee: %v", err)
+	}
+	indices, err := helpers.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "Could not get attesting indices: %v", err)
+	}
+
+	vs.seenVotesLock.Lock()
+	defer vs.seenVotesLock.Unlock()
+	if vs.seenVotes == nil {
+		vs.seenVotes = make(map[uint64]map[uint64]*ethpb.AttestationData)
+	}
+	vs.pruneSeenVotes()
+
+	for _, idx := range indices {
+		for _, seen := range vs.seenVotes[idx] {
+			if isSlashableVote(seen, att.Data) {
+				return true, nil
+			}
+		}
+	}
+	for _, idx := range indices {
+		if vs.seenVotes[idx] == nil {
+			vs.seenVotes[idx] = make(map[uint64]*ethpb.AttestationData)
+		}
+		vs.seenVotes[idx][att.Data.Target.Epoch] = att.Data
+	}
+	return false, nil
+}
+
+// pruneSeenVotes drops the recorded votes targeting an epoch older than the finalized epoch.
+// The caller must hold the seen votes lock.
+func (vs *Server) pruneSeenVotes() {
+	if vs.FinalizationFetcher == nil {
+		return
+	}
+	finalized := vs.FinalizationFetcher.FinalizedCheckpt()
+	if finalized == nil {
+		return
+	}
+	for idx, votes := range vs.seenVotes {
+		for epoch := range votes {
+			if epoch < finalized.Epoch {
+				delete(votes, epoch)
+			}
+		}
+		if len(votes) == 0 {
+			delete(vs.seenVotes, idx)
+		}
+	}
+}
+
+// isSlashableVote returns true if two votes of the same validator are a double vote or a
+// surround vote.
+func isSlashableVote(a *ethpb.AttestationData, b *ethpb.AttestationData) bool {
+	isDoubleVote := a.Target.Epoch == b.Target.Epoch && !proto.Equal(a, b)
+	isSurroundVote := (a.Source.Epoch < b.Source.Epoch && b.Target.Epoch < a.Target.Epoch) ||
+		(b.Source.Epoch < a.Source.Epoch && a.Target.Epoch < b.Target.Epoch)
+	return isDoubleVote || isSurroundVote
+}
@@ -0,0 +1,102 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func doubleVoteTestAttestation(committeeSize int, attester uint64, root byte, source uint64, target uint64) *ethpb.Attestation {
+	bits := bitfield.NewBitlist(uint64(committeeSize))
+	bits.SetBitAt(attester, true)
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: []byte{root},
+			Source:          &ethpb.Checkpoint{Epoch: source},
+			Target:          &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
+
+func TestDetectDoubleVote_Conflicting(t *testing.T) {
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState},
+	}
+
+	tests := []struct {
+		name      string
+		att       *ethpb.Attestation
+		slashable bool
+	}{
+		{
+			name:      "first vote",
+			att:       doubleVoteTestAttestation(len(committee), 0, 'a', 1, 2),
+			slashable: false,
+		},
+		{
+			name:      "same vote again",
+			att:       doubleVoteTestAttestation(len(committee), 0, 'a', 1, 2),
+			slashable: false,
+		},
+		{
+			name:      "double vote",
+			att:       doubleVoteTestAttestation(len(committee), 0, 'b', 1, 2),
+			slashable: true,
+		},
+		{
+			name:      "surrounding vote",
+			att:       doubleVoteTestAttestation(len(committee), 0, 'a', 0, 3),
+			slashable: true,
+		},
+	}
+	for _, tt := range tests {
+		slashable, err := vs.DetectDoubleVote(ctx, tt.att)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if slashable != tt.slashable {
+			t.Errorf("%s: wanted slashable %v, received %v", tt.name, tt.slashable, slashable)
+		}
+	}
+}
+
+func TestDetectDoubleVote_Clean(t *testing.T) {
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState},
+	}
+
+	atts := []*ethpb.Attestation{
+		doubleVoteTestAttestation(len(committee), 0, 'a', 0, 1),
+		// A different attester may vote for different data with the same target.
+		doubleVoteTestAttestation(len(committee), 1, 'b', 0, 1),
+		// Consecutive votes neither conflict nor surround.
+		doubleVoteTestAttestation(len(committee), 0, 'c', 1, 2),
+	}
+	for i, att := range atts {
+		slashable, err := vs.DetectDoubleVote(ctx, att)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slashable {
+			t.Errorf("Expected attestation %d to not be slashable", i)
+		}
+	}
+}
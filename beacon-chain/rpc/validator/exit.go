@@ -12,17 +12,26 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// ProposeExit proposes an exit for a validator.
+// ProposeExit proposes an exit for a validator. The exit is validated against the head state,
+// rejecting it with an invalid argument error naming the failed check, before being added to the
+// pool of exits to be included in a block.
 func (vs *Server) ProposeExit(ctx context.Context, req *ethpb.SignedVoluntaryExit) (*ptypes.Empty, error) {
 	s, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
 	}
+	if s == nil {
+		return nil, status.Error(codes.Unavailable, "Head state not available yet")
+	}
 
 	// Confirm the validator is eligible to exit with the parameters provided.
 	err = exit.ValidateVoluntaryExit(s, vs.GenesisTime, req)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid voluntary exit: %v", err)
+	}
+
+	if vs.ExitPool != nil {
+		vs.ExitPool.InsertVoluntaryExit(ctx, s, req)
 	}
 
 	// Send the voluntary exit to the operation feed.
@@ -33,5 +42,5 @@ func (vs *Server) ProposeExit(ctx context.Context, req *ethpb.SignedVoluntaryExi
 		},
 	})
 
-	return nil, nil
+	return &ptypes.Empty{}, nil
 }
@@ -5,17 +5,24 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestSub(t *testing.T) {
@@ -90,3 +97,86 @@ func TestSub(t *testing.T) {
 		}
 	}
 }
+
+// exitTestServer returns a validator server with an exit pool, whose head state is a genesis
+// state of the given time, along with the keys of its validators.
+func exitTestServer(t *testing.T, genesisTime time.Time) (*Server, *voluntaryexits.Pool, []*bls.SecretKey) {
+	deposits, privKeys, err := testutil.DeterministicDepositsAndKeys(params.BeaconConfig().MinGenesisActiveValidatorCount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(genesisTime.Unix()), &ethpb.Eth1Data{BlockHash: make([]byte, 32)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mockChainService := &mockChain.ChainService{State: beaconState, Genesis: genesisTime}
+	pool := voluntaryexits.NewPool()
+	server := &Server{
+		HeadFetcher:       mockChainService,
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		GenesisTime:       genesisTime,
+		StateNotifier:     mockChainService.StateNotifier(),
+		OperationNotifier: mockChainService.OperationNotifier(),
+		ExitPool:          pool,
+	}
+	return server, pool, privKeys
+}
+
+// signedExit returns the exit of the validator at the given epoch, signed with its key.
+func signedExit(t *testing.T, beaconState *pbp2p.BeaconState, key *bls.SecretKey, validatorIndex uint64, epoch uint64) *ethpb.SignedVoluntaryExit {
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: validatorIndex}
+	root, err := ssz.HashTreeRoot(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState.Fork, epoch, params.BeaconConfig().DomainVoluntaryExit)
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: key.Sign(root[:], domain).Marshal()}
+}
+
+func TestProposeExit_ValidExitAddedToPool(t *testing.T) {
+	// Set genesis time far enough in the past for the validators to be eligible to exit.
+	epochDuration := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second
+	genesisTime := time.Now().Add(-time.Duration(params.BeaconConfig().PersistentCommitteePeriod+1) * epochDuration)
+	server, pool, privKeys := exitTestServer(t, genesisTime)
+	headState, err := server.HeadFetcher.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	epoch := params.BeaconConfig().PersistentCommitteePeriod
+	req := signedExit(t, headState, privKeys[1], 1, epoch)
+	if _, err := server.ProposeExit(context.Background(), req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The exit is not valid for inclusion before its epoch.
+	if pending := pool.PendingExits(headState); len(pending) != 0 {
+		t.Errorf("Wanted no exit to include in a genesis block, received %v", pending)
+	}
+	exitState := proto.Clone(headState).(*pbp2p.BeaconState)
+	exitState.Slot = helpers.StartSlot(epoch)
+	pending := pool.PendingExits(exitState)
+	if len(pending) != 1 || !proto.Equal(pending[0], req) {
+		t.Errorf("Wanted pending exits %v, received %v", []*ethpb.SignedVoluntaryExit{req}, pending)
+	}
+}
+
+func TestProposeExit_ValidatorNotYetEligibleRejected(t *testing.T) {
+	server, pool, privKeys := exitTestServer(t, time.Now())
+	headState, err := server.HeadFetcher.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := signedExit(t, headState, privKeys[1], 1, 0)
+	_, err = server.ProposeExit(context.Background(), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Wanted invalid argument error, received %v", err)
+	}
+
+	exitState := proto.Clone(headState).(*pbp2p.BeaconState)
+	exitState.Slot = helpers.StartSlot(params.BeaconConfig().PersistentCommitteePeriod)
+	if pending := pool.PendingExits(exitState); len(pending) != 0 {
+		t.Errorf("Wanted no exit in the pool, received %v", pending)
+	}
+}
@@ -0,0 +1,46 @@
+package validator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	dutiesRequestsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "duties_requests_total",
+			Help: "Count of duties requests, by requested epoch relative to the head epoch.",
+		},
+		[]string{"epoch"},
+	)
+	dutiesRequestKeysHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "duties_request_keys",
+			Help:    "Number of validators requested per duties request.",
+			Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+		},
+	)
+	dutiesComputationLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "duties_computation_seconds",
+			Help:    "Time taken to compute the response of a duties request.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 8),
+		},
+	)
+)
+
+// dutiesEpochLabel returns the label of a duties request for the given epoch, relative to the
+// current epoch of the head. Any other epoch than the previous, current or next one is
+// reported as "other" to keep the label cardinality bounded.
+func dutiesEpochLabel(epoch uint64, currentEpoch uint64) string {
+	switch {
+	case epoch == currentEpoch:
+		return "current"
+	case epoch == currentEpoch+1:
+		return "next"
+	case epoch+1 == currentEpoch:
+		return "previous"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// dutiesMetrics is a snapshot of the duties metrics gathered from a registry.
+type dutiesMetrics struct {
+	requests     map[string]float64
+	keysCount    uint64
+	keysSum      float64
+	latencyCount uint64
+}
+
+func gatherDutiesMetrics(t *testing.T, reg *prometheus.Registry) *dutiesMetrics {
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &dutiesMetrics{requests: make(map[string]float64)}
+	for _, f := range families {
+		for _, metric := range f.GetMetric() {
+			switch f.GetName() {
+			case "duties_requests_total":
+				m.requests[epochLabelValue(metric)] = metric.GetCounter().GetValue()
+			case "duties_request_keys":
+				m.keysCount = metric.GetHistogram().GetSampleCount()
+				m.keysSum = metric.GetHistogram().GetSampleSum()
+			case "duties_computation_seconds":
+				m.latencyCount = metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return m
+}
+
+func epochLabelValue(metric *dto.Metric) string {
+	for _, l := range metric.GetLabel() {
+		if l.GetName() == "epoch" {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+func TestGetDuties_Metrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(dutiesRequestsCounter, dutiesRequestKeysHistogram, dutiesComputationLatency)
+
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := blk.NewGenesisBlock([]byte{})
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	before := gatherDutiesMetrics(t, reg)
+	if _, err := vs.GetDuties(context.Background(), &ethpb.DutiesRequest{Indices: []uint64{0, 1}, Epoch: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vs.GetDuties(context.Background(), &ethpb.DutiesRequest{Indices: []uint64{2}, Epoch: 1}); err != nil {
+		t.Fatal(err)
+	}
+	after := gatherDutiesMetrics(t, reg)
+
+	if diff := after.requests["current"] - before.requests["current"]; diff != 1 {
+		t.Errorf("Wanted 1 current epoch request, received %v", diff)
+	}
+	if diff := after.requests["next"] - before.requests["next"]; diff != 1 {
+		t.Errorf("Wanted 1 next epoch request, received %v", diff)
+	}
+	if diff := after.keysCount - before.keysCount; diff != 2 {
+		t.Errorf("Wanted 2 keys observations, received %d", diff)
+	}
+	if diff := after.keysSum - before.keysSum; diff != 3 {
+		t.Errorf("Wanted 3 requested keys in total, received %v", diff)
+	}
+	if diff := after.latencyCount - before.latencyCount; diff != 2 {
+		t.Errorf("Wanted 2 latency observations, received %d", diff)
+	}
+}
+
+func TestDutiesEpochLabel(t *testing.T) {
+	tests := []struct {
+		epoch        uint64
+		currentEpoch uint64
+		label        string
+	}{
+		{epoch: 5, currentEpoch: 5, label: "current"},
+		{epoch: 6, currentEpoch: 5, label: "next"},
+		{epoch: 4, currentEpoch: 5, label: "previous"},
+		{epoch: 0, currentEpoch: 5, label: "other"},
+		{epoch: 100, currentEpoch: 5, label: "other"},
+		{epoch: 0, currentEpoch: 0, label: "current"},
+	}
+	for _, tt := range tests {
+		if label := dutiesEpochLabel(tt.epoch, tt.currentEpoch); label != tt.label {
+			t.Errorf("dutiesEpochLabel(%d, %d) = %s, wanted %s", tt.epoch, tt.currentEpoch, label, tt.label)
+		}
+	}
+}
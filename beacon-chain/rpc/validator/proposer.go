@@ -1,11 +1,13 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
 	"math/rand"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
@@ -15,9 +17,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -51,13 +55,31 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		return nil, status.Errorf(codes.Internal, "Could not get ETH1 deposits: %v", err)
 	}
 
-	// Pack aggregated attestations which have not been included in the beacon chain.
-	atts := vs.AttPool.AggregatedAttestations()
-	atts, err = vs.filterAttestationsForBlockInclusion(ctx, req.Slot, atts)
+	// Pack the aggregated attestations adding the most attesters which have not been included
+	// in the beacon chain.
+	bState, err := vs.headStateAtSlot(ctx, req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state at slot %d: %v", req.Slot, err)
+	}
+	atts := vs.AttPool.BestAttestations(bState, int(params.BeaconConfig().MaxAttestations))
+	atts, err = vs.filterAttestationsForBlockInclusion(ctx, bState, atts)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not filter attestations: %v", err)
 	}
 
+	// Pack the slashings and the voluntary exits received from validators which are valid at
+	// the slot.
+	proposerSlashings := []*ethpb.ProposerSlashing{}
+	attesterSlashings := []*ethpb.AttesterSlashing{}
+	if vs.SlashingsPool != nil {
+		proposerSlashings = vs.SlashingsPool.PendingProposerSlashings(ctx, bState)
+		attesterSlashings = vs.SlashingsPool.PendingAttesterSlashings(ctx, bState)
+	}
+	exits := []*ethpb.SignedVoluntaryExit{}
+	if vs.ExitPool != nil {
+		exits = vs.ExitPool.PendingExits(bState)
+	}
+
 	// Use zero hash as stub for state root to compute later.
 	stateRoot := params.BeaconConfig().ZeroHash[:]
 
@@ -68,14 +90,13 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		ParentRoot: parentRoot[:],
 		StateRoot:  stateRoot,
 		Body: &ethpb.BeaconBlockBody{
-			Eth1Data:     eth1Data,
-			Deposits:     deposits,
-			Attestations: atts,
-			RandaoReveal: req.RandaoReveal,
-			// TODO(2766): Implement rest of the retrievals for beacon block operations
-			ProposerSlashings: []*ethpb.ProposerSlashing{},
-			AttesterSlashings: []*ethpb.AttesterSlashing{},
-			VoluntaryExits:    []*ethpb.SignedVoluntaryExit{},
+			Eth1Data:          eth1Data,
+			Deposits:          deposits,
+			Attestations:      atts,
+			RandaoReveal:      req.RandaoReveal,
+			ProposerSlashings: proposerSlashings,
+			AttesterSlashings: attesterSlashings,
+			VoluntaryExits:    exits,
 			Graffiti:          graffiti[:],
 		},
 	}
@@ -100,6 +121,9 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 	}
 	log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).Debugf(
 		"Block proposal received via RPC")
+	if err := vs.verifyRandaoReveal(ctx, blk.Block); err != nil {
+		return nil, err
+	}
 	if err := vs.BlockReceiver.ReceiveBlock(ctx, blk); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not process beacon block: %v", err)
 	}
@@ -113,6 +137,142 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 	}, nil
 }
 
+// ComputeBlockStateRoot applies a block built on top of the head to a copy of the head state,
+// without processing or saving the block, and returns the resulting state root. This lets a
+// proposer check the state root of its block before signing it, so the block signature and the
+// signatures of its attestations are not verified.
+func (vs *Server) ComputeBlockStateRoot(ctx context.Context, blk *ethpb.SignedBeaconBlock) (*pb.StateRootResponse, error) {
+	if blk == nil || blk.Block == nil || blk.Block.Body == nil {
+		return nil, status.Error(codes.InvalidArgument, "Nil block")
+	}
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	if !bytes.Equal(blk.Block.ParentRoot, headRoot) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Block parent root %#x is not the head root %#x",
+			blk.Block.ParentRoot,
+			headRoot,
+		)
+	}
+	headState, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "Head state not available yet")
+	}
+	if blk.Block.Slot <= headState.Slot {
+		return nil, status.Errorf(codes.InvalidArgument, "Block slot %d is not after the head slot %d", blk.Block.Slot, headState.Slot)
+	}
+	// The state is copied before the transition is applied.
+	root, err := state.CalculateStateRoot(ctx, headState, blk)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not apply block to the head state: %v", err)
+	}
+	return &pb.StateRootResponse{StateRoot: root[:]}, nil
+}
+
+// ReconstructFullBlock pairs a blinded block, the signed header of a block, with the block body it
+// commits to and returns the full signed block. The body is taken from the request until it can be
+// fetched from a block builder. The header of the reconstructed block must match the blinded
+// block header, so the signature of the blinded block is valid for the full block.
+func (vs *Server) ReconstructFullBlock(ctx context.Context, req *pb.ReconstructFullBlockRequest) (*ethpb.SignedBeaconBlock, error) {
+	return reconstructFullBlock(req)
+}
+
+// ProposeBlindedBlock reconstructs the full block of a blinded block from the block body in the
+// request and proposes it.
+func (vs *Server) ProposeBlindedBlock(ctx context.Context, req *pb.ReconstructFullBlockRequest) (*ethpb.ProposeResponse, error) {
+	blk, err := reconstructFullBlock(req)
+	if err != nil {
+		return nil, err
+	}
+	return vs.ProposeBlock(ctx, blk)
+}
+
+func reconstructFullBlock(req *pb.ReconstructFullBlockRequest) (*ethpb.SignedBeaconBlock, error) {
+	if req == nil || req.BlindedBlock == nil || req.BlindedBlock.Header == nil {
+		return nil, status.Error(codes.InvalidArgument, "Nil blinded block")
+	}
+	if req.Body == nil {
+		return nil, status.Error(codes.InvalidArgument, "Nil block body")
+	}
+	blindedHeader := req.BlindedBlock.Header
+	blk := &ethpb.BeaconBlock{
+		Slot:       blindedHeader.Slot,
+		ParentRoot: blindedHeader.ParentRoot,
+		StateRoot:  blindedHeader.StateRoot,
+		Body:       req.Body,
+	}
+	bodyRoot, err := ssz.HashTreeRoot(blk.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not tree hash block body: %v", err)
+	}
+	header := &ethpb.BeaconBlockHeader{
+		Slot:       blk.Slot,
+		ParentRoot: blk.ParentRoot,
+		StateRoot:  blk.StateRoot,
+		BodyRoot:   bodyRoot[:],
+	}
+	if !proto.Equal(header, blindedHeader) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Reconstructed block header does not match the blinded block header, body root %#x is not %#x",
+			bodyRoot,
+			blindedHeader.BodyRoot,
+		)
+	}
+	return &ethpb.SignedBeaconBlock{
+		Block:     blk,
+		Signature: req.BlindedBlock.Signature,
+	}, nil
+}
+
+// verifyRandaoReveal checks the randao reveal of a proposed block is signed by the proposer of the
+// block slot as seen from the head state, so that blocks from misconfigured signers are rejected
+// before being processed.
+func (vs *Server) verifyRandaoReveal(ctx context.Context, blk *ethpb.BeaconBlock) error {
+	if blk == nil || blk.Body == nil {
+		return status.Error(codes.InvalidArgument, "Block and block body cannot be nil")
+	}
+	headState, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return status.Error(codes.Unavailable, "head state not available yet")
+	}
+
+	// The proposer of a slot in a later epoch than the head depends on the registry at the start
+	// of that epoch.
+	epoch := helpers.SlotToEpoch(blk.Slot)
+	s := headState
+	if epoch > helpers.CurrentEpoch(headState) {
+		headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not get head root: %v", err)
+		}
+		s, err = vs.epochBoundaryState(ctx, headState, bytesutil.ToBytes32(headRoot), epoch)
+		if err != nil {
+			return err
+		}
+	}
+	s = shallowCopyState(s)
+	s.Slot = blk.Slot
+	proposerIdx, err := helpers.BeaconProposerIndex(s)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get proposer index at slot %d: %v", blk.Slot, err)
+	}
+	proposerPub := s.Validators[proposerIdx].PublicKey
+	if err := blocks.VerifyRandaoReveal(s.Fork, epoch, proposerPub, blk.Body.RandaoReveal); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid randao reveal for proposer %d: %v", proposerIdx, err)
+	}
+	return nil
+}
+
 // eth1Data determines the appropriate eth1data for a block proposal. The algorithm for this method
 // is as follows:
 //  - Determine the timestamp for the start slot for the eth1 voting period.
@@ -128,8 +288,9 @@ func (vs *Server) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth1Data, e
 		return vs.randomETH1DataVote(ctx)
 	}
 
-	eth1VotingPeriodStartTime, _ := vs.Eth1InfoFetcher.Eth2GenesisPowchainInfo()
-	eth1VotingPeriodStartTime += (slot - (slot % params.BeaconConfig().SlotsPerEth1VotingPeriod)) * params.BeaconConfig().SecondsPerSlot
+	genesisTime, _ := vs.Eth1InfoFetcher.Eth2GenesisPowchainInfo()
+	votingPeriodStartSlot := slot - (slot % params.BeaconConfig().SlotsPerEth1VotingPeriod)
+	eth1VotingPeriodStartTime := uint64(slotutil.SlotStartTime(genesisTime, votingPeriodStartSlot).Unix())
 
 	// Look up most recent block up to timestamp
 	blockNumber, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, eth1VotingPeriodStartTime)
@@ -326,14 +487,8 @@ func (vs *Server) defaultEth1DataResponse(ctx context.Context, currentHeight *bi
 	}, nil
 }
 
-// This filters the input attestations to return a list of valid attestations to be packaged inside a beacon block.
-func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, slot uint64, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
-	ctx, span := trace.StartSpan(ctx, "ProposerServer.filterAttestationsForBlockInclusion")
-	defer span.End()
-
-	validAtts := make([]*ethpb.Attestation, 0, len(atts))
-	inValidAtts := make([]*ethpb.Attestation, 0, len(atts))
-
+// headStateAtSlot returns the head state processed up to the given slot.
+func (vs *Server) headStateAtSlot(ctx context.Context, slot uint64) (*pbp2p.BeaconState, error) {
 	bState, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
 		return nil, errors.New("could not head state from DB")
@@ -345,8 +500,18 @@ func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, slot
 			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
 		}
 	}
+	return bState, nil
+}
+
+// This filters the input attestations to return a list of valid attestations to be packaged inside a beacon block.
+// The given state must be at the slot of the block, it is mutated as the attestations are processed.
+func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, bState *pbp2p.BeaconState, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.filterAttestationsForBlockInclusion")
+	defer span.End()
+
+	validAtts := make([]*ethpb.Attestation, 0, len(atts))
+	inValidAtts := make([]*ethpb.Attestation, 0, len(atts))
 
-	// TODO(3916): Insert optimizations to sort out the most profitable attestations
 	for i, att := range atts {
 		if i == int(params.BeaconConfig().MaxAttestations) {
 			break
@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetProposerDuties returns the proposer index and public key of every slot of the requested
+// epoch, without the committee data of GetDuties. Proposers are only final once the epoch has
+// started, so only the current epoch can be requested. The current epoch is the one of the wall
+// clock, or of the head state while the genesis time is unknown. A head state lagging behind the
+// wall clock is advanced with empty slots to the start of the epoch.
+func (vs *Server) GetProposerDuties(ctx context.Context, req *pb.ProposerDutiesRequest) (*pb.ProposerDutiesResponse, error) {
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "beacon node is syncing to latest head")
+	}
+	s, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if s == nil {
+		return nil, status.Error(codes.Unavailable, "head state not available yet")
+	}
+
+	currentEpoch := helpers.CurrentEpoch(s)
+	if !vs.GenesisTime.IsZero() {
+		currentEpoch = helpers.SlotToEpoch(slotutil.SlotsSinceGenesis(vs.GenesisTime))
+	}
+	if req.Epoch != currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"proposer duties can only be requested for the current epoch %d, received epoch %d",
+			currentEpoch,
+			req.Epoch,
+		)
+	}
+	if helpers.CurrentEpoch(s) < req.Epoch {
+		headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+		}
+		s, err = vs.epochBoundaryState(ctx, s, bytesutil.ToBytes32(headRoot), req.Epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The proposer computation moves the slot of the state it is given through the epoch.
+	proposers, err := helpers.ProposerAssignments(shallowCopyState(s), req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute proposer assignments: %v", err)
+	}
+	startSlot := helpers.StartSlot(req.Epoch)
+	duties := make([]*pb.ProposerDutiesResponse_Duty, 0, params.BeaconConfig().SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		idx := proposers[slot]
+		if idx >= uint64(len(s.Validators)) {
+			return nil, status.Errorf(codes.Internal, "Proposer index %d of slot %d out of range", idx, slot)
+		}
+		duties = append(duties, &pb.ProposerDutiesResponse_Duty{
+			Slot:          slot,
+			ProposerIndex: idx,
+			PublicKey:     s.Validators[idx].PublicKey,
+		})
+	}
+	return &pb.ProposerDutiesResponse{Duties: duties}, nil
+}
@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetProposerDuties_GenesisProposers(t *testing.T) {
+	helpers.ClearCache()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	wantedProposer, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: make([]byte, 32)},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	res, err := vs.GetProposerDuties(context.Background(), &pb.ProposerDutiesRequest{Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uint64(len(res.Duties)) != params.BeaconConfig().SlotsPerEpoch {
+		t.Fatalf("Wanted %d proposer duties, received %d", params.BeaconConfig().SlotsPerEpoch, len(res.Duties))
+	}
+	for i, duty := range res.Duties {
+		if duty.Slot != uint64(i) {
+			t.Errorf("Wanted duty %d at slot %d, received slot %d", i, i, duty.Slot)
+		}
+		if !bytes.Equal(duty.PublicKey, beaconState.Validators[duty.ProposerIndex].PublicKey) {
+			t.Errorf("Wrong public key for proposer %d at slot %d", duty.ProposerIndex, duty.Slot)
+		}
+	}
+	if res.Duties[0].ProposerIndex != wantedProposer {
+		t.Errorf("Wanted proposer %d at slot 0, received %d", wantedProposer, res.Duties[0].ProposerIndex)
+	}
+	if beaconState.Slot != 0 {
+		t.Errorf("Expected head state to stay at slot 0, received slot %d", beaconState.Slot)
+	}
+}
+
+func TestGetProposerDuties_NextEpoch(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState, Root: make([]byte, 32)},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	_, err := vs.GetProposerDuties(context.Background(), &pb.ProposerDutiesRequest{Epoch: 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted code %v, received %v", codes.InvalidArgument, err)
+	}
+}
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 	"strings"
@@ -14,17 +15,22 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/stateutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -43,7 +49,7 @@ func TestProposeBlock_OK(t *testing.T) {
 	}
 
 	numDeposits := params.BeaconConfig().MinGenesisActiveValidatorCount
-	beaconState, _ := testutil.DeterministicGenesisState(t, numDeposits)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, numDeposits)
 
 	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
 	if err != nil {
@@ -59,13 +65,14 @@ func TestProposeBlock_OK(t *testing.T) {
 		Eth1InfoFetcher:   &mockPOW.POWChain{},
 		Eth1BlockFetcher:  &mockPOW.POWChain{},
 		BlockReceiver:     &mock.ChainService{},
-		HeadFetcher:       &mock.ChainService{},
+		HeadFetcher:       &mock.ChainService{State: beaconState, Root: genesisRoot[:]},
 	}
+	randaoReveal := proposerRandaoReveal(t, beaconState, 5, privKeys)
 	req := &ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{
 			Slot:       5,
 			ParentRoot: []byte("parent-hash"),
-			Body:       &ethpb.BeaconBlockBody{},
+			Body:       &ethpb.BeaconBlockBody{RandaoReveal: randaoReveal},
 		},
 	}
 	if err := db.SaveBlock(ctx, req); err != nil {
@@ -76,6 +83,85 @@ func TestProposeBlock_OK(t *testing.T) {
 	}
 }
 
+func TestProposeBlock_InvalidRandaoReveal(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+
+	numDeposits := params.BeaconConfig().MinGenesisActiveValidatorCount
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, numDeposits)
+	genesisRoot, err := ssz.HashTreeRoot(b.NewGenesisBlock([]byte{}).Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proposerServer := &Server{
+		BeaconDB:      db,
+		BlockReceiver: &mock.ChainService{},
+		HeadFetcher:   &mock.ChainService{State: beaconState, Root: genesisRoot[:]},
+	}
+	// The reveal of the epoch signed by another validator than the proposer, and the reveal of the
+	// proposer signed for another epoch.
+	proposerState := proto.Clone(beaconState).(*pbp2p.BeaconState)
+	proposerState.Slot = 5
+	proposerIdx, err := helpers.BeaconProposerIndex(proposerState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 32)
+	domain := helpers.Domain(beaconState.Fork, 0, params.BeaconConfig().DomainRandao)
+	otherProposerReveal := privKeys[(proposerIdx+1)%uint64(len(privKeys))].Sign(buf, domain).Marshal()
+	otherEpochReveal, err := testutil.RandaoReveal(proposerState, 1, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, reveal := range [][]byte{otherProposerReveal, otherEpochReveal} {
+		req := &ethpb.SignedBeaconBlock{
+			Block: &ethpb.BeaconBlock{
+				Slot:       5,
+				ParentRoot: []byte("parent-hash"),
+				Body:       &ethpb.BeaconBlockBody{RandaoReveal: reveal},
+			},
+		}
+		_, err := proposerServer.ProposeBlock(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "Invalid randao reveal") {
+			t.Errorf("Expected invalid randao reveal error, received %v", err)
+		}
+	}
+}
+
+func TestProposeBlock_NoHeadState(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+
+	proposerServer := &Server{
+		BeaconDB:      db,
+		BlockReceiver: &mock.ChainService{},
+		HeadFetcher:   &mock.ChainService{},
+	}
+	req := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot:       5,
+			ParentRoot: []byte("parent-hash"),
+			Body:       &ethpb.BeaconBlockBody{},
+		},
+	}
+	if _, err := proposerServer.ProposeBlock(context.Background(), req); status.Code(err) != codes.Unavailable {
+		t.Errorf("Wanted code %v, received %v", codes.Unavailable, err)
+	}
+}
+
+// proposerRandaoReveal returns the randao reveal of the proposer of the given slot, signed for the
+// epoch of the slot.
+func proposerRandaoReveal(t *testing.T, beaconState *pbp2p.BeaconState, slot uint64, privKeys []*bls.SecretKey) []byte {
+	s := proto.Clone(beaconState).(*pbp2p.BeaconState)
+	s.Slot = slot
+	reveal, err := testutil.RandaoReveal(s, helpers.SlotToEpoch(slot), privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reveal
+}
+
 func TestComputeStateRoot_OK(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	defer dbutil.TeardownDB(t, db)
@@ -149,6 +235,154 @@ func TestComputeStateRoot_OK(t *testing.T) {
 	}
 }
 
+func TestComputeBlockStateRoot_MatchesStateTransition(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, testutil.DefaultBlockGenConfig(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerServer := &Server{
+		HeadFetcher: &mock.ChainService{State: beaconState, Root: blk.Block.ParentRoot},
+	}
+
+	res, err := proposerServer.ComputeBlockStateRoot(ctx, blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	postState, err := state.ExecuteStateTransition(ctx, proto.Clone(beaconState).(*pbp2p.BeaconState), blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted, err := stateutil.HashTreeRootState(postState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.StateRoot, wanted[:]) {
+		t.Errorf("Wanted state root %#x, received %#x", wanted, res.StateRoot)
+	}
+	if beaconState.Slot != 0 {
+		t.Errorf("Expected the head state to be left at slot 0, received slot %d", beaconState.Slot)
+	}
+
+	blk.Block.ParentRoot = []byte("not-the-head")
+	if _, err := proposerServer.ComputeBlockStateRoot(ctx, blk); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %v error for a block not built on the head, received %v", codes.InvalidArgument, err)
+	}
+}
+
+// blindBlock returns the blinded block of a block, its header signed with the block signature.
+func blindBlock(t *testing.T, blk *ethpb.SignedBeaconBlock) *ethpb.SignedBeaconBlockHeader {
+	bodyRoot, err := ssz.HashTreeRoot(blk.Block.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:       blk.Block.Slot,
+			ParentRoot: blk.Block.ParentRoot,
+			StateRoot:  blk.Block.StateRoot,
+			BodyRoot:   bodyRoot[:],
+		},
+		Signature: blk.Signature,
+	}
+}
+
+func TestReconstructFullBlock_OK(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, testutil.DefaultBlockGenConfig(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.ReconstructFullBlockRequest{
+		BlindedBlock: blindBlock(t, blk),
+		Body:         blk.Block.Body,
+	}
+
+	proposerServer := &Server{}
+	res, err := proposerServer.ReconstructFullBlock(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(res, blk) {
+		t.Errorf("Wanted block %v, received %v", blk, res)
+	}
+	// The blinded block signature is valid for the full block as both have the same root.
+	headerRoot, err := ssz.HashTreeRoot(req.BlindedBlock.Header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockRoot, err := ssz.HashTreeRoot(res.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headerRoot != blockRoot {
+		t.Errorf("Wanted block root %#x, received %#x", headerRoot, blockRoot)
+	}
+}
+
+func TestReconstructFullBlock_InvalidArgument(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, testutil.DefaultBlockGenConfig(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherBody := proto.Clone(blk.Block.Body).(*ethpb.BeaconBlockBody)
+	otherBody.Graffiti = []byte("other-graffiti")
+
+	proposerServer := &Server{}
+	tests := []struct {
+		name string
+		req  *pb.ReconstructFullBlockRequest
+	}{
+		{
+			name: "nil blinded block",
+			req:  &pb.ReconstructFullBlockRequest{Body: blk.Block.Body},
+		},
+		{
+			name: "nil body",
+			req:  &pb.ReconstructFullBlockRequest{BlindedBlock: blindBlock(t, blk)},
+		},
+		{
+			name: "body not committed to",
+			req:  &pb.ReconstructFullBlockRequest{BlindedBlock: blindBlock(t, blk), Body: otherBody},
+		},
+	}
+	for _, tt := range tests {
+		if _, err := proposerServer.ReconstructFullBlock(context.Background(), tt.req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: wanted code %v, received %v", tt.name, codes.InvalidArgument, err)
+		}
+	}
+}
+
+func TestProposeBlindedBlock_OK(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerServer := &Server{
+		BlockReceiver: &mock.ChainService{},
+		HeadFetcher:   &mock.ChainService{State: beaconState, Root: blk.Block.ParentRoot},
+		AttPool:       attestations.NewPool(),
+	}
+	req := &pb.ReconstructFullBlockRequest{
+		BlindedBlock: blindBlock(t, blk),
+		Body:         blk.Block.Body,
+	}
+	res, err := proposerServer.ProposeBlindedBlock(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.BlockRoot, wanted[:]) {
+		t.Errorf("Wanted block root %#x, received %#x", wanted, res.BlockRoot)
+	}
+}
+
 func TestPendingDeposits_Eth1DataVoteOK(t *testing.T) {
 	ctx := context.Background()
 
@@ -917,6 +1151,71 @@ func TestDefaultEth1Data_NoBlockExists(t *testing.T) {
 	}
 }
 
+func TestDefaultEth1Data_ReducedFollowDistance(t *testing.T) {
+	ctx := context.Background()
+
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatalf("could not setup deposit trie: %v", err)
+	}
+	depositCache := depositcache.NewDepositCache()
+	for i, height := range []uint64{100, 1200} {
+		deposit := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             []byte{byte(i)},
+				Signature:             make([]byte, 96),
+				WithdrawalCredentials: make([]byte, 32),
+			}}
+		depositCache.InsertDeposit(ctx, deposit, height, int64(i), depositTrie.Root())
+	}
+
+	p := &mockPOW.POWChain{
+		HashesByHeight: map[int][]byte{
+			476:  []byte("hash476"),
+			1400: []byte("hash1400"),
+		},
+	}
+	proposerServer := &Server{
+		ChainStartFetcher:      p,
+		Eth1InfoFetcher:        p,
+		Eth1BlockFetcher:       p,
+		DepositFetcher:         depositCache,
+		PendingDepositsFetcher: depositCache,
+	}
+
+	tests := []struct {
+		followDistance uint64
+		blockHash      []byte
+		depositCount   uint64
+	}{
+		{followDistance: 1024, blockHash: []byte("hash476"), depositCount: 1},
+		{followDistance: 100, blockHash: []byte("hash1400"), depositCount: 2},
+	}
+	defaultFollowDistance := params.BeaconConfig().Eth1FollowDistance
+	defer func() {
+		c := params.BeaconConfig()
+		c.Eth1FollowDistance = defaultFollowDistance
+		params.OverrideBeaconConfig(c)
+	}()
+	for _, tt := range tests {
+		c := params.BeaconConfig()
+		c.Eth1FollowDistance = tt.followDistance
+		params.OverrideBeaconConfig(c)
+
+		result, err := proposerServer.defaultEth1DataResponse(ctx, big.NewInt(1500))
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantHash := bytesutil.ToBytes32(tt.blockHash)
+		if !bytes.Equal(result.BlockHash, wantHash[:]) {
+			t.Errorf("Follow distance %d: wanted block hash %#x, received %#x", tt.followDistance, wantHash, result.BlockHash)
+		}
+		if result.DepositCount != tt.depositCount {
+			t.Errorf("Follow distance %d: wanted deposit count %d, received %d", tt.followDistance, tt.depositCount, result.DepositCount)
+		}
+	}
+}
+
 // TODO(2312): Add more tests for edge cases and better coverage.
 func TestEth1Data(t *testing.T) {
 
@@ -1038,7 +1337,11 @@ func TestFilterAttestation_OK(t *testing.T) {
 			Target:         &ethpb.Checkpoint{}},
 		}
 	}
-	received, err := proposerServer.filterAttestationsForBlockInclusion(context.Background(), 1, atts)
+	bState, err := proposerServer.headStateAtSlot(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received, err := proposerServer.filterAttestationsForBlockInclusion(ctx, bState, atts)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1077,7 +1380,11 @@ func TestFilterAttestation_OK(t *testing.T) {
 		atts[i].Signature = bls.AggregateSignatures(sigs).Marshal()[:]
 	}
 
-	received, err = proposerServer.filterAttestationsForBlockInclusion(context.Background(), 1, atts)
+	bState, err = proposerServer.headStateAtSlot(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received, err = proposerServer.filterAttestationsForBlockInclusion(ctx, bState, atts)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2,8 +2,10 @@ package validator
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
@@ -15,6 +17,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
@@ -36,32 +40,43 @@ func init() {
 // and committees in which particular validators need to perform their responsibilities,
 // and more.
 type Server struct {
-	Ctx                    context.Context
-	BeaconDB               db.ReadOnlyDatabase
-	AttestationCache       *cache.AttestationCache
-	HeadFetcher            blockchain.HeadFetcher
-	ForkFetcher            blockchain.ForkFetcher
-	FinalizationFetcher    blockchain.FinalizationFetcher
-	CanonicalStateChan     chan *pbp2p.BeaconState
-	BlockFetcher           powchain.POWBlockFetcher
-	DepositFetcher         depositcache.DepositFetcher
-	ChainStartFetcher      powchain.ChainStartFetcher
-	Eth1InfoFetcher        powchain.ChainInfoFetcher
-	SyncChecker            sync.Checker
-	StateNotifier          statefeed.Notifier
-	P2P                    p2p.Broadcaster
-	AttPool                attestations.Pool
-	BlockReceiver          blockchain.BlockReceiver
-	MockEth1Votes          bool
-	Eth1BlockFetcher       powchain.POWBlockFetcher
-	PendingDepositsFetcher depositcache.PendingDepositsFetcher
-	OperationNotifier      opfeed.Notifier
-	GenesisTime            time.Time
+	Ctx                           context.Context
+	BeaconDB                      db.ReadOnlyDatabase
+	AttestationCache              *cache.AttestationCache
+	HeadFetcher                   blockchain.HeadFetcher
+	ForkFetcher                   blockchain.ForkFetcher
+	FinalizationFetcher           blockchain.FinalizationFetcher
+	CanonicalStateChan            chan *pbp2p.BeaconState
+	BlockFetcher                  powchain.POWBlockFetcher
+	DepositFetcher                depositcache.DepositFetcher
+	ChainStartFetcher             powchain.ChainStartFetcher
+	Eth1InfoFetcher               powchain.ChainInfoFetcher
+	SyncChecker                   sync.Checker
+	StateNotifier                 statefeed.Notifier
+	P2P                           p2p.Broadcaster
+	AttPool                       attestations.Pool
+	ExitPool                      voluntaryexits.PoolManager
+	SlashingsPool                 slashings.PoolManager
+	BlockReceiver                 blockchain.BlockReceiver
+	MockEth1Votes                 bool
+	Eth1BlockFetcher              powchain.POWBlockFetcher
+	PendingDepositsFetcher        depositcache.PendingDepositsFetcher
+	OperationNotifier             opfeed.Notifier
+	GenesisTime                   time.Time
+	AssignmentsCacheSize          int
+	MaxValidatorsPerDutiesRequest int
+	BoundaryStateCache            *cache.CheckpointStateCache
+	SubnetIDs                     *cache.SubnetIDs
+	assignmentsCache              *assignmentsCache
+	assignmentsCacheLock          sync.Mutex
+	seenVotes                     map[uint64]map[uint64]*ethpb.AttestationData
+	seenVotesLock                 sync.Mutex
 }
 
-// WaitForActivation checks if a validator public key exists in the active validator registry of the current
-// beacon state, if not, then it creates a stream which listens for canonical states which contain
-// the validator with the public key as an active validator record.
+// WaitForActivation checks if the validator public keys exist in the active validator registry of the
+// current head state. The statuses of the validators are sent right away and, as long as none of them
+// is active yet, re-sent whenever a processed block changes them. The stream ends with the message
+// in which any requested validator is active, which is the first one if one of them already was.
 func (vs *Server) WaitForActivation(req *ethpb.ValidatorActivationRequest, stream ethpb.BeaconNodeValidator_WaitForActivationServer) error {
 	activeValidatorExists, validatorStatuses, err := vs.multipleValidatorStatus(stream.Context(), req.PublicKeys)
 	if err != nil {
@@ -77,14 +92,24 @@ func (vs *Server) WaitForActivation(req *ethpb.ValidatorActivationRequest, strea
 		return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
 	}
 
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := vs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
 	for {
 		select {
-		case <-time.After(6 * time.Second):
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
 			activeValidatorExists, validatorStatuses, err := vs.multipleValidatorStatus(stream.Context(), req.PublicKeys)
 			if err != nil {
 				return status.Errorf(codes.Internal, "Could not fetch validator status: %v", err)
 			}
-			res := &ethpb.ValidatorActivationResponse{
+			// Pending validators only get an update once their statuses change.
+			if !activeValidatorExists && activationStatusesEqual(res.Statuses, validatorStatuses) {
+				continue
+			}
+			res = &ethpb.ValidatorActivationResponse{
 				Statuses: validatorStatuses,
 			}
 			if activeValidatorExists {
@@ -93,6 +118,8 @@ func (vs *Server) WaitForActivation(req *ethpb.ValidatorActivationRequest, strea
 			if err := stream.Send(res); err != nil {
 				return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
 			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
 		case <-stream.Context().Done():
 			return status.Error(codes.Canceled, "Stream context canceled")
 		case <-vs.Ctx.Done():
@@ -101,6 +128,19 @@ func (vs *Server) WaitForActivation(req *ethpb.ValidatorActivationRequest, strea
 	}
 }
 
+// activationStatusesEqual returns true if both lists hold the same statuses in the same order.
+func activationStatusesEqual(a []*ethpb.ValidatorActivationResponse_Status, b []*ethpb.ValidatorActivationResponse_Status) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // ValidatorIndex is called by a validator to get its index location in the beacon state.
 func (vs *Server) ValidatorIndex(ctx context.Context, req *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
 	index, ok, err := vs.BeaconDB.ValidatorIndex(ctx, req.PublicKey)
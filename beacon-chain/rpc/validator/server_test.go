@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -78,6 +79,7 @@ func TestWaitForActivation_ContextClosed(t *testing.T) {
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
+	chainService := &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]}
 	vs := &Server{
 		BeaconDB:           db,
 		Ctx:                ctx,
@@ -86,7 +88,8 @@ func TestWaitForActivation_ContextClosed(t *testing.T) {
 		Eth1InfoFetcher:    &mockPOW.POWChain{},
 		CanonicalStateChan: make(chan *pbp2p.BeaconState, 1),
 		DepositFetcher:     depositcache.NewDepositCache(),
-		HeadFetcher:        &mockChain.ChainService{State: beaconState, Root: genesisRoot[:]},
+		HeadFetcher:        chainService,
+		StateNotifier:      chainService.StateNotifier(),
 	}
 	req := &ethpb.ValidatorActivationRequest{
 		PublicKeys: [][]byte{pubKey(1)},
@@ -214,6 +217,100 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 	}
 }
 
+func TestWaitForActivation_ValidatorActivatedAfterBlock(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	pubKey := pubKey(1)
+	if err := db.SaveValidatorIndex(ctx, pubKey, 0); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
+	}
+	pendingState := &pbp2p.BeaconState{
+		Slot: 0,
+		Validators: []*ethpb.Validator{
+			{
+				ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+				ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+				PublicKey:       pubKey,
+			},
+		},
+	}
+	activeState := proto.Clone(pendingState).(*pbp2p.BeaconState)
+	activeState.Slot = params.BeaconConfig().SlotsPerEpoch
+	activeState.Validators[0].ActivationEpoch = 1
+
+	chainService := &mockChain.ChainService{State: pendingState}
+	vs := &Server{
+		BeaconDB:           db,
+		Ctx:                context.Background(),
+		ChainStartFetcher:  &mockPOW.POWChain{},
+		BlockFetcher:       &mockPOW.POWChain{},
+		Eth1InfoFetcher:    &mockPOW.POWChain{},
+		CanonicalStateChan: make(chan *pbp2p.BeaconState, 1),
+		DepositFetcher:     depositcache.NewDepositCache(),
+		HeadFetcher:        chainService,
+		StateNotifier:      chainService.StateNotifier(),
+	}
+	req := &ethpb.ValidatorActivationRequest{
+		PublicKeys: [][]byte{pubKey},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockChainStream := mockRPC.NewMockBeaconNodeValidator_WaitForActivationServer(ctrl)
+	mockChainStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+	pendingSent := make(chan bool)
+	gomock.InOrder(
+		mockChainStream.EXPECT().Send(
+			&ethpb.ValidatorActivationResponse{
+				Statuses: []*ethpb.ValidatorActivationResponse_Status{
+					{PublicKey: pubKey,
+						Status: &ethpb.ValidatorStatusResponse{
+							Status:          ethpb.ValidatorStatus_PENDING_ACTIVE,
+							ActivationEpoch: int64(params.BeaconConfig().FarFutureEpoch),
+						},
+					},
+				},
+			},
+		).DoAndReturn(func(*ethpb.ValidatorActivationResponse) error {
+			close(pendingSent)
+			return nil
+		}),
+		mockChainStream.EXPECT().Send(
+			&ethpb.ValidatorActivationResponse{
+				Statuses: []*ethpb.ValidatorActivationResponse_Status{
+					{PublicKey: pubKey,
+						Status: &ethpb.ValidatorStatusResponse{
+							Status:          ethpb.ValidatorStatus_ACTIVE,
+							ActivationEpoch: 1,
+						},
+					},
+				},
+			},
+		).Return(nil),
+	)
+
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		if err := vs.WaitForActivation(req, mockChainStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		exitRoutine <- true
+	}(t)
+
+	<-pendingSent
+	chainService.State = activeState
+	// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
+	for sent := 0; sent == 0; {
+		sent = vs.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.BlockProcessed,
+			Data: &statefeed.BlockProcessedData{},
+		})
+	}
+	<-exitRoutine
+}
+
 func TestWaitForChainStart_ContextClosed(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	defer dbutil.TeardownDB(t, db)
@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubscribeCommitteeSubnets subscribes the node to the attestation subnets of the given committee
+// assignments until the end of their slot. Only aggregators need the node to receive the
+// attestations of their subnet; the other attesters merely publish to it, so their subscriptions
+// are validated without subscribing. Assignments of past slots, of epochs beyond the next one or
+// of committees out of range are rejected with an InvalidArgument error and no subscription of
+// the request is kept.
+func (vs *Server) SubscribeCommitteeSubnets(ctx context.Context, req *pb.CommitteeSubnetsSubscribeRequest) (*ptypes.Empty, error) {
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	if vs.SubnetIDs == nil {
+		return nil, status.Error(codes.Unavailable, "Subnet subscriptions are not available")
+	}
+	headState, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "Head state not available yet")
+	}
+
+	currentSlot := slotutil.SlotsSinceGenesis(vs.GenesisTime)
+	maxEpoch := helpers.CurrentEpoch(headState) + 1
+	subnets := make([]uint64, 0, len(req.Subscriptions))
+	for _, s := range req.Subscriptions {
+		if s.Slot < currentSlot {
+			return nil, status.Errorf(codes.InvalidArgument, "Slot %d has already passed, current slot %d", s.Slot, currentSlot)
+		}
+		epoch := helpers.SlotToEpoch(s.Slot)
+		if epoch > maxEpoch {
+			return nil, status.Errorf(codes.InvalidArgument, "Cannot subscribe for epoch %d beyond the next epoch %d", epoch, maxEpoch)
+		}
+		activeCount, err := helpers.ActiveValidatorCount(headState, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+		}
+		committeesPerSlot := helpers.SlotCommitteeCount(activeCount)
+		if s.CommitteeIndex >= committeesPerSlot {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Committee index %d out of range, slot %d has %d committees",
+				s.CommitteeIndex,
+				s.Slot,
+				committeesPerSlot,
+			)
+		}
+		subnets = append(subnets, helpers.ComputeSubnetForAttestation(committeesPerSlot, s.Slot, s.CommitteeIndex))
+	}
+
+	genesis := uint64(vs.GenesisTime.Unix())
+	for i, s := range req.Subscriptions {
+		if !s.IsAggregator {
+			continue
+		}
+		vs.SubnetIDs.AddSubnetID(subnets[i], slotutil.SlotStartTime(genesis, s.Slot+1))
+	}
+	return &ptypes.Empty{}, nil
+}
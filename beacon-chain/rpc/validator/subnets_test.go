@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubscribeCommitteeSubnets_AggregatorSubscriptionsExpire(t *testing.T) {
+	// 256 validators make 4 committees per slot with the minimal config.
+	beaconState, _ := testutil.DeterministicGenesisState(t, 256)
+	genesis := time.Unix(time.Now().Unix(), 0)
+	subnetIDs := cache.NewSubnetIDs()
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		GenesisTime: genesis,
+		SubnetIDs:   subnetIDs,
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	req := &pb.CommitteeSubnetsSubscribeRequest{
+		Subscriptions: []*pb.CommitteeSubnetSubscription{
+			{Slot: 5, CommitteeIndex: 2, IsAggregator: true},
+			{Slot: 6, CommitteeIndex: 1, IsAggregator: false},
+			{Slot: 2*slotsPerEpoch - 1, CommitteeIndex: 3, IsAggregator: true},
+		},
+	}
+	if _, err := vs.SubscribeCommitteeSubnets(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	lastSubnet := helpers.ComputeSubnetForAttestation(4, 2*slotsPerEpoch-1, 3)
+	want := []uint64{helpers.ComputeSubnetForAttestation(4, 5, 2), lastSubnet}
+	if got := subnetIDs.ActiveSubnetIDs(genesis); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Wanted active subnets %v, received %v", want, got)
+	}
+	if got := subnetIDs.ActiveSubnetIDs(slotutil.SlotStartTime(uint64(genesis.Unix()), 6)); !reflect.DeepEqual(got, []uint64{lastSubnet}) {
+		t.Errorf("Wanted active subnets %v after slot 5, received %v", []uint64{lastSubnet}, got)
+	}
+	if got := subnetIDs.ActiveSubnetIDs(slotutil.SlotStartTime(uint64(genesis.Unix()), 2*slotsPerEpoch)); len(got) != 0 {
+		t.Errorf("Wanted no active subnet after the subscribed slots, received %v", got)
+	}
+}
+
+func TestSubscribeCommitteeSubnets_InvalidCommitteeIndex(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 256)
+	genesis := time.Unix(time.Now().Unix(), 0)
+	subnetIDs := cache.NewSubnetIDs()
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		GenesisTime: genesis,
+		SubnetIDs:   subnetIDs,
+	}
+	req := &pb.CommitteeSubnetsSubscribeRequest{
+		Subscriptions: []*pb.CommitteeSubnetSubscription{
+			{Slot: 5, CommitteeIndex: 2, IsAggregator: true},
+			{Slot: 5, CommitteeIndex: 4, IsAggregator: true},
+		},
+	}
+	if _, err := vs.SubscribeCommitteeSubnets(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Wanted invalid argument error, received %v", err)
+	}
+	if got := subnetIDs.ActiveSubnetIDs(genesis); len(got) != 0 {
+		t.Errorf("Wanted no subscription of a rejected request, received %v", got)
+	}
+}
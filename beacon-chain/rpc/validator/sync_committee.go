@@ -0,0 +1,26 @@
+package validator
+
+// syncCommitteeState is implemented by beacon states carrying sync committees. Phase 0 states
+// do not, in which case validators are never reported as sync committee members.
+type syncCommitteeState interface {
+	CurrentSyncCommitteePubkeys() [][]byte
+	NextSyncCommitteePubkeys() [][]byte
+}
+
+// syncCommitteeMembers returns the public keys of the members of the current and next sync
+// committees of a beacon state, or nil sets when the state carries no sync committees.
+func syncCommitteeMembers(s interface{}) (map[string]bool, map[string]bool) {
+	state, ok := s.(syncCommitteeState)
+	if !ok {
+		return nil, nil
+	}
+	return pubkeySet(state.CurrentSyncCommitteePubkeys()), pubkeySet(state.NextSyncCommitteePubkeys())
+}
+
+func pubkeySet(pubKeys [][]byte) map[string]bool {
+	set := make(map[string]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		set[string(pubKey)] = true
+	}
+	return set
+}
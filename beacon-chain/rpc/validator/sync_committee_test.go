@@ -0,0 +1,102 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// syncCommitteeTestState is a beacon state carrying sync committees.
+type syncCommitteeTestState struct {
+	current [][]byte
+	next    [][]byte
+}
+
+func (s *syncCommitteeTestState) CurrentSyncCommitteePubkeys() [][]byte {
+	return s.current
+}
+
+func (s *syncCommitteeTestState) NextSyncCommitteePubkeys() [][]byte {
+	return s.next
+}
+
+func TestSyncCommitteeMembers(t *testing.T) {
+	current, next := syncCommitteeMembers(&pbp2p.BeaconState{})
+	if current != nil || next != nil {
+		t.Errorf("Expected no sync committees for a phase 0 state, received %v and %v", current, next)
+	}
+
+	current, next = syncCommitteeMembers(&syncCommitteeTestState{
+		current: [][]byte{[]byte("a"), []byte("b")},
+		next:    [][]byte{[]byte("b"), []byte("c")},
+	})
+	if !current["a"] || !current["b"] || current["c"] {
+		t.Errorf("Unexpected current sync committee %v", current)
+	}
+	if next["a"] || !next["b"] || !next["c"] {
+		t.Errorf("Unexpected next sync committee %v", next)
+	}
+}
+
+func TestGetDuties_SyncCommittees(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{EnableSyncCommitteeDuties: true})
+	defer featureconfig.Init(nil)
+
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesisRoot, err := ssz.HashTreeRoot(blk.NewGenesisBlock([]byte{}).Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: bState, Root: genesisRoot[:]},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	// A phase 0 state has no sync committees.
+	res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{Indices: []uint64{0, 1}, Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, duty := range res.Duties {
+		if duty.InCurrentSyncCommittee || duty.InNextSyncCommittee {
+			t.Errorf("Expected validator %d in no sync committee, received %v", i, duty)
+		}
+	}
+
+	// Assignments of a state carrying sync committees.
+	current, next := syncCommitteeMembers(&syncCommitteeTestState{
+		current: [][]byte{bState.Validators[0].PublicKey},
+		next:    [][]byte{bState.Validators[0].PublicKey, bState.Validators[1].PublicKey},
+	})
+	validators := []*requestedValidator{
+		{pubKey: bState.Validators[0].PublicKey, index: 0, known: true},
+		{pubKey: bState.Validators[1].PublicKey, index: 1, known: true},
+	}
+	duties, err := assignedDuties(ctx, validators, &epochAssignments{
+		currentSyncCommittee: current,
+		nextSyncCommittee:    next,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !duties[0].InCurrentSyncCommittee || !duties[0].InNextSyncCommittee {
+		t.Errorf("Expected validator 0 in both sync committees, received %v", duties[0])
+	}
+	if duties[1].InCurrentSyncCommittee || !duties[1].InNextSyncCommittee {
+		t.Errorf("Expected validator 1 in the next sync committee only, received %v", duties[1])
+	}
+}
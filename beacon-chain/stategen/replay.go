@@ -0,0 +1,137 @@
+package stategen
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"go.opencensus.io/trace"
+)
+
+// ReplayBlocks returns the state at the target slot on the chain ending at the target block root.
+// The chain is resolved backwards from the target block through the parent roots down to the
+// latest block of the start state, then its blocks are applied in order along with the empty slot
+// transitions of the skipped slots in between. Blocks of the chain above the target slot are not
+// applied. The blocks were verified when they were first processed, so their signatures are not
+// verified again. The start state is not modified.
+func (s *State) ReplayBlocks(ctx context.Context, startState *pb.BeaconState, targetRoot [32]byte, targetSlot uint64) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stategen.ReplayBlocks")
+	defer span.End()
+	if startState == nil || startState.LatestBlockHeader == nil {
+		return nil, errors.New("nil start state or latest block header")
+	}
+	if targetSlot < startState.Slot {
+		return nil, errors.Errorf("target slot %d is before start state slot %d", targetSlot, startState.Slot)
+	}
+
+	startRoot, err := latestBlockRoot(startState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute latest block root")
+	}
+	blks, err := s.loadBlocks(ctx, startRoot, startState.Slot, targetRoot, targetSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	replayed := proto.Clone(startState).(*pb.BeaconState)
+	for _, b := range blks {
+		replayed, err = executeStateTransitionStateGen(ctx, replayed, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not replay block at slot %d", b.Block.Slot)
+		}
+	}
+	if replayed.Slot < targetSlot {
+		replayed, err = state.ProcessSlots(ctx, replayed, targetSlot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process empty slots")
+		}
+	}
+	return replayed, nil
+}
+
+// loadBlocks walks back from the target block root through the parent roots until it reaches the
+// start block root, and returns the blocks of that chain up to the target slot in ascending slot
+// order. It fails if a block of the chain is missing or if the chain does not descend from the
+// start block.
+func (s *State) loadBlocks(ctx context.Context, startRoot [32]byte, startSlot uint64, targetRoot [32]byte, targetSlot uint64) ([]*ethpb.SignedBeaconBlock, error) {
+	var blks []*ethpb.SignedBeaconBlock
+	for root := targetRoot; root != startRoot; {
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve block %#x", root)
+		}
+		if b == nil || b.Block == nil {
+			return nil, errors.Errorf("missing block %#x", root)
+		}
+		if b.Block.Slot <= startSlot {
+			return nil, errors.Errorf("block %#x does not descend from block %#x", targetRoot, startRoot)
+		}
+		if b.Block.Slot <= targetSlot {
+			blks = append(blks, b)
+		}
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+	for i, j := 0, len(blks)-1; i < j; i, j = i+1, j-1 {
+		blks[i], blks[j] = blks[j], blks[i]
+	}
+	return blks, nil
+}
+
+// StateAtSlot regenerates the state at the given slot on the chain ending at the given block
+// root, by replaying its blocks from the nearest archived state saved in the database.
+func (s *State) StateAtSlot(ctx context.Context, blockRoot [32]byte, slot uint64) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stategen.StateAtSlot")
+	defer span.End()
+	archived, err := s.beaconDB.NearestArchivedState(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve archived state")
+	}
+	if archived == nil {
+		return nil, errors.Errorf("no archived state at or before slot %d", slot)
+	}
+	return s.ReplayBlocks(ctx, archived, blockRoot, slot)
+}
+
+// executeStateTransitionStateGen applies a past accepted block to the state, along with the empty
+// slots before it. None of the signatures of the block are verified, as it was verified when it
+// was first processed.
+func executeStateTransitionStateGen(ctx context.Context, st *pb.BeaconState, signed *ethpb.SignedBeaconBlock) (*pb.BeaconState, error) {
+	if signed == nil || signed.Block == nil {
+		return nil, errors.New("nil block")
+	}
+	blocks.ClearEth1DataVoteCache()
+
+	st, err := state.ProcessSlots(ctx, st, signed.Block.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slot")
+	}
+	st, err = state.ProcessBlockForStateRegen(ctx, st, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block")
+	}
+	return st, nil
+}
+
+// latestBlockRoot returns the root of the latest block applied to the state. The state root of
+// the latest block header is only filled in when processing the next slot, in which case the
+// state is the post state of that block.
+func latestBlockRoot(st *pb.BeaconState) ([32]byte, error) {
+	header := proto.Clone(st.LatestBlockHeader).(*ethpb.BeaconBlockHeader)
+	if len(header.StateRoot) == 0 || bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := stateutil.HashTreeRootState(st)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return ssz.HashTreeRoot(header)
+}
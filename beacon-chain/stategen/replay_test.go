@@ -0,0 +1,189 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestReplayBlocks_MatchesProcessedStates(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	genesis, privKeys := testutil.DeterministicGenesisState(t, 64)
+	beaconState := proto.Clone(genesis).(*pb.BeaconState)
+	skipped := map[uint64]bool{3: true, 6: true, 7: true}
+	processed := make(map[uint64]*pb.BeaconState)
+	roots := make(map[uint64][32]byte)
+	var forkRoot [32]byte
+	var forkState *pb.BeaconState
+	for slot := uint64(1); slot <= 10; slot++ {
+		if skipped[slot] {
+			continue
+		}
+		// A block on another fork, built on the state at slot 4, is saved at slot 8 along
+		// with the canonical block.
+		if slot == 5 {
+			fork, err := testutil.GenerateFullBlock(proto.Clone(processed[4]).(*pb.BeaconState), privKeys, &testutil.BlockGenConfig{}, 8)
+			if err != nil {
+				t.Fatal(err)
+			}
+			forkState, err = state.ExecuteStateTransition(ctx, proto.Clone(processed[4]).(*pb.BeaconState), fork)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := db.SaveBlock(ctx, fork); err != nil {
+				t.Fatal(err)
+			}
+			forkRoot, err = ssz.HashTreeRoot(fork.Block)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{}, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		processed[slot] = proto.Clone(beaconState).(*pb.BeaconState)
+		roots[slot], err = ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The states of skipped slots are the empty slot transitions of the previous state.
+	for _, slot := range []uint64{3, 7} {
+		prev := processed[slot-1]
+		if prev == nil {
+			prev = processed[slot-2]
+		}
+		st, err := state.ProcessSlots(ctx, proto.Clone(prev).(*pb.BeaconState), slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		processed[slot] = st
+	}
+
+	gen := New(db)
+	tests := []struct {
+		name       string
+		startState *pb.BeaconState
+		targetRoot [32]byte
+		targetSlot uint64
+		wanted     *pb.BeaconState
+	}{
+		{name: "genesis to block slot", startState: genesis, targetRoot: roots[10], targetSlot: 10, wanted: processed[10]},
+		{name: "genesis to skipped slot", startState: genesis, targetRoot: roots[2], targetSlot: 3, wanted: processed[3]},
+		{name: "skipped slots", startState: processed[4], targetRoot: roots[5], targetSlot: 7, wanted: processed[7]},
+		{name: "canonical block next to fork block", startState: processed[4], targetRoot: roots[9], targetSlot: 9, wanted: processed[9]},
+		{name: "fork block", startState: processed[4], targetRoot: forkRoot, targetSlot: 8, wanted: forkState},
+		{name: "skipped slot to block slot", startState: processed[3], targetRoot: roots[9], targetSlot: 9, wanted: processed[9]},
+		{name: "blocks above target slot", startState: genesis, targetRoot: roots[10], targetSlot: 5, wanted: processed[5]},
+		{name: "same slot", startState: processed[8], targetRoot: roots[8], targetSlot: 8, wanted: processed[8]},
+	}
+	for _, tt := range tests {
+		startRoot, err := stateutil.HashTreeRootState(tt.startState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		replayed, err := gen.ReplayBlocks(ctx, tt.startState, tt.targetRoot, tt.targetSlot)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if replayed.Slot != tt.targetSlot {
+			t.Errorf("%s: wanted slot %d, received %d", tt.name, tt.targetSlot, replayed.Slot)
+		}
+		wanted, err := stateutil.HashTreeRootState(tt.wanted)
+		if err != nil {
+			t.Fatal(err)
+		}
+		received, err := stateutil.HashTreeRootState(replayed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if received != wanted {
+			t.Errorf("%s: wanted state root %#x, received %#x", tt.name, wanted, received)
+		}
+		if root, _ := stateutil.HashTreeRootState(tt.startState); root != startRoot {
+			t.Errorf("%s: start state was modified", tt.name)
+		}
+	}
+
+	if _, err := gen.ReplayBlocks(ctx, processed[9], roots[9], 8); err == nil {
+		t.Error("Expected an error replaying to a slot before the start state")
+	}
+	if _, err := gen.ReplayBlocks(ctx, processed[5], forkRoot, 8); err == nil {
+		t.Error("Expected an error replaying a block which does not descend from the start state")
+	}
+	if _, err := gen.ReplayBlocks(ctx, genesis, [32]byte{'a'}, 8); err == nil {
+		t.Error("Expected an error replaying to a missing block")
+	}
+}
+
+func TestStateAtSlot_ReplaysFromNearestArchivedState(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDBWithSlotsPerArchivedPoint(t, 4)
+	defer testDB.TeardownDB(t, db)
+
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	gen := New(db)
+	if _, err := gen.StateAtSlot(ctx, [32]byte{}, 5); err == nil {
+		t.Error("Expected an error without archived states")
+	}
+	if err := db.SaveArchivedPointState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	var wanted, headRoot [32]byte
+	for slot := uint64(1); slot <= 6; slot++ {
+		blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{}, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveArchivedPointState(ctx, beaconState); err != nil {
+			t.Fatal(err)
+		}
+		if slot == 6 {
+			wanted, err = stateutil.HashTreeRootState(beaconState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			headRoot, err = ssz.HashTreeRoot(blk.Block)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	st, err := gen.StateAtSlot(ctx, headRoot, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received, err := stateutil.HashTreeRootState(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received != wanted {
+		t.Errorf("Wanted state root %#x, received %#x", wanted, received)
+	}
+}
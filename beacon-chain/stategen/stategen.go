@@ -0,0 +1,17 @@
+// Package stategen regenerates historical beacon states, which are not all kept in the
+// database, by replaying the stored blocks on top of an earlier state.
+package stategen
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+)
+
+// State regenerates beacon states from the blocks and archived states saved in the database.
+type State struct {
+	beaconDB db.ReadOnlyDatabase
+}
+
+// New returns a state generator reading blocks and archived states from the database.
+func New(beaconDB db.ReadOnlyDatabase) *State {
+	return &State{beaconDB: beaconDB}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/paulbellamy/ratecounter"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
@@ -19,6 +21,7 @@ import (
 )
 
 var _ = shared.Service(&Service{})
+var _ = sync.ProgressFetcher(&Service{})
 
 type blockchainService interface {
 	blockchain.BlockReceiver
@@ -46,6 +49,7 @@ type Service struct {
 	synced        bool
 	chainStarted  bool
 	stateNotifier statefeed.Notifier
+	counter       *ratecounter.RateCounter
 }
 
 // NewInitialSync configures the initial sync service responsible for bringing the node up to the
@@ -57,6 +61,7 @@ func NewInitialSync(cfg *Config) *Service {
 		p2p:           cfg.P2P,
 		db:            cfg.DB,
 		stateNotifier: cfg.StateNotifier,
+		counter:       ratecounter.NewRateCounter(counterSeconds * time.Second),
 	}
 }
 
@@ -110,6 +115,9 @@ func (s *Service) Start() {
 	log.Info("Starting initial chain sync...")
 	// Are we already in sync, or close to it?
 	if helpers.SlotToEpoch(s.chain.HeadSlot()) == helpers.SlotToEpoch(currentSlot) {
+		if err := s.verifyWeakSubjectivity(); err != nil {
+			log.WithError(err).Fatal("Could not verify weak subjectivity checkpoint")
+		}
 		log.Info("Already synced to the current chain head")
 		s.synced = true
 		return
@@ -118,6 +126,9 @@ func (s *Service) Start() {
 	if err := s.roundRobinSync(genesis); err != nil {
 		panic(err)
 	}
+	if err := s.verifyWeakSubjectivity(); err != nil {
+		log.WithError(err).Fatal("Could not verify weak subjectivity checkpoint")
+	}
 	log.Infof("Synced up to slot %d", s.chain.HeadSlot())
 	s.synced = true
 }
@@ -179,3 +190,27 @@ func (s *Service) waitForMinimumPeers() {
 		time.Sleep(handshakePollingInterval)
 	}
 }
+
+// HighestKnownSlot returns the highest head slot reported by the connected peers, or the head
+// slot of the node if it is ahead of all of them.
+func (s *Service) HighestKnownSlot() uint64 {
+	highest := s.chain.HeadSlot()
+	for _, pid := range s.p2p.Peers().Connected() {
+		peerChainState, err := s.p2p.Peers().ChainState(pid)
+		if err == nil && peerChainState != nil && peerChainState.HeadSlot > highest {
+			highest = peerChainState.HeadSlot
+		}
+	}
+	return highest
+}
+
+// BlocksPerSecond returns the rate at which blocks were processed by the initial sync over the
+// last counterSeconds. It returns false if no block was processed over that period, in which
+// case there is no rate to report.
+func (s *Service) BlocksPerSecond() (float64, bool) {
+	count := s.counter.Rate()
+	if count == 0 {
+		return 0, false
+	}
+	return float64(count) / counterSeconds, true
+}
@@ -2,7 +2,9 @@ package testing
 
 // Sync defines a mock for the sync service.
 type Sync struct {
-	IsSyncing bool
+	IsSyncing   bool
+	HighestSlot uint64
+	BlocksRate  float64
 }
 
 // Syncing --
@@ -19,3 +21,13 @@ func (s *Sync) Status() error {
 func (s *Sync) Resync() error {
 	return nil
 }
+
+// HighestKnownSlot --
+func (s *Sync) HighestKnownSlot() uint64 {
+	return s.HighestSlot
+}
+
+// BlocksPerSecond --
+func (s *Sync) BlocksPerSecond() (float64, bool) {
+	return s.BlocksRate, s.BlocksRate > 0
+}
@@ -0,0 +1,61 @@
+package initialsync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// VerifyWeakSubjectivityCheckpoint checks the chain stored by the node includes the block with
+// the given root as the checkpoint block of the given epoch, that is the latest block at or
+// before the start slot of the epoch. The stored chain is walked back from the head block, so
+// a node whose chain diverges from the checkpoint is detected before it considers itself synced,
+// protecting it against long range attacks.
+func (s *Service) VerifyWeakSubjectivityCheckpoint(ctx context.Context, root [32]byte, epoch uint64) error {
+	headRoot, err := s.chain.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head root")
+	}
+	if s.chain.HeadSlot() < helpers.StartSlot(epoch) {
+		return errors.Errorf("head slot %d is before weak subjectivity checkpoint epoch %d", s.chain.HeadSlot(), epoch)
+	}
+
+	blockRoot := bytesutil.ToBytes32(headRoot)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		blk, err := s.db.Block(ctx, blockRoot)
+		if err != nil {
+			return errors.Wrapf(err, "could not get block %#x", blockRoot)
+		}
+		if blk == nil || blk.Block == nil {
+			return errors.Errorf("block %#x is missing from the stored chain", blockRoot)
+		}
+		if blk.Block.Slot <= helpers.StartSlot(epoch) {
+			break
+		}
+		blockRoot = bytesutil.ToBytes32(blk.Block.ParentRoot)
+	}
+	if blockRoot != root {
+		return errors.Errorf(
+			"chain diverges from weak subjectivity checkpoint, wanted block %#x at epoch %d, found %#x",
+			root,
+			epoch,
+			blockRoot,
+		)
+	}
+	return nil
+}
+
+// verifyWeakSubjectivity verifies the weak subjectivity checkpoint the node was started with, if any.
+func (s *Service) verifyWeakSubjectivity() error {
+	checkpoint := flags.Get().WeakSubjectivityCheckpoint
+	if checkpoint == nil {
+		return nil
+	}
+	return s.VerifyWeakSubjectivityCheckpoint(s.ctx, bytesutil.ToBytes32(checkpoint.Root), checkpoint.Epoch)
+}
@@ -0,0 +1,72 @@
+package initialsync
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// saveChain saves a chain of blocks at the given slots, each block being the child of the
+// previous one, and returns the roots of the blocks by slot.
+func saveChain(t *testing.T, beaconDB db.Database, slots []uint64) map[uint64][32]byte {
+	roots := make(map[uint64][32]byte, len(slots))
+	parentRoot := [32]byte{}
+	for _, slot := range slots {
+		blk := &eth.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}
+		if err := beaconDB.SaveBlock(context.Background(), &eth.SignedBeaconBlock{Block: blk}); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots[slot] = root
+		parentRoot = root
+	}
+	return roots
+}
+
+func TestVerifyWeakSubjectivityCheckpoint(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, beaconDB)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// The start slot of epoch 1 is skipped, so its checkpoint block is the last block of epoch 0.
+	headSlot := 2*slotsPerEpoch + 1
+	roots := saveChain(t, beaconDB, []uint64{0, slotsPerEpoch - 2, slotsPerEpoch + 3, headSlot})
+	headRoot := roots[headSlot]
+	s := &Service{
+		chain: &mock.ChainService{State: &p2ppb.BeaconState{Slot: headSlot}, Root: headRoot[:]},
+		db:    beaconDB,
+	}
+
+	tests := []struct {
+		name   string
+		root   [32]byte
+		epoch  uint64
+		errMsg string
+	}{
+		{name: "genesis", root: roots[0], epoch: 0},
+		{name: "skipped epoch start", root: roots[slotsPerEpoch-2], epoch: 1},
+		{name: "mismatching root", root: roots[slotsPerEpoch+3], epoch: 1, errMsg: "chain diverges"},
+		{name: "unknown root", root: [32]byte{'a'}, epoch: 2, errMsg: "chain diverges"},
+		{name: "ahead of head", root: [32]byte{'a'}, epoch: 3, errMsg: "is before weak subjectivity checkpoint"},
+	}
+	for _, tt := range tests {
+		err := s.VerifyWeakSubjectivityCheckpoint(context.Background(), tt.root, tt.epoch)
+		if tt.errMsg == "" && err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		if tt.errMsg != "" && (err == nil || !strings.Contains(err.Error(), tt.errMsg)) {
+			t.Errorf("%s: wanted error containing %q, received %v", tt.name, tt.errMsg, err)
+		}
+	}
+}
@@ -20,6 +20,13 @@ var (
 		},
 		[]string{"topic"},
 	)
+	messageValidationTimeoutCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_message_validation_timeout_total",
+			Help: "Count of messages ignored as their validation took too long.",
+		},
+		[]string{"topic"},
+	)
 	messageFailedProcessingCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "p2p_message_failed_processing_total",
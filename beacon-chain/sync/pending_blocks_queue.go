@@ -21,6 +21,10 @@ import (
 
 var processPendingBlocksPeriod = time.Duration(params.BeaconConfig().SecondsPerSlot/3) * time.Second
 
+// parentBlockRequestPeers is the number of best scored peers a missing parent block is requested
+// from, one of them being picked at random for each request to spread the load.
+const parentBlockRequestPeers = 4
+
 // processes pending blocks queue on every processPendingBlocksPeriod
 func (r *Service) processPendingBlocksQueue() {
 	ctx := context.Background()
@@ -34,7 +38,7 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "processPendingBlocks")
 	defer span.End()
 
-	pids := r.p2p.Peers().Connected()
+	pids := r.p2p.Peers().BestPeers(parentBlockRequestPeers)
 	if err := r.validatePendingSlots(); err != nil {
 		return errors.Wrap(err, "could not validate pending slots")
 	}
@@ -3,11 +3,13 @@ package sync
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
@@ -20,14 +22,21 @@ var _ = shared.Service(&Service{})
 const allowedBlocksPerSecond = 32.0
 const allowedBlocksBurst = 10 * allowedBlocksPerSecond
 
+// defaultValidationTimeout is the gossip message validation timeout used when none is configured.
+const defaultValidationTimeout = 5 * time.Second
+
 // Config to set up the regular sync service.
 type Config struct {
 	P2P           p2p.P2P
 	DB            db.NoHeadAccessDatabase
 	AttPool       attestations.Pool
+	SubnetIDs     *cache.SubnetIDs
 	Chain         blockchainService
 	InitialSync   Checker
 	StateNotifier statefeed.Notifier
+	// ValidationTimeout is the time after which the validation of a gossip message is abandoned
+	// and the message ignored. It defaults to defaultValidationTimeout.
+	ValidationTimeout time.Duration
 }
 
 // This defines the interface for interacting with block chain service
@@ -43,18 +52,24 @@ type blockchainService interface {
 // NewRegularSync service.
 func NewRegularSync(cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(context.Background())
+	validationTimeout := cfg.ValidationTimeout
+	if validationTimeout == 0 {
+		validationTimeout = defaultValidationTimeout
+	}
 	r := &Service{
 		ctx:                 ctx,
 		cancel:              cancel,
 		db:                  cfg.DB,
 		p2p:                 cfg.P2P,
 		attPool:             cfg.AttPool,
+		subnetIDs:           cfg.SubnetIDs,
 		chain:               cfg.Chain,
 		initialSync:         cfg.InitialSync,
 		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 		stateNotifier:       cfg.StateNotifier,
 		blocksRateLimiter:   leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */),
+		validationTimeout:   validationTimeout,
 	}
 
 	r.registerRPCHandlers()
@@ -71,6 +86,7 @@ type Service struct {
 	p2p                 p2p.P2P
 	db                  db.NoHeadAccessDatabase
 	attPool             attestations.Pool
+	subnetIDs           *cache.SubnetIDs
 	chain               blockchainService
 	slotToPendingBlocks map[uint64]*ethpb.SignedBeaconBlock
 	seenPendingBlocks   map[[32]byte]bool
@@ -80,6 +96,7 @@ type Service struct {
 	validateBlockLock   sync.RWMutex
 	stateNotifier       statefeed.Notifier
 	blocksRateLimiter   *leakybucket.Collector
+	validationTimeout   time.Duration
 }
 
 // Start the regular sync service.
@@ -112,3 +129,10 @@ type Checker interface {
 	Status() error
 	Resync() error
 }
+
+// ProgressFetcher defines a struct which can report how far a node is from the head of the
+// chain known to its peers and how fast it is catching up.
+type ProgressFetcher interface {
+	HighestKnownSlot() uint64
+	BlocksPerSecond() (float64, bool)
+}
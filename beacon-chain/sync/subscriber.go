@@ -20,6 +20,23 @@ import (
 
 const pubsubMessageTimeout = 30 * time.Second
 
+const (
+	// validMessageScore is added to the gossip score of a peer for every message it relays which passes validation.
+	validMessageScore = 1
+	// invalidMessageScore is added to the gossip score of a peer for every message it relays which fails validation. It
+	// outweighs the reward of a valid message, so a peer can't make up for junk by relaying valid messages as well.
+	invalidMessageScore = -2
+)
+
+// ignoredMessage is set as the validator data of a message its validator ignores rather than rejects,
+// such as a message already seen or which can't be validated yet, so its peer is not penalized for it.
+type ignoredMessage struct{}
+
+// ignoreMessage marks the message as ignored by its validator, which should then return false.
+func ignoreMessage(msg *pubsub.Message) {
+	msg.ValidatorData = ignoredMessage{}
+}
+
 // subHandler represents handler for a given subscription.
 type subHandler func(context.Context, proto.Message) error
 
@@ -89,7 +106,7 @@ func (r *Service) registerSubscribers() {
 	)
 	r.subscribeDynamic(
 		"/eth2/committee_index%d_beacon_attestation",
-		r.currentCommitteeIndex,                     /* determineSubsLen */
+		r.attestationSubnets,                        /* wantedSubnets */
 		r.validateCommitteeIndexBeaconAttestation,   /* validator */
 		r.committeeIndexBeaconAttestationSubscriber, /* message handler */
 	)
@@ -109,7 +126,7 @@ func (r *Service) subscribeWithBase(base proto.Message, topic string, validator
 	topic += r.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
 
-	if err := r.p2p.PubSub().RegisterTopicValidator(wrapAndReportValidation(topic, validator)); err != nil {
+	if err := r.p2p.PubSub().RegisterTopicValidator(wrapAndReportValidation(topic, r.scoreValidation(validator), r.validationTimeout)); err != nil {
 		log.WithError(err).Error("Failed to register validator")
 	}
 
@@ -174,32 +191,74 @@ func (r *Service) subscribeWithBase(base proto.Message, topic string, validator
 	return sub
 }
 
+// Wrap the pubsub validator to update the gossip score of the peer which relayed the message with the
+// validation result, so that requests are sent to the peers relaying valid messages first. Only
+// rejected messages are penalized: messages received while syncing, messages the validator ignored
+// and validations abandoned at their timeout leave the score as is.
+func (r *Service) scoreValidation(v pubsub.Validator) pubsub.Validator {
+	return func(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+		b := v(ctx, pid, msg)
+		if r.initialSync.Syncing() || ctx.Err() != nil {
+			return b
+		}
+		if b {
+			r.p2p.Peers().AddGossipScore(pid, validMessageScore)
+		} else if _, ok := msg.ValidatorData.(ignoredMessage); !ok {
+			r.p2p.Peers().AddGossipScore(pid, invalidMessageScore)
+		}
+		return b
+	}
+}
+
 // Wrap the pubsub validator with a metric monitoring function. This function increments the
-// appropriate counter if the particular message fails to validate.
-func wrapAndReportValidation(topic string, v pubsub.Validator) (string, pubsub.Validator) {
+// appropriate counter if the particular message fails to validate. A validation taking longer than
+// the timeout is abandoned and the message ignored, so that a slow validation, such as one waiting
+// on a state fetch, does not hold up the pubsub validation pipeline. The validator is given a
+// context cancelled at the timeout to stop its work. A zero timeout disables it.
+func wrapAndReportValidation(topic string, v pubsub.Validator, timeout time.Duration) (string, pubsub.Validator) {
 	return topic, func(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
 		defer messagehandler.HandlePanic(ctx, msg)
 		messageReceivedCounter.WithLabelValues(topic).Inc()
-		b := v(ctx, pid, msg)
-		if !b {
-			messageFailedValidationCounter.WithLabelValues(topic).Inc()
+		if timeout == 0 {
+			b := v(ctx, pid, msg)
+			if !b {
+				messageFailedValidationCounter.WithLabelValues(topic).Inc()
+			}
+			return b
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		result := make(chan bool, 1)
+		go func() {
+			defer messagehandler.HandlePanic(ctx, msg)
+			result <- v(ctx, pid, msg)
+		}()
+		select {
+		case b := <-result:
+			if !b {
+				messageFailedValidationCounter.WithLabelValues(topic).Inc()
+			}
+			return b
+		case <-ctx.Done():
+			messageValidationTimeoutCounter.WithLabelValues(topic).Inc()
+			log.WithField("topic", topic).WithField("timeout", timeout).Debug("Ignoring message which took too long to validate")
+			return false
 		}
-		return b
 	}
 }
 
-// subscribe to a dynamically increasing index of topics. This method expects a fmt compatible
-// string for the topic name and a maxID to represent the number of subscribed topics that should be
-// maintained. As the state feed emits a newly updated state, the maxID function will be called to
-// determine the appropriate number of topics. This method supports only sequential number ranges
-// for topics.
-func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() int, validate pubsub.Validator, handle subHandler) {
+// subscribe to a dynamic set of topics indexed by subnet. This method expects a fmt compatible
+// string for the topic name and a function returning the subnets whose topics should be
+// subscribed to. As the state feed emits a newly updated state, the function is called again and
+// the topics of the subnets no longer wanted are unsubscribed from.
+func (r *Service) subscribeDynamic(topicFormat string, wantedSubnets func() []uint64, validate pubsub.Validator, handle subHandler) {
 	base := p2p.GossipTopicMappings[topicFormat]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topicFormat))
 	}
 
-	var subscriptions []*pubsub.Subscription
+	subscriptions := make(map[uint64]*pubsub.Subscription)
 
 	stateChannel := make(chan *feed.Event, 1)
 	stateSub := r.stateNotifier.StateFeed().Subscribe(stateChannel)
@@ -210,21 +269,23 @@ func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() i
 				stateSub.Unsubscribe()
 				return
 			case <-stateChannel:
-				// Update topic count.
-				wantedSubs := determineSubsLen()
-				// Resize as appropriate.
-				if len(subscriptions) > wantedSubs { // Reduce topics
-					var cancelSubs []*pubsub.Subscription
-					subscriptions, cancelSubs = subscriptions[:wantedSubs-1], subscriptions[wantedSubs:]
-					for i, sub := range cancelSubs {
-						sub.Cancel()
-						r.p2p.PubSub().UnregisterTopicValidator(fmt.Sprintf(topicFormat, i+wantedSubs))
+				wanted := make(map[uint64]bool)
+				for _, subnet := range wantedSubnets() {
+					wanted[subnet] = true
+					if _, ok := subscriptions[subnet]; !ok {
+						subscriptions[subnet] = r.subscribeWithBase(base, fmt.Sprintf(topicFormat, subnet), validate, handle)
+					}
+				}
+				for subnet, sub := range subscriptions {
+					if wanted[subnet] {
+						continue
 					}
-				} else if len(subscriptions) < wantedSubs { // Increase topics
-					for i := len(subscriptions); i < wantedSubs; i++ {
-						sub := r.subscribeWithBase(base, fmt.Sprintf(topicFormat, i), validate, handle)
-						subscriptions = append(subscriptions, sub)
+					sub.Cancel()
+					topic := fmt.Sprintf(topicFormat, subnet) + r.p2p.Encoding().ProtocolSuffix()
+					if err := r.p2p.PubSub().UnregisterTopicValidator(topic); err != nil {
+						log.WithError(err).WithField("topic", topic).Error("Failed to unregister validator")
 					}
+					delete(subscriptions, subnet)
 				}
 			}
 		}
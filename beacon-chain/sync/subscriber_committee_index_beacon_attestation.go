@@ -7,6 +7,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 )
 
 func (r *Service) committeeIndexBeaconAttestationSubscriber(ctx context.Context, msg proto.Message) error {
@@ -24,3 +25,19 @@ func (r *Service) currentCommitteeIndex() int {
 	}
 	return int(helpers.SlotCommitteeCount(uint64(len(activeValidatorIndices))))
 }
+
+// attestationSubnets returns the attestation subnets to subscribe to: the subnets the committees of
+// the head slot map to, along with the subnets aggregating validators asked to subscribe to through
+// the validator server until their duty is over.
+func (r *Service) attestationSubnets() []uint64 {
+	count := uint64(r.currentCommitteeIndex())
+	slot := r.chain.HeadSlot()
+	subnets := make([]uint64, 0, count)
+	for i := uint64(0); i < count; i++ {
+		subnets = append(subnets, helpers.ComputeSubnetForAttestation(count, slot, i))
+	}
+	if r.subnetIDs != nil {
+		subnets = append(subnets, r.subnetIDs.ActiveSubnetIDs(roughtime.Now())...)
+	}
+	return subnets
+}
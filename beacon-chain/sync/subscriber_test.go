@@ -8,11 +8,16 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -114,3 +119,123 @@ func TestSubscribe_HandlesPanic(t *testing.T) {
 		t.Fatal("Did not receive PubSub in 1 second")
 	}
 }
+
+func TestWrapAndReportValidation_Timeout(t *testing.T) {
+	cancelled := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	slowValidator := func(ctx context.Context, _ peer.ID, _ *pubsub.Message) bool {
+		<-ctx.Done()
+		close(cancelled)
+		<-release
+		return true
+	}
+	fastValidator := func(_ context.Context, _ peer.ID, _ *pubsub.Message) bool {
+		return true
+	}
+	msg := &pubsub.Message{Message: &pubsubpb.Message{}}
+
+	_, v := wrapAndReportValidation("/eth2/beacon_block", slowValidator, 10*time.Millisecond)
+	done := make(chan bool, 1)
+	go func() {
+		done <- v(context.Background(), "", msg)
+	}()
+	select {
+	case valid := <-done:
+		if valid {
+			t.Error("Expected message to be ignored after the validation timeout")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Validation was not abandoned after the timeout")
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Error("Expected the validator context to be cancelled at the timeout")
+	}
+
+	_, v = wrapAndReportValidation("/eth2/beacon_block", fastValidator, 10*time.Millisecond)
+	if !v(context.Background(), "", msg) {
+		t.Error("Expected message validated within the timeout to be accepted")
+	}
+}
+
+func TestScoreValidation_UpdatesPeerScore(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := Service{
+		ctx:         context.Background(),
+		p2p:         p,
+		initialSync: &mockSync.Sync{IsSyncing: false},
+	}
+	good := peer.ID("good")
+	bad := peer.ID("bad")
+	for _, pid := range []peer.ID{good, bad} {
+		p.Peers().Add(pid, nil, network.DirInbound)
+		p.Peers().SetConnectionState(pid, peers.PeerConnected)
+	}
+	validator := func(_ context.Context, pid peer.ID, _ *pubsub.Message) bool {
+		return pid == good
+	}
+	v := r.scoreValidation(validator)
+	msg := &pubsub.Message{Message: &pubsubpb.Message{}}
+
+	for i := 0; i < 3; i++ {
+		if !v(context.Background(), good, msg) {
+			t.Error("Expected message of good peer to be accepted")
+		}
+		if v(context.Background(), bad, msg) {
+			t.Error("Expected message of bad peer to be rejected")
+		}
+	}
+	if score, _ := p.Peers().Score(good); score != 3*validMessageScore {
+		t.Errorf("Unexpected score of good peer: expected %d, received %d", 3*validMessageScore, score)
+	}
+	if score, _ := p.Peers().Score(bad); score != 3*invalidMessageScore {
+		t.Errorf("Unexpected score of bad peer: expected %d, received %d", 3*invalidMessageScore, score)
+	}
+	best := p.Peers().BestPeers(2)
+	if len(best) != 2 || best[0] != good {
+		t.Errorf("Expected good peer to be selected first, received %v", best)
+	}
+}
+
+func TestScoreValidation_IgnoredMessagesLeaveScore(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	pid := peer.ID("peer")
+	p.Peers().Add(pid, nil, network.DirInbound)
+	p.Peers().SetConnectionState(pid, peers.PeerConnected)
+	reject := func(_ context.Context, _ peer.ID, _ *pubsub.Message) bool {
+		return false
+	}
+	ignore := func(_ context.Context, _ peer.ID, msg *pubsub.Message) bool {
+		ignoreMessage(msg)
+		return false
+	}
+
+	syncing := Service{
+		ctx:         context.Background(),
+		p2p:         p,
+		initialSync: &mockSync.Sync{IsSyncing: true},
+	}
+	if syncing.scoreValidation(reject)(context.Background(), pid, &pubsub.Message{Message: &pubsubpb.Message{}}) {
+		t.Error("Expected message to be rejected")
+	}
+
+	r := Service{
+		ctx:         context.Background(),
+		p2p:         p,
+		initialSync: &mockSync.Sync{IsSyncing: false},
+	}
+	if r.scoreValidation(ignore)(context.Background(), pid, &pubsub.Message{Message: &pubsubpb.Message{}}) {
+		t.Error("Expected message to be ignored")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r.scoreValidation(reject)(ctx, pid, &pubsub.Message{Message: &pubsubpb.Message{}}) {
+		t.Error("Expected message to be rejected")
+	}
+
+	if score, _ := p.Peers().Score(pid); score != 0 {
+		t.Errorf("Unexpected score of peer: expected 0, received %d", score)
+	}
+}
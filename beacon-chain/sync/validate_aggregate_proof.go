@@ -57,6 +57,7 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		return false
 	}
 	if seen {
+		ignoreMessage(msg)
 		return false
 	}
 
@@ -148,7 +149,7 @@ func validateSelection(ctx context.Context, s *pb.BeaconState, data *ethpb.Attes
 	if err != nil {
 		return err
 	}
-	aggregator, err := helpers.IsAggregator(uint64(len(committee)), data.Slot, data.CommitteeIndex, proof)
+	aggregator, err := helpers.IsAggregator(uint64(len(committee)), proof)
 	if err != nil {
 		return err
 	}
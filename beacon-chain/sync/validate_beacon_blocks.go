@@ -54,6 +54,7 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	r.pendingQueueLock.RLock()
 	if r.seenPendingBlocks[blockRoot] {
 		r.pendingQueueLock.RUnlock()
+		ignoreMessage(msg)
 		return false
 	}
 	r.pendingQueueLock.RUnlock()
@@ -65,6 +66,7 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 
 	if r.chain.FinalizedCheckpt().Epoch > helpers.SlotToEpoch(blk.Block.Slot) {
 		log.Debug("Block older than finalized checkpoint received,rejecting it")
+		ignoreMessage(msg)
 		return false
 	}
 
@@ -56,7 +56,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	// The attestation's committee index (attestation.data.index) is for the correct subnet.
-	if !strings.HasPrefix(originalTopic, fmt.Sprintf(format, att.Data.CommitteeIndex)) {
+	if !s.isCorrectAttestationTopic(ctx, originalTopic, format, att) {
 		return false
 	}
 
@@ -92,3 +92,18 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 
 	return true
 }
+
+// This returns whether the attestation was received on the topic of the subnet its committee maps
+// to given the active validator count of the head state.
+func (s *Service) isCorrectAttestationTopic(ctx context.Context, topic string, format string, att *eth.Attestation) bool {
+	headState, err := s.chain.HeadStateReadOnly(ctx)
+	if err != nil || headState == nil {
+		return false
+	}
+	activeCount, err := helpers.ActiveValidatorCount(headState, helpers.SlotToEpoch(att.Data.Slot))
+	if err != nil {
+		return false
+	}
+	subnet := helpers.ComputeSubnetForAttestation(helpers.SlotCommitteeCount(activeCount), att.Data.Slot, att.Data.CommitteeIndex)
+	return strings.HasPrefix(topic, fmt.Sprintf(format, subnet))
+}
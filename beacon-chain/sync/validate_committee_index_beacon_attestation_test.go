@@ -3,6 +3,7 @@ package sync
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -12,11 +13,13 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
@@ -24,12 +27,14 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 	p := p2ptest.NewTestP2P(t)
 	db := dbtest.SetupDB(t)
 	defer dbtest.TeardownDB(t, db)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
 	s := &Service{
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		p2p:         p,
 		db:          db,
 		chain: &mockChain.ChainService{
 			Genesis: time.Now().Add(time.Duration(-64*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second), // 64 slots ago
+			State:   beaconState,
 		},
 	}
 
@@ -48,6 +53,10 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 	}
 
 	validSig := bls.RandKey().Sign([]byte("foo"), 0).Marshal()
+	subnetTopic := func(committeeIndex uint64) string {
+		subnet := helpers.ComputeSubnetForAttestation(helpers.SlotCommitteeCount(64), 63, committeeIndex)
+		return fmt.Sprintf("/eth2/committee_index%d_beacon_attestation", subnet)
+	}
 
 	tests := []struct {
 		name  string
@@ -66,9 +75,23 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				},
 				Signature: validSig,
 			},
-			topic: "/eth2/committee_index1_beacon_attestation",
+			topic: subnetTopic(1),
 			want:  true,
 		},
+		{
+			name: "committee index topic instead of subnet",
+			msg: &ethpb.Attestation{
+				AggregationBits: bitfield.Bitlist{0b1010},
+				Data: &ethpb.AttestationData{
+					BeaconBlockRoot: validBlockRoot[:],
+					CommitteeIndex:  1,
+					Slot:            63,
+				},
+				Signature: validSig,
+			},
+			topic: "/eth2/committee_index1_beacon_attestation",
+			want:  false,
+		},
 		{
 			name: "wrong committee index",
 			msg: &ethpb.Attestation{
@@ -94,7 +117,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				},
 				Signature: validSig,
 			},
-			topic: "/eth2/committee_index1_beacon_attestation",
+			topic: subnetTopic(1),
 			want:  false,
 		},
 		{
@@ -108,7 +131,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				},
 				Signature: validSig,
 			},
-			topic: "/eth2/committee_index1_beacon_attestation",
+			topic: subnetTopic(1),
 			want:  false,
 		},
 		{
@@ -122,7 +145,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				},
 				Signature: []byte("bad"),
 			},
-			topic: "/eth2/committee_index1_beacon_attestation",
+			topic: subnetTopic(1),
 			want:  false,
 		},
 	}
@@ -81,11 +81,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.InteropGenesisStateFlag,
 			flags.DepositContractFlag,
 			flags.ContractDeploymentBlock,
+			flags.Eth1FollowDistance,
+			flags.WeakSubjectivityCheckpoint,
 			flags.Web3ProviderFlag,
 			flags.RPCPort,
 			flags.CertFlag,
 			flags.KeyFlag,
 			flags.GRPCGatewayPort,
+			flags.MaxValidatorsPerDutiesRequest,
+			flags.EnableDebugRPCEndpoints,
 			flags.HTTPWeb3ProviderFlag,
 		},
 	},
@@ -100,6 +104,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.EnableUPnPFlag,
 			cmd.P2PEncoding,
 			flags.MinSyncPeers,
+			flags.GossipValidationTimeout,
 		},
 	},
 	{
@@ -128,6 +133,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ArchiveValidatorSetChangesFlag,
 			flags.ArchiveBlocksFlag,
 			flags.ArchiveAttestationsFlag,
+			flags.SlotsPerArchivedPoint,
 		},
 	},
 }
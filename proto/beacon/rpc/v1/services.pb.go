@@ -9,11 +9,14 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,7 +28,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ValidatorRole int32
 
@@ -101,9 +104,44 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{1}
 }
 
+type SigningRootRequest_ObjectType int32
+
+const (
+	SigningRootRequest_UNKNOWN             SigningRootRequest_ObjectType = 0
+	SigningRootRequest_ATTESTATION_DATA    SigningRootRequest_ObjectType = 1
+	SigningRootRequest_BEACON_BLOCK        SigningRootRequest_ObjectType = 2
+	SigningRootRequest_BEACON_BLOCK_HEADER SigningRootRequest_ObjectType = 3
+	SigningRootRequest_VOLUNTARY_EXIT      SigningRootRequest_ObjectType = 4
+)
+
+var SigningRootRequest_ObjectType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ATTESTATION_DATA",
+	2: "BEACON_BLOCK",
+	3: "BEACON_BLOCK_HEADER",
+	4: "VOLUNTARY_EXIT",
+}
+
+var SigningRootRequest_ObjectType_value = map[string]int32{
+	"UNKNOWN":             0,
+	"ATTESTATION_DATA":    1,
+	"BEACON_BLOCK":        2,
+	"BEACON_BLOCK_HEADER": 3,
+	"VOLUNTARY_EXIT":      4,
+}
+
+func (x SigningRootRequest_ObjectType) String() string {
+	return proto.EnumName(SigningRootRequest_ObjectType_name, int32(x))
+}
+
+func (SigningRootRequest_ObjectType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{5, 0}
+}
+
 type BlockRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	Graffiti             []byte   `protobuf:"bytes,3,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -123,7 +161,7 @@ func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -156,3594 +194,11303 @@ func (m *BlockRequest) GetRandaoReveal() []byte {
 	return nil
 }
 
-type ProposeResponse struct {
-	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+func (m *BlockRequest) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
+	}
+	return nil
+}
+
+type BlockRootRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProposeResponse) Reset()         { *m = ProposeResponse{} }
-func (m *ProposeResponse) String() string { return proto.CompactTextString(m) }
-func (*ProposeResponse) ProtoMessage()    {}
-func (*ProposeResponse) Descriptor() ([]byte, []int) {
+func (m *BlockRootRequest) Reset()         { *m = BlockRootRequest{} }
+func (m *BlockRootRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRootRequest) ProtoMessage()    {}
+func (*BlockRootRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{1}
 }
-func (m *ProposeResponse) XXX_Unmarshal(b []byte) error {
+func (m *BlockRootRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlockRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProposeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlockRootRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ProposeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProposeResponse.Merge(m, src)
+func (m *BlockRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRootRequest.Merge(m, src)
 }
-func (m *ProposeResponse) XXX_Size() int {
+func (m *BlockRootRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProposeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProposeResponse.DiscardUnknown(m)
+func (m *BlockRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRootRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProposeResponse proto.InternalMessageInfo
+var xxx_messageInfo_BlockRootRequest proto.InternalMessageInfo
 
-func (m *ProposeResponse) GetBlockRoot() []byte {
+func (m *BlockRootRequest) GetSlot() uint64 {
 	if m != nil {
-		return m.BlockRoot
+		return m.Slot
 	}
-	return nil
+	return 0
 }
 
-type AttestationRequest struct {
-	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	PocBit               []byte   `protobuf:"bytes,2,opt,name=poc_bit,json=pocBit,proto3" json:"poc_bit,omitempty"`
-	Slot                 uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
-	CommitteeIndex       uint64   `protobuf:"varint,4,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+type BlockRootResponse struct {
+	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AttestationRequest) Reset()         { *m = AttestationRequest{} }
-func (m *AttestationRequest) String() string { return proto.CompactTextString(m) }
-func (*AttestationRequest) ProtoMessage()    {}
-func (*AttestationRequest) Descriptor() ([]byte, []int) {
+func (m *BlockRootResponse) Reset()         { *m = BlockRootResponse{} }
+func (m *BlockRootResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockRootResponse) ProtoMessage()    {}
+func (*BlockRootResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{2}
 }
-func (m *AttestationRequest) XXX_Unmarshal(b []byte) error {
+func (m *BlockRootResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlockRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AttestationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlockRootResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AttestationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttestationRequest.Merge(m, src)
+func (m *BlockRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRootResponse.Merge(m, src)
 }
-func (m *AttestationRequest) XXX_Size() int {
+func (m *BlockRootResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AttestationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttestationRequest.DiscardUnknown(m)
+func (m *BlockRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRootResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AttestationRequest proto.InternalMessageInfo
-
-func (m *AttestationRequest) GetPublicKey() []byte {
-	if m != nil {
-		return m.PublicKey
-	}
-	return nil
-}
+var xxx_messageInfo_BlockRootResponse proto.InternalMessageInfo
 
-func (m *AttestationRequest) GetPocBit() []byte {
+func (m *BlockRootResponse) GetBlockRoot() []byte {
 	if m != nil {
-		return m.PocBit
+		return m.BlockRoot
 	}
 	return nil
 }
 
-func (m *AttestationRequest) GetSlot() uint64 {
-	if m != nil {
-		return m.Slot
-	}
-	return 0
-}
-
-func (m *AttestationRequest) GetCommitteeIndex() uint64 {
-	if m != nil {
-		return m.CommitteeIndex
-	}
-	return 0
-}
-
-type AttestResponse struct {
-	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BeaconBlockRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*BeaconBlockRequest_BlockRoot
+	//	*BeaconBlockRequest_Slot
+	QueryFilter          isBeaconBlockRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *AttestResponse) Reset()         { *m = AttestResponse{} }
-func (m *AttestResponse) String() string { return proto.CompactTextString(m) }
-func (*AttestResponse) ProtoMessage()    {}
-func (*AttestResponse) Descriptor() ([]byte, []int) {
+func (m *BeaconBlockRequest) Reset()         { *m = BeaconBlockRequest{} }
+func (m *BeaconBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*BeaconBlockRequest) ProtoMessage()    {}
+func (*BeaconBlockRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{3}
 }
-func (m *AttestResponse) XXX_Unmarshal(b []byte) error {
+func (m *BeaconBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AttestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BeaconBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AttestResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BeaconBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AttestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttestResponse.Merge(m, src)
+func (m *BeaconBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconBlockRequest.Merge(m, src)
 }
-func (m *AttestResponse) XXX_Size() int {
+func (m *BeaconBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AttestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttestResponse.DiscardUnknown(m)
+func (m *BeaconBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AttestResponse proto.InternalMessageInfo
+var xxx_messageInfo_BeaconBlockRequest proto.InternalMessageInfo
 
-func (m *AttestResponse) GetRoot() []byte {
+type isBeaconBlockRequest_QueryFilter interface {
+	isBeaconBlockRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BeaconBlockRequest_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3,oneof" json:"block_root,omitempty"`
+}
+type BeaconBlockRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,2,opt,name=slot,proto3,oneof" json:"slot,omitempty"`
+}
+
+func (*BeaconBlockRequest_BlockRoot) isBeaconBlockRequest_QueryFilter() {}
+func (*BeaconBlockRequest_Slot) isBeaconBlockRequest_QueryFilter()      {}
+
+func (m *BeaconBlockRequest) GetQueryFilter() isBeaconBlockRequest_QueryFilter {
 	if m != nil {
-		return m.Root
+		return m.QueryFilter
 	}
 	return nil
 }
 
-type AggregationRequest struct {
-	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
-	CommitteeIndex       uint64   `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
-	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	SlotSignature        []byte   `protobuf:"bytes,4,opt,name=slot_signature,json=slotSignature,proto3" json:"slot_signature,omitempty"`
+func (m *BeaconBlockRequest) GetBlockRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*BeaconBlockRequest_BlockRoot); ok {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockRequest) GetSlot() uint64 {
+	if x, ok := m.GetQueryFilter().(*BeaconBlockRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BeaconBlockRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BeaconBlockRequest_BlockRoot)(nil),
+		(*BeaconBlockRequest_Slot)(nil),
+	}
+}
+
+type BlocksByRangeRequest struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Step                 uint64   `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AggregationRequest) Reset()         { *m = AggregationRequest{} }
-func (m *AggregationRequest) String() string { return proto.CompactTextString(m) }
-func (*AggregationRequest) ProtoMessage()    {}
-func (*AggregationRequest) Descriptor() ([]byte, []int) {
+func (m *BlocksByRangeRequest) Reset()         { *m = BlocksByRangeRequest{} }
+func (m *BlocksByRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*BlocksByRangeRequest) ProtoMessage()    {}
+func (*BlocksByRangeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{4}
 }
-func (m *AggregationRequest) XXX_Unmarshal(b []byte) error {
+func (m *BlocksByRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AggregationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlocksByRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AggregationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlocksByRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AggregationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AggregationRequest.Merge(m, src)
+func (m *BlocksByRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksByRangeRequest.Merge(m, src)
 }
-func (m *AggregationRequest) XXX_Size() int {
+func (m *BlocksByRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AggregationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AggregationRequest.DiscardUnknown(m)
+func (m *BlocksByRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksByRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AggregationRequest proto.InternalMessageInfo
+var xxx_messageInfo_BlocksByRangeRequest proto.InternalMessageInfo
 
-func (m *AggregationRequest) GetSlot() uint64 {
+func (m *BlocksByRangeRequest) GetStartSlot() uint64 {
 	if m != nil {
-		return m.Slot
+		return m.StartSlot
 	}
 	return 0
 }
 
-func (m *AggregationRequest) GetCommitteeIndex() uint64 {
+func (m *BlocksByRangeRequest) GetCount() uint64 {
 	if m != nil {
-		return m.CommitteeIndex
+		return m.Count
 	}
 	return 0
 }
 
-func (m *AggregationRequest) GetPublicKey() []byte {
-	if m != nil {
-		return m.PublicKey
-	}
-	return nil
-}
-
-func (m *AggregationRequest) GetSlotSignature() []byte {
+func (m *BlocksByRangeRequest) GetStep() uint64 {
 	if m != nil {
-		return m.SlotSignature
+		return m.Step
 	}
-	return nil
+	return 0
 }
 
-type AggregationResponse struct {
-	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+type SigningRootRequest struct {
+	ObjectType SigningRootRequest_ObjectType `protobuf:"varint,1,opt,name=object_type,json=objectType,proto3,enum=ethereum.beacon.rpc.v1.SigningRootRequest_ObjectType" json:"object_type,omitempty"`
+	// The SSZ encoding of the object.
+	Object []byte `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	// The epoch the object is signed at, which determines the fork version of the domain.
+	Epoch                uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AggregationResponse) Reset()         { *m = AggregationResponse{} }
-func (m *AggregationResponse) String() string { return proto.CompactTextString(m) }
-func (*AggregationResponse) ProtoMessage()    {}
-func (*AggregationResponse) Descriptor() ([]byte, []int) {
+func (m *SigningRootRequest) Reset()         { *m = SigningRootRequest{} }
+func (m *SigningRootRequest) String() string { return proto.CompactTextString(m) }
+func (*SigningRootRequest) ProtoMessage()    {}
+func (*SigningRootRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{5}
 }
-func (m *AggregationResponse) XXX_Unmarshal(b []byte) error {
+func (m *SigningRootRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AggregationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SigningRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AggregationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SigningRootRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AggregationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AggregationResponse.Merge(m, src)
+func (m *SigningRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningRootRequest.Merge(m, src)
 }
-func (m *AggregationResponse) XXX_Size() int {
+func (m *SigningRootRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AggregationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AggregationResponse.DiscardUnknown(m)
+func (m *SigningRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningRootRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AggregationResponse proto.InternalMessageInfo
+var xxx_messageInfo_SigningRootRequest proto.InternalMessageInfo
 
-func (m *AggregationResponse) GetRoot() []byte {
+func (m *SigningRootRequest) GetObjectType() SigningRootRequest_ObjectType {
 	if m != nil {
-		return m.Root
+		return m.ObjectType
+	}
+	return SigningRootRequest_UNKNOWN
+}
+
+func (m *SigningRootRequest) GetObject() []byte {
+	if m != nil {
+		return m.Object
 	}
 	return nil
 }
 
-type ValidatorPerformanceRequest struct {
-	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
-	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+func (m *SigningRootRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type SigningRootResponse struct {
+	// The root of the object, signed as message along with the domain.
+	SigningRoot          []byte   `protobuf:"bytes,1,opt,name=signing_root,json=signingRoot,proto3" json:"signing_root,omitempty"`
+	SignatureDomain      uint64   `protobuf:"varint,2,opt,name=signature_domain,json=signatureDomain,proto3" json:"signature_domain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorPerformanceRequest) Reset()         { *m = ValidatorPerformanceRequest{} }
-func (m *ValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidatorPerformanceRequest) ProtoMessage()    {}
-func (*ValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+func (m *SigningRootResponse) Reset()         { *m = SigningRootResponse{} }
+func (m *SigningRootResponse) String() string { return proto.CompactTextString(m) }
+func (*SigningRootResponse) ProtoMessage()    {}
+func (*SigningRootResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{6}
 }
-func (m *ValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *SigningRootResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SigningRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorPerformanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SigningRootResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorPerformanceRequest.Merge(m, src)
+func (m *SigningRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningRootResponse.Merge(m, src)
 }
-func (m *ValidatorPerformanceRequest) XXX_Size() int {
+func (m *SigningRootResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorPerformanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorPerformanceRequest.DiscardUnknown(m)
+func (m *SigningRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningRootResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorPerformanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_SigningRootResponse proto.InternalMessageInfo
 
-func (m *ValidatorPerformanceRequest) GetSlot() uint64 {
+func (m *SigningRootResponse) GetSigningRoot() []byte {
 	if m != nil {
-		return m.Slot
+		return m.SigningRoot
 	}
-	return 0
+	return nil
 }
 
-func (m *ValidatorPerformanceRequest) GetPublicKeys() [][]byte {
+func (m *SigningRootResponse) GetSignatureDomain() uint64 {
 	if m != nil {
-		return m.PublicKeys
+		return m.SignatureDomain
 	}
-	return nil
+	return 0
 }
 
-type ValidatorPerformanceResponse struct {
-	Balances                      []uint64 `protobuf:"varint,1,rep,packed,name=balances,proto3" json:"balances,omitempty"`
-	TotalValidators               uint64   `protobuf:"varint,2,opt,name=total_validators,json=totalValidators,proto3" json:"total_validators,omitempty"`
-	TotalActiveValidators         uint64   `protobuf:"varint,3,opt,name=total_active_validators,json=totalActiveValidators,proto3" json:"total_active_validators,omitempty"`
-	MissingValidators             [][]byte `protobuf:"bytes,4,rep,name=missing_validators,json=missingValidators,proto3" json:"missing_validators,omitempty"`
-	AverageActiveValidatorBalance float32  `protobuf:"fixed32,5,opt,name=average_active_validator_balance,json=averageActiveValidatorBalance,proto3" json:"average_active_validator_balance,omitempty"`
-	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
-	XXX_unrecognized              []byte   `json:"-"`
-	XXX_sizecache                 int32    `json:"-"`
+type GenesisResponse struct {
+	GenesisTime            uint64   `protobuf:"varint,1,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	GenesisValidatorsRoot  []byte   `protobuf:"bytes,2,opt,name=genesis_validators_root,json=genesisValidatorsRoot,proto3" json:"genesis_validators_root,omitempty"`
+	DepositContractAddress []byte   `protobuf:"bytes,3,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func (m *ValidatorPerformanceResponse) Reset()         { *m = ValidatorPerformanceResponse{} }
-func (m *ValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorPerformanceResponse) ProtoMessage()    {}
-func (*ValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+func (m *GenesisResponse) Reset()         { *m = GenesisResponse{} }
+func (m *GenesisResponse) String() string { return proto.CompactTextString(m) }
+func (*GenesisResponse) ProtoMessage()    {}
+func (*GenesisResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{7}
 }
-func (m *ValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *GenesisResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GenesisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorPerformanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GenesisResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorPerformanceResponse.Merge(m, src)
+func (m *GenesisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisResponse.Merge(m, src)
 }
-func (m *ValidatorPerformanceResponse) XXX_Size() int {
+func (m *GenesisResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorPerformanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorPerformanceResponse.DiscardUnknown(m)
+func (m *GenesisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorPerformanceResponse proto.InternalMessageInfo
-
-func (m *ValidatorPerformanceResponse) GetBalances() []uint64 {
-	if m != nil {
-		return m.Balances
-	}
-	return nil
-}
+var xxx_messageInfo_GenesisResponse proto.InternalMessageInfo
 
-func (m *ValidatorPerformanceResponse) GetTotalValidators() uint64 {
+func (m *GenesisResponse) GetGenesisTime() uint64 {
 	if m != nil {
-		return m.TotalValidators
+		return m.GenesisTime
 	}
 	return 0
 }
 
-func (m *ValidatorPerformanceResponse) GetTotalActiveValidators() uint64 {
+func (m *GenesisResponse) GetGenesisValidatorsRoot() []byte {
 	if m != nil {
-		return m.TotalActiveValidators
+		return m.GenesisValidatorsRoot
 	}
-	return 0
+	return nil
 }
 
-func (m *ValidatorPerformanceResponse) GetMissingValidators() [][]byte {
+func (m *GenesisResponse) GetDepositContractAddress() []byte {
 	if m != nil {
-		return m.MissingValidators
+		return m.DepositContractAddress
 	}
 	return nil
 }
 
-func (m *ValidatorPerformanceResponse) GetAverageActiveValidatorBalance() float32 {
-	if m != nil {
-		return m.AverageActiveValidatorBalance
-	}
-	return 0
+type BeaconStateRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*BeaconStateRequest_StateRoot
+	//	*BeaconStateRequest_Slot
+	QueryFilter          isBeaconStateRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-type ValidatorActivationRequest struct {
-	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
+func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateRequest) ProtoMessage()    {}
+func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{8}
 }
-
-func (m *ValidatorActivationRequest) Reset()         { *m = ValidatorActivationRequest{} }
-func (m *ValidatorActivationRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidatorActivationRequest) ProtoMessage()    {}
-func (*ValidatorActivationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{8}
-}
-func (m *ValidatorActivationRequest) XXX_Unmarshal(b []byte) error {
+func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorActivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BeaconStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorActivationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BeaconStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorActivationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorActivationRequest.Merge(m, src)
+func (m *BeaconStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateRequest.Merge(m, src)
 }
-func (m *ValidatorActivationRequest) XXX_Size() int {
+func (m *BeaconStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorActivationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorActivationRequest.DiscardUnknown(m)
+func (m *BeaconStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorActivationRequest proto.InternalMessageInfo
-
-func (m *ValidatorActivationRequest) GetPublicKeys() [][]byte {
-	if m != nil {
-		return m.PublicKeys
-	}
-	return nil
-}
+var xxx_messageInfo_BeaconStateRequest proto.InternalMessageInfo
 
-type ValidatorActivationResponse struct {
-	ActivatedPublicKeys  [][]byte                              `protobuf:"bytes,1,rep,name=activated_public_keys,json=activatedPublicKeys,proto3" json:"activated_public_keys,omitempty"` // Deprecated: Do not use.
-	Statuses             []*ValidatorActivationResponse_Status `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+type isBeaconStateRequest_QueryFilter interface {
+	isBeaconStateRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *ValidatorActivationResponse) Reset()         { *m = ValidatorActivationResponse{} }
-func (m *ValidatorActivationResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorActivationResponse) ProtoMessage()    {}
-func (*ValidatorActivationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{9}
-}
-func (m *ValidatorActivationResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ValidatorActivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ValidatorActivationResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ValidatorActivationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorActivationResponse.Merge(m, src)
+type BeaconStateRequest_StateRoot struct {
+	StateRoot []byte `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3,oneof" json:"state_root,omitempty"`
 }
-func (m *ValidatorActivationResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *ValidatorActivationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorActivationResponse.DiscardUnknown(m)
+type BeaconStateRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,2,opt,name=slot,proto3,oneof" json:"slot,omitempty"`
 }
 
-var xxx_messageInfo_ValidatorActivationResponse proto.InternalMessageInfo
+func (*BeaconStateRequest_StateRoot) isBeaconStateRequest_QueryFilter() {}
+func (*BeaconStateRequest_Slot) isBeaconStateRequest_QueryFilter()      {}
 
-// Deprecated: Do not use.
-func (m *ValidatorActivationResponse) GetActivatedPublicKeys() [][]byte {
+func (m *BeaconStateRequest) GetQueryFilter() isBeaconStateRequest_QueryFilter {
 	if m != nil {
-		return m.ActivatedPublicKeys
+		return m.QueryFilter
 	}
 	return nil
 }
 
-func (m *ValidatorActivationResponse) GetStatuses() []*ValidatorActivationResponse_Status {
-	if m != nil {
-		return m.Statuses
+func (m *BeaconStateRequest) GetStateRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_StateRoot); ok {
+		return x.StateRoot
 	}
 	return nil
 }
 
-type ValidatorActivationResponse_Status struct {
-	PublicKey            []byte                   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Status               *ValidatorStatusResponse `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *BeaconStateRequest) GetSlot() uint64 {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
 }
 
-func (m *ValidatorActivationResponse_Status) Reset()         { *m = ValidatorActivationResponse_Status{} }
-func (m *ValidatorActivationResponse_Status) String() string { return proto.CompactTextString(m) }
-func (*ValidatorActivationResponse_Status) ProtoMessage()    {}
-func (*ValidatorActivationResponse_Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{9, 0}
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BeaconStateRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BeaconStateRequest_StateRoot)(nil),
+		(*BeaconStateRequest_Slot)(nil),
+	}
 }
-func (m *ValidatorActivationResponse_Status) XXX_Unmarshal(b []byte) error {
+
+type BeaconStateChunk struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Offset               uint64   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	TotalSize            uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconStateChunk) Reset()         { *m = BeaconStateChunk{} }
+func (m *BeaconStateChunk) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateChunk) ProtoMessage()    {}
+func (*BeaconStateChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{9}
+}
+func (m *BeaconStateChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorActivationResponse_Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BeaconStateChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorActivationResponse_Status.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BeaconStateChunk.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorActivationResponse_Status) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorActivationResponse_Status.Merge(m, src)
+func (m *BeaconStateChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateChunk.Merge(m, src)
 }
-func (m *ValidatorActivationResponse_Status) XXX_Size() int {
+func (m *BeaconStateChunk) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorActivationResponse_Status) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorActivationResponse_Status.DiscardUnknown(m)
+func (m *BeaconStateChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateChunk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorActivationResponse_Status proto.InternalMessageInfo
+var xxx_messageInfo_BeaconStateChunk proto.InternalMessageInfo
 
-func (m *ValidatorActivationResponse_Status) GetPublicKey() []byte {
+func (m *BeaconStateChunk) GetData() []byte {
 	if m != nil {
-		return m.PublicKey
+		return m.Data
 	}
 	return nil
 }
 
-func (m *ValidatorActivationResponse_Status) GetStatus() *ValidatorStatusResponse {
+func (m *BeaconStateChunk) GetOffset() uint64 {
 	if m != nil {
-		return m.Status
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-type ExitedValidatorsRequest struct {
-	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+func (m *BeaconStateChunk) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type DepositProofRequest struct {
+	DepositIndex         uint64   `protobuf:"varint,1,opt,name=deposit_index,json=depositIndex,proto3" json:"deposit_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExitedValidatorsRequest) Reset()         { *m = ExitedValidatorsRequest{} }
-func (m *ExitedValidatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*ExitedValidatorsRequest) ProtoMessage()    {}
-func (*ExitedValidatorsRequest) Descriptor() ([]byte, []int) {
+func (m *DepositProofRequest) Reset()         { *m = DepositProofRequest{} }
+func (m *DepositProofRequest) String() string { return proto.CompactTextString(m) }
+func (*DepositProofRequest) ProtoMessage()    {}
+func (*DepositProofRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{10}
 }
-func (m *ExitedValidatorsRequest) XXX_Unmarshal(b []byte) error {
+func (m *DepositProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExitedValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DepositProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExitedValidatorsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DepositProofRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ExitedValidatorsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExitedValidatorsRequest.Merge(m, src)
+func (m *DepositProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositProofRequest.Merge(m, src)
 }
-func (m *ExitedValidatorsRequest) XXX_Size() int {
+func (m *DepositProofRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExitedValidatorsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExitedValidatorsRequest.DiscardUnknown(m)
+func (m *DepositProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExitedValidatorsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DepositProofRequest proto.InternalMessageInfo
 
-func (m *ExitedValidatorsRequest) GetPublicKeys() [][]byte {
+func (m *DepositProofRequest) GetDepositIndex() uint64 {
 	if m != nil {
-		return m.PublicKeys
+		return m.DepositIndex
 	}
-	return nil
+	return 0
 }
 
-type ExitedValidatorsResponse struct {
-	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+type DepositProofResponse struct {
+	Leaf                 []byte   `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Proof                [][]byte `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
+	DepositRoot          []byte   `protobuf:"bytes,3,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,4,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExitedValidatorsResponse) Reset()         { *m = ExitedValidatorsResponse{} }
-func (m *ExitedValidatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*ExitedValidatorsResponse) ProtoMessage()    {}
-func (*ExitedValidatorsResponse) Descriptor() ([]byte, []int) {
+func (m *DepositProofResponse) Reset()         { *m = DepositProofResponse{} }
+func (m *DepositProofResponse) String() string { return proto.CompactTextString(m) }
+func (*DepositProofResponse) ProtoMessage()    {}
+func (*DepositProofResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{11}
 }
-func (m *ExitedValidatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *DepositProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExitedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DepositProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExitedValidatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DepositProofResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ExitedValidatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExitedValidatorsResponse.Merge(m, src)
+func (m *DepositProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositProofResponse.Merge(m, src)
 }
-func (m *ExitedValidatorsResponse) XXX_Size() int {
+func (m *DepositProofResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExitedValidatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExitedValidatorsResponse.DiscardUnknown(m)
+func (m *DepositProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExitedValidatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DepositProofResponse proto.InternalMessageInfo
 
-func (m *ExitedValidatorsResponse) GetPublicKeys() [][]byte {
+func (m *DepositProofResponse) GetLeaf() []byte {
 	if m != nil {
-		return m.PublicKeys
+		return m.Leaf
 	}
 	return nil
 }
 
-type ChainStartResponse struct {
-	Started              bool     `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
-	GenesisTime          uint64   `protobuf:"varint,2,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+func (m *DepositProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *DepositProofResponse) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+func (m *DepositProofResponse) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+type IndividualVotesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChainStartResponse) Reset()         { *m = ChainStartResponse{} }
-func (m *ChainStartResponse) String() string { return proto.CompactTextString(m) }
-func (*ChainStartResponse) ProtoMessage()    {}
-func (*ChainStartResponse) Descriptor() ([]byte, []int) {
+func (m *IndividualVotesRequest) Reset()         { *m = IndividualVotesRequest{} }
+func (m *IndividualVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*IndividualVotesRequest) ProtoMessage()    {}
+func (*IndividualVotesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{12}
 }
-func (m *ChainStartResponse) XXX_Unmarshal(b []byte) error {
+func (m *IndividualVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChainStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IndividualVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChainStartResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IndividualVotesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ChainStartResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChainStartResponse.Merge(m, src)
+func (m *IndividualVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndividualVotesRequest.Merge(m, src)
 }
-func (m *ChainStartResponse) XXX_Size() int {
+func (m *IndividualVotesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChainStartResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChainStartResponse.DiscardUnknown(m)
+func (m *IndividualVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndividualVotesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChainStartResponse proto.InternalMessageInfo
+var xxx_messageInfo_IndividualVotesRequest proto.InternalMessageInfo
 
-func (m *ChainStartResponse) GetStarted() bool {
+func (m *IndividualVotesRequest) GetEpoch() uint64 {
 	if m != nil {
-		return m.Started
+		return m.Epoch
 	}
-	return false
+	return 0
 }
 
-func (m *ChainStartResponse) GetGenesisTime() uint64 {
+func (m *IndividualVotesRequest) GetIndices() []uint64 {
 	if m != nil {
-		return m.GenesisTime
+		return m.Indices
 	}
-	return 0
+	return nil
 }
 
-type ValidatorIndexRequest struct {
-	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+type IndividualVote struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	IsActiveInEpoch      bool     `protobuf:"varint,2,opt,name=is_active_in_epoch,json=isActiveInEpoch,proto3" json:"is_active_in_epoch,omitempty"`
+	IsSlashed            bool     `protobuf:"varint,3,opt,name=is_slashed,json=isSlashed,proto3" json:"is_slashed,omitempty"`
+	VotedSource          bool     `protobuf:"varint,4,opt,name=voted_source,json=votedSource,proto3" json:"voted_source,omitempty"`
+	VotedTarget          bool     `protobuf:"varint,5,opt,name=voted_target,json=votedTarget,proto3" json:"voted_target,omitempty"`
+	VotedHead            bool     `protobuf:"varint,6,opt,name=voted_head,json=votedHead,proto3" json:"voted_head,omitempty"`
+	EffectiveBalance     uint64   `protobuf:"varint,7,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	InclusionSlot        uint64   `protobuf:"varint,8,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDistance    uint64   `protobuf:"varint,9,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorIndexRequest) Reset()         { *m = ValidatorIndexRequest{} }
-func (m *ValidatorIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidatorIndexRequest) ProtoMessage()    {}
-func (*ValidatorIndexRequest) Descriptor() ([]byte, []int) {
+func (m *IndividualVote) Reset()         { *m = IndividualVote{} }
+func (m *IndividualVote) String() string { return proto.CompactTextString(m) }
+func (*IndividualVote) ProtoMessage()    {}
+func (*IndividualVote) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{13}
 }
-func (m *ValidatorIndexRequest) XXX_Unmarshal(b []byte) error {
+func (m *IndividualVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IndividualVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorIndexRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IndividualVote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorIndexRequest.Merge(m, src)
+func (m *IndividualVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndividualVote.Merge(m, src)
 }
-func (m *ValidatorIndexRequest) XXX_Size() int {
+func (m *IndividualVote) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorIndexRequest.DiscardUnknown(m)
+func (m *IndividualVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndividualVote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_IndividualVote proto.InternalMessageInfo
 
-func (m *ValidatorIndexRequest) GetPublicKey() []byte {
+func (m *IndividualVote) GetValidatorIndex() uint64 {
 	if m != nil {
-		return m.PublicKey
+		return m.ValidatorIndex
 	}
-	return nil
+	return 0
 }
 
-type ValidatorIndexResponse struct {
-	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *IndividualVote) GetIsActiveInEpoch() bool {
+	if m != nil {
+		return m.IsActiveInEpoch
+	}
+	return false
 }
 
-func (m *ValidatorIndexResponse) Reset()         { *m = ValidatorIndexResponse{} }
-func (m *ValidatorIndexResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorIndexResponse) ProtoMessage()    {}
-func (*ValidatorIndexResponse) Descriptor() ([]byte, []int) {
+func (m *IndividualVote) GetIsSlashed() bool {
+	if m != nil {
+		return m.IsSlashed
+	}
+	return false
+}
+
+func (m *IndividualVote) GetVotedSource() bool {
+	if m != nil {
+		return m.VotedSource
+	}
+	return false
+}
+
+func (m *IndividualVote) GetVotedTarget() bool {
+	if m != nil {
+		return m.VotedTarget
+	}
+	return false
+}
+
+func (m *IndividualVote) GetVotedHead() bool {
+	if m != nil {
+		return m.VotedHead
+	}
+	return false
+}
+
+func (m *IndividualVote) GetEffectiveBalance() uint64 {
+	if m != nil {
+		return m.EffectiveBalance
+	}
+	return 0
+}
+
+func (m *IndividualVote) GetInclusionSlot() uint64 {
+	if m != nil {
+		return m.InclusionSlot
+	}
+	return 0
+}
+
+func (m *IndividualVote) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+type IndividualVotesResponse struct {
+	Epoch                uint64            `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Votes                []*IndividualVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *IndividualVotesResponse) Reset()         { *m = IndividualVotesResponse{} }
+func (m *IndividualVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*IndividualVotesResponse) ProtoMessage()    {}
+func (*IndividualVotesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{14}
 }
-func (m *ValidatorIndexResponse) XXX_Unmarshal(b []byte) error {
+func (m *IndividualVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IndividualVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorIndexResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IndividualVotesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorIndexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorIndexResponse.Merge(m, src)
+func (m *IndividualVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndividualVotesResponse.Merge(m, src)
 }
-func (m *ValidatorIndexResponse) XXX_Size() int {
+func (m *IndividualVotesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorIndexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorIndexResponse.DiscardUnknown(m)
+func (m *IndividualVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndividualVotesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorIndexResponse proto.InternalMessageInfo
+var xxx_messageInfo_IndividualVotesResponse proto.InternalMessageInfo
 
-func (m *ValidatorIndexResponse) GetIndex() uint64 {
+func (m *IndividualVotesResponse) GetEpoch() uint64 {
 	if m != nil {
-		return m.Index
+		return m.Epoch
 	}
 	return 0
 }
 
-type AssignmentRequest struct {
-	EpochStart           uint64   `protobuf:"varint,1,opt,name=epoch_start,json=epochStart,proto3" json:"epoch_start,omitempty"`
-	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+func (m *IndividualVotesResponse) GetVotes() []*IndividualVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+type ProposeResponse struct {
+	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AssignmentRequest) Reset()         { *m = AssignmentRequest{} }
-func (m *AssignmentRequest) String() string { return proto.CompactTextString(m) }
-func (*AssignmentRequest) ProtoMessage()    {}
-func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+func (m *ProposeResponse) Reset()         { *m = ProposeResponse{} }
+func (m *ProposeResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposeResponse) ProtoMessage()    {}
+func (*ProposeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{15}
 }
-func (m *AssignmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *ProposeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AssignmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AssignmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AssignmentRequest.Merge(m, src)
+func (m *ProposeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeResponse.Merge(m, src)
 }
-func (m *AssignmentRequest) XXX_Size() int {
+func (m *ProposeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AssignmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AssignmentRequest.DiscardUnknown(m)
+func (m *ProposeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AssignmentRequest proto.InternalMessageInfo
-
-func (m *AssignmentRequest) GetEpochStart() uint64 {
-	if m != nil {
-		return m.EpochStart
-	}
-	return 0
-}
+var xxx_messageInfo_ProposeResponse proto.InternalMessageInfo
 
-func (m *AssignmentRequest) GetPublicKeys() [][]byte {
+func (m *ProposeResponse) GetBlockRoot() []byte {
 	if m != nil {
-		return m.PublicKeys
+		return m.BlockRoot
 	}
 	return nil
 }
 
-type AssignmentResponse struct {
-	ValidatorAssignment  []*AssignmentResponse_ValidatorAssignment `protobuf:"bytes,1,rep,name=validator_assignment,json=validatorAssignment,proto3" json:"validator_assignment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
-	XXX_unrecognized     []byte                                    `json:"-"`
-	XXX_sizecache        int32                                     `json:"-"`
+type StateRootResponse struct {
+	StateRoot            []byte   `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AssignmentResponse) Reset()         { *m = AssignmentResponse{} }
-func (m *AssignmentResponse) String() string { return proto.CompactTextString(m) }
-func (*AssignmentResponse) ProtoMessage()    {}
-func (*AssignmentResponse) Descriptor() ([]byte, []int) {
+func (m *StateRootResponse) Reset()         { *m = StateRootResponse{} }
+func (m *StateRootResponse) String() string { return proto.CompactTextString(m) }
+func (*StateRootResponse) ProtoMessage()    {}
+func (*StateRootResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{16}
 }
-func (m *AssignmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *StateRootResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StateRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AssignmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StateRootResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AssignmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AssignmentResponse.Merge(m, src)
+func (m *StateRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateRootResponse.Merge(m, src)
 }
-func (m *AssignmentResponse) XXX_Size() int {
+func (m *StateRootResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AssignmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AssignmentResponse.DiscardUnknown(m)
+func (m *StateRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateRootResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AssignmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_StateRootResponse proto.InternalMessageInfo
 
-func (m *AssignmentResponse) GetValidatorAssignment() []*AssignmentResponse_ValidatorAssignment {
+func (m *StateRootResponse) GetStateRoot() []byte {
 	if m != nil {
-		return m.ValidatorAssignment
+		return m.StateRoot
 	}
 	return nil
 }
 
-type AssignmentResponse_ValidatorAssignment struct {
-	Committee            []uint64        `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
-	CommitteeIndex       uint64          `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
-	AttesterSlot         uint64          `protobuf:"varint,3,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
-	ProposerSlot         uint64          `protobuf:"varint,4,opt,name=proposer_slot,json=proposerSlot,proto3" json:"proposer_slot,omitempty"`
-	PublicKey            []byte          `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Status               ValidatorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ReconstructFullBlockRequest struct {
+	// The blinded block, a signed block header committing to the block body by its root.
+	BlindedBlock *v1alpha1.SignedBeaconBlockHeader `protobuf:"bytes,1,opt,name=blinded_block,json=blindedBlock,proto3" json:"blinded_block,omitempty"`
+	// The block body the blinded block commits to, which carries the payload of the block.
+	Body                 *v1alpha1.BeaconBlockBody `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Reset() {
-	*m = AssignmentResponse_ValidatorAssignment{}
-}
-func (m *AssignmentResponse_ValidatorAssignment) String() string { return proto.CompactTextString(m) }
-func (*AssignmentResponse_ValidatorAssignment) ProtoMessage()    {}
-func (*AssignmentResponse_ValidatorAssignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{16, 0}
+func (m *ReconstructFullBlockRequest) Reset()         { *m = ReconstructFullBlockRequest{} }
+func (m *ReconstructFullBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconstructFullBlockRequest) ProtoMessage()    {}
+func (*ReconstructFullBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{17}
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_Unmarshal(b []byte) error {
+func (m *ReconstructFullBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReconstructFullBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AssignmentResponse_ValidatorAssignment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReconstructFullBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AssignmentResponse_ValidatorAssignment.Merge(m, src)
+func (m *ReconstructFullBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconstructFullBlockRequest.Merge(m, src)
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_Size() int {
+func (m *ReconstructFullBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_DiscardUnknown() {
-	xxx_messageInfo_AssignmentResponse_ValidatorAssignment.DiscardUnknown(m)
+func (m *ReconstructFullBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconstructFullBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AssignmentResponse_ValidatorAssignment proto.InternalMessageInfo
+var xxx_messageInfo_ReconstructFullBlockRequest proto.InternalMessageInfo
 
-func (m *AssignmentResponse_ValidatorAssignment) GetCommittee() []uint64 {
+func (m *ReconstructFullBlockRequest) GetBlindedBlock() *v1alpha1.SignedBeaconBlockHeader {
 	if m != nil {
-		return m.Committee
+		return m.BlindedBlock
 	}
 	return nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) GetCommitteeIndex() uint64 {
-	if m != nil {
-		return m.CommitteeIndex
-	}
-	return 0
-}
-
-func (m *AssignmentResponse_ValidatorAssignment) GetAttesterSlot() uint64 {
-	if m != nil {
-		return m.AttesterSlot
-	}
-	return 0
-}
-
-func (m *AssignmentResponse_ValidatorAssignment) GetProposerSlot() uint64 {
-	if m != nil {
-		return m.ProposerSlot
-	}
-	return 0
-}
-
-func (m *AssignmentResponse_ValidatorAssignment) GetPublicKey() []byte {
+func (m *ReconstructFullBlockRequest) GetBody() *v1alpha1.BeaconBlockBody {
 	if m != nil {
-		return m.PublicKey
+		return m.Body
 	}
 	return nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) GetStatus() ValidatorStatus {
-	if m != nil {
-		return m.Status
-	}
-	return ValidatorStatus_UNKNOWN_STATUS
-}
-
-type ValidatorStatusResponse struct {
-	Status                    ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	Eth1DepositBlockNumber    uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
-	DepositInclusionSlot      uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
-	ActivationEpoch           uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
-	PositionInActivationQueue uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
-	XXX_unrecognized          []byte          `json:"-"`
-	XXX_sizecache             int32           `json:"-"`
+type AttestationRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	PocBit               []byte   `protobuf:"bytes,2,opt,name=poc_bit,json=pocBit,proto3" json:"poc_bit,omitempty"`
+	Slot                 uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,4,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
-func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorStatusResponse) ProtoMessage()    {}
-func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{17}
+func (m *AttestationRequest) Reset()         { *m = AttestationRequest{} }
+func (m *AttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationRequest) ProtoMessage()    {}
+func (*AttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{18}
 }
-func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *AttestationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttestationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorStatusResponse.Merge(m, src)
+func (m *AttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationRequest.Merge(m, src)
 }
-func (m *ValidatorStatusResponse) XXX_Size() int {
+func (m *AttestationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorStatusResponse.DiscardUnknown(m)
+func (m *AttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorStatusResponse proto.InternalMessageInfo
-
-func (m *ValidatorStatusResponse) GetStatus() ValidatorStatus {
-	if m != nil {
-		return m.Status
-	}
-	return ValidatorStatus_UNKNOWN_STATUS
-}
+var xxx_messageInfo_AttestationRequest proto.InternalMessageInfo
 
-func (m *ValidatorStatusResponse) GetEth1DepositBlockNumber() uint64 {
+func (m *AttestationRequest) GetPublicKey() []byte {
 	if m != nil {
-		return m.Eth1DepositBlockNumber
+		return m.PublicKey
 	}
-	return 0
+	return nil
 }
 
-func (m *ValidatorStatusResponse) GetDepositInclusionSlot() uint64 {
+func (m *AttestationRequest) GetPocBit() []byte {
 	if m != nil {
-		return m.DepositInclusionSlot
+		return m.PocBit
 	}
-	return 0
+	return nil
 }
 
-func (m *ValidatorStatusResponse) GetActivationEpoch() uint64 {
+func (m *AttestationRequest) GetSlot() uint64 {
 	if m != nil {
-		return m.ActivationEpoch
+		return m.Slot
 	}
 	return 0
 }
 
-func (m *ValidatorStatusResponse) GetPositionInActivationQueue() uint64 {
+func (m *AttestationRequest) GetCommitteeIndex() uint64 {
 	if m != nil {
-		return m.PositionInActivationQueue
+		return m.CommitteeIndex
 	}
 	return 0
 }
 
-type DomainRequest struct {
-	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Domain               []byte   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+type AttestResponse struct {
+	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DomainRequest) Reset()         { *m = DomainRequest{} }
-func (m *DomainRequest) String() string { return proto.CompactTextString(m) }
-func (*DomainRequest) ProtoMessage()    {}
-func (*DomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{18}
+func (m *AttestResponse) Reset()         { *m = AttestResponse{} }
+func (m *AttestResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestResponse) ProtoMessage()    {}
+func (*AttestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{19}
 }
-func (m *DomainRequest) XXX_Unmarshal(b []byte) error {
+func (m *AttestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DomainRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttestResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *DomainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DomainRequest.Merge(m, src)
+func (m *AttestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestResponse.Merge(m, src)
 }
-func (m *DomainRequest) XXX_Size() int {
+func (m *AttestResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DomainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DomainRequest.DiscardUnknown(m)
+func (m *AttestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DomainRequest proto.InternalMessageInfo
-
-func (m *DomainRequest) GetEpoch() uint64 {
-	if m != nil {
-		return m.Epoch
-	}
-	return 0
-}
+var xxx_messageInfo_AttestResponse proto.InternalMessageInfo
 
-func (m *DomainRequest) GetDomain() []byte {
+func (m *AttestResponse) GetRoot() []byte {
 	if m != nil {
-		return m.Domain
+		return m.Root
 	}
 	return nil
 }
 
-type DomainResponse struct {
-	SignatureDomain      uint64   `protobuf:"varint,1,opt,name=signature_domain,json=signatureDomain,proto3" json:"signature_domain,omitempty"`
+type AggregationRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	SlotSignature        []byte   `protobuf:"bytes,4,opt,name=slot_signature,json=slotSignature,proto3" json:"slot_signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DomainResponse) Reset()         { *m = DomainResponse{} }
-func (m *DomainResponse) String() string { return proto.CompactTextString(m) }
-func (*DomainResponse) ProtoMessage()    {}
-func (*DomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{19}
+func (m *AggregationRequest) Reset()         { *m = AggregationRequest{} }
+func (m *AggregationRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregationRequest) ProtoMessage()    {}
+func (*AggregationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{20}
 }
-func (m *DomainResponse) XXX_Unmarshal(b []byte) error {
+func (m *AggregationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AggregationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DomainResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AggregationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *DomainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DomainResponse.Merge(m, src)
+func (m *AggregationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationRequest.Merge(m, src)
 }
-func (m *DomainResponse) XXX_Size() int {
+func (m *AggregationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DomainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DomainResponse.DiscardUnknown(m)
+func (m *AggregationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DomainResponse proto.InternalMessageInfo
+var xxx_messageInfo_AggregationRequest proto.InternalMessageInfo
 
-func (m *DomainResponse) GetSignatureDomain() uint64 {
+func (m *AggregationRequest) GetSlot() uint64 {
 	if m != nil {
-		return m.SignatureDomain
+		return m.Slot
 	}
 	return 0
 }
 
-type BlockTreeResponse struct {
-	Tree                 []*BlockTreeResponse_TreeNode `protobuf:"bytes,1,rep,name=tree,proto3" json:"tree,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+func (m *AggregationRequest) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
 }
 
-func (m *BlockTreeResponse) Reset()         { *m = BlockTreeResponse{} }
-func (m *BlockTreeResponse) String() string { return proto.CompactTextString(m) }
-func (*BlockTreeResponse) ProtoMessage()    {}
-func (*BlockTreeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{20}
+func (m *AggregationRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
 }
-func (m *BlockTreeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *AggregationRequest) GetSlotSignature() []byte {
+	if m != nil {
+		return m.SlotSignature
+	}
+	return nil
+}
+
+type AggregationResponse struct {
+	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregationResponse) Reset()         { *m = AggregationResponse{} }
+func (m *AggregationResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregationResponse) ProtoMessage()    {}
+func (*AggregationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21}
+}
+func (m *AggregationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AggregationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockTreeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AggregationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *BlockTreeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockTreeResponse.Merge(m, src)
+func (m *AggregationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationResponse.Merge(m, src)
 }
-func (m *BlockTreeResponse) XXX_Size() int {
+func (m *AggregationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockTreeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockTreeResponse.DiscardUnknown(m)
+func (m *AggregationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockTreeResponse proto.InternalMessageInfo
+var xxx_messageInfo_AggregationResponse proto.InternalMessageInfo
 
-func (m *BlockTreeResponse) GetTree() []*BlockTreeResponse_TreeNode {
+func (m *AggregationResponse) GetRoot() []byte {
 	if m != nil {
-		return m.Tree
+		return m.Root
 	}
 	return nil
 }
 
-type BlockTreeResponse_TreeNode struct {
-	Block                *v1alpha1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	BlockRoot            []byte                `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	ParticipatedVotes    uint64                `protobuf:"varint,3,opt,name=participated_votes,json=participatedVotes,proto3" json:"participated_votes,omitempty"`
-	TotalVotes           uint64                `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type AggregateSelectionResponse struct {
+	AggregateAndProof    *v1alpha1.AggregateAttestationAndProof `protobuf:"bytes,1,opt,name=aggregate_and_proof,json=aggregateAndProof,proto3" json:"aggregate_and_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
 
-func (m *BlockTreeResponse_TreeNode) Reset()         { *m = BlockTreeResponse_TreeNode{} }
-func (m *BlockTreeResponse_TreeNode) String() string { return proto.CompactTextString(m) }
-func (*BlockTreeResponse_TreeNode) ProtoMessage()    {}
-func (*BlockTreeResponse_TreeNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{20, 0}
+func (m *AggregateSelectionResponse) Reset()         { *m = AggregateSelectionResponse{} }
+func (m *AggregateSelectionResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregateSelectionResponse) ProtoMessage()    {}
+func (*AggregateSelectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
 }
-func (m *BlockTreeResponse_TreeNode) XXX_Unmarshal(b []byte) error {
+func (m *AggregateSelectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockTreeResponse_TreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AggregateSelectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockTreeResponse_TreeNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AggregateSelectionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *BlockTreeResponse_TreeNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockTreeResponse_TreeNode.Merge(m, src)
+func (m *AggregateSelectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateSelectionResponse.Merge(m, src)
 }
-func (m *BlockTreeResponse_TreeNode) XXX_Size() int {
+func (m *AggregateSelectionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockTreeResponse_TreeNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockTreeResponse_TreeNode.DiscardUnknown(m)
+func (m *AggregateSelectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateSelectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockTreeResponse_TreeNode proto.InternalMessageInfo
+var xxx_messageInfo_AggregateSelectionResponse proto.InternalMessageInfo
 
-func (m *BlockTreeResponse_TreeNode) GetBlock() *v1alpha1.BeaconBlock {
+func (m *AggregateSelectionResponse) GetAggregateAndProof() *v1alpha1.AggregateAttestationAndProof {
 	if m != nil {
-		return m.Block
+		return m.AggregateAndProof
 	}
 	return nil
 }
 
-func (m *BlockTreeResponse_TreeNode) GetBlockRoot() []byte {
-	if m != nil {
-		return m.BlockRoot
+type ValidatorPerformanceRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorPerformanceRequest) Reset()         { *m = ValidatorPerformanceRequest{} }
+func (m *ValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceRequest) ProtoMessage()    {}
+func (*ValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+func (m *ValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *ValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceRequest.DiscardUnknown(m)
 }
 
-func (m *BlockTreeResponse_TreeNode) GetParticipatedVotes() uint64 {
+var xxx_messageInfo_ValidatorPerformanceRequest proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceRequest) GetSlot() uint64 {
 	if m != nil {
-		return m.ParticipatedVotes
+		return m.Slot
 	}
 	return 0
 }
 
-func (m *BlockTreeResponse_TreeNode) GetTotalVotes() uint64 {
+func (m *ValidatorPerformanceRequest) GetPublicKeys() [][]byte {
 	if m != nil {
-		return m.TotalVotes
+		return m.PublicKeys
 	}
-	return 0
+	return nil
 }
 
-type TreeBlockSlotRequest struct {
-	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
-	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ValidatorPerformanceResponse struct {
+	Balances                      []uint64 `protobuf:"varint,1,rep,packed,name=balances,proto3" json:"balances,omitempty"`
+	TotalValidators               uint64   `protobuf:"varint,2,opt,name=total_validators,json=totalValidators,proto3" json:"total_validators,omitempty"`
+	TotalActiveValidators         uint64   `protobuf:"varint,3,opt,name=total_active_validators,json=totalActiveValidators,proto3" json:"total_active_validators,omitempty"`
+	MissingValidators             [][]byte `protobuf:"bytes,4,rep,name=missing_validators,json=missingValidators,proto3" json:"missing_validators,omitempty"`
+	AverageActiveValidatorBalance float32  `protobuf:"fixed32,5,opt,name=average_active_validator_balance,json=averageActiveValidatorBalance,proto3" json:"average_active_validator_balance,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_unrecognized              []byte   `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
 }
 
-func (m *TreeBlockSlotRequest) Reset()         { *m = TreeBlockSlotRequest{} }
-func (m *TreeBlockSlotRequest) String() string { return proto.CompactTextString(m) }
-func (*TreeBlockSlotRequest) ProtoMessage()    {}
-func (*TreeBlockSlotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{21}
+func (m *ValidatorPerformanceResponse) Reset()         { *m = ValidatorPerformanceResponse{} }
+func (m *ValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceResponse) ProtoMessage()    {}
+func (*ValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
 }
-func (m *TreeBlockSlotRequest) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TreeBlockSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TreeBlockSlotRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorPerformanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *TreeBlockSlotRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TreeBlockSlotRequest.Merge(m, src)
+func (m *ValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceResponse.Merge(m, src)
 }
-func (m *TreeBlockSlotRequest) XXX_Size() int {
+func (m *ValidatorPerformanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TreeBlockSlotRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TreeBlockSlotRequest.DiscardUnknown(m)
+func (m *ValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TreeBlockSlotRequest proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorPerformanceResponse proto.InternalMessageInfo
 
-func (m *TreeBlockSlotRequest) GetSlotFrom() uint64 {
+func (m *ValidatorPerformanceResponse) GetBalances() []uint64 {
 	if m != nil {
-		return m.SlotFrom
+		return m.Balances
 	}
-	return 0
+	return nil
 }
 
-func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
+func (m *ValidatorPerformanceResponse) GetTotalValidators() uint64 {
 	if m != nil {
-		return m.SlotTo
+		return m.TotalValidators
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
-	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
-	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
-	proto.RegisterType((*AggregationRequest)(nil), "ethereum.beacon.rpc.v1.AggregationRequest")
-	proto.RegisterType((*AggregationResponse)(nil), "ethereum.beacon.rpc.v1.AggregationResponse")
-	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
-	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
-	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
-	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
-	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
-	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
-	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
-	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
-	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
-	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
-	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
-	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
-	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
-	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
-	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
-	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
-	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
-	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
-	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+func (m *ValidatorPerformanceResponse) GetTotalActiveValidators() uint64 {
+	if m != nil {
+		return m.TotalActiveValidators
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
+func (m *ValidatorPerformanceResponse) GetMissingValidators() [][]byte {
+	if m != nil {
+		return m.MissingValidators
+	}
+	return nil
+}
 
-var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xdb, 0x46,
-	0x16, 0x0f, 0x65, 0x59, 0x76, 0x9e, 0x64, 0x99, 0x1e, 0x3b, 0xb6, 0xa2, 0x24, 0x8e, 0x97, 0x49,
-	0x36, 0xb6, 0x17, 0x91, 0x6c, 0x25, 0x08, 0x76, 0x13, 0x64, 0x03, 0xd9, 0x62, 0x6c, 0x21, 0x81,
-	0xac, 0x50, 0x8a, 0x9d, 0x45, 0x0e, 0x04, 0x25, 0x8d, 0x25, 0x22, 0x12, 0x87, 0x26, 0x47, 0x42,
-	0x7c, 0x59, 0x60, 0x2f, 0x5b, 0xf4, 0xd6, 0x1e, 0x8a, 0x1e, 0x8b, 0x7e, 0x86, 0x1e, 0xfa, 0x15,
-	0x72, 0xec, 0x07, 0xe8, 0xa1, 0x08, 0xfa, 0x41, 0x0a, 0xce, 0x0c, 0x29, 0xea, 0x9f, 0x2d, 0xe7,
-	0xc6, 0x79, 0x7f, 0x7f, 0xf3, 0xde, 0x9b, 0x37, 0x6f, 0x08, 0x8a, 0xed, 0x10, 0x4a, 0xb2, 0x35,
-	0x6c, 0xd4, 0x89, 0x95, 0x75, 0xec, 0x7a, 0xb6, 0xb7, 0x9b, 0x75, 0xb1, 0xd3, 0x33, 0xeb, 0xd8,
-	0xcd, 0x30, 0x26, 0x5a, 0xc5, 0xb4, 0x85, 0x1d, 0xdc, 0xed, 0x64, 0xb8, 0x58, 0xc6, 0xb1, 0xeb,
-	0x99, 0xde, 0x6e, 0xfa, 0x56, 0x93, 0x90, 0x66, 0x1b, 0x67, 0x99, 0x54, 0xad, 0x7b, 0x9a, 0xc5,
-	0x1d, 0x9b, 0x9e, 0x73, 0xa5, 0xf4, 0x5d, 0x4c, 0x5b, 0xd9, 0xde, 0xae, 0xd1, 0xb6, 0x5b, 0xc6,
-	0xae, 0xb0, 0xaf, 0xd7, 0xda, 0xa4, 0xfe, 0x51, 0x08, 0xac, 0x0f, 0x08, 0x18, 0x94, 0x62, 0x97,
-	0x1a, 0xd4, 0x24, 0x16, 0xe7, 0x2b, 0x07, 0x90, 0xd8, 0xf3, 0xc4, 0x35, 0x7c, 0xd6, 0xc5, 0x2e,
-	0x45, 0x08, 0xa2, 0x6e, 0x9b, 0xd0, 0x94, 0xb4, 0x21, 0x6d, 0x46, 0x35, 0xf6, 0x8d, 0xee, 0xc1,
-	0x82, 0x63, 0x58, 0x0d, 0x83, 0xe8, 0x0e, 0xee, 0x61, 0xa3, 0x9d, 0x8a, 0x6c, 0x48, 0x9b, 0x09,
-	0x2d, 0xc1, 0x89, 0x1a, 0xa3, 0x29, 0x3b, 0xb0, 0x58, 0x76, 0x88, 0x4d, 0x5c, 0xac, 0x61, 0xd7,
-	0x26, 0x96, 0x8b, 0xd1, 0x1d, 0x00, 0x06, 0x45, 0x77, 0x88, 0xb0, 0x98, 0xd0, 0xae, 0x33, 0x8a,
-	0x46, 0x08, 0x55, 0xbe, 0x95, 0x00, 0xe5, 0xfb, 0x80, 0x7c, 0x04, 0x77, 0x00, 0xec, 0x6e, 0xad,
-	0x6d, 0xd6, 0xf5, 0x8f, 0xf8, 0xdc, 0xd7, 0xe2, 0x94, 0xd7, 0xf8, 0x1c, 0xad, 0xc1, 0x9c, 0x4d,
-	0xea, 0x7a, 0xcd, 0xa4, 0x02, 0x46, 0xcc, 0x26, 0xf5, 0x3d, 0xb3, 0x8f, 0x7c, 0x26, 0x84, 0xfc,
-	0x21, 0x2c, 0xd6, 0x49, 0xa7, 0x63, 0x52, 0x8a, 0xb1, 0x6e, 0x5a, 0x0d, 0xfc, 0x29, 0x15, 0x65,
-	0xec, 0x64, 0x40, 0x2e, 0x7a, 0x54, 0xe5, 0x3e, 0x24, 0x39, 0x94, 0x00, 0x3c, 0x82, 0x68, 0x08,
-	0x36, 0xfb, 0x56, 0x7e, 0xf4, 0x10, 0x37, 0x9b, 0x0e, 0x6e, 0x0e, 0x20, 0x1e, 0x17, 0xb3, 0x31,
-	0x9e, 0x23, 0xe3, 0x3c, 0x0f, 0x6d, 0x77, 0x66, 0x78, 0xbb, 0x0f, 0x20, 0xe9, 0xd9, 0xd3, 0x5d,
-	0xb3, 0x69, 0x19, 0xb4, 0xeb, 0x60, 0xb6, 0x81, 0x84, 0xb6, 0xe0, 0x51, 0x2b, 0x3e, 0x51, 0xd9,
-	0x82, 0xe5, 0x01, 0x60, 0x17, 0x6c, 0x42, 0x83, 0x5b, 0xc7, 0x46, 0xdb, 0x6c, 0x18, 0x94, 0x38,
-	0x65, 0xec, 0x9c, 0x12, 0xa7, 0x63, 0x58, 0x75, 0x7c, 0xd1, 0x66, 0xee, 0x42, 0xbc, 0x8f, 0xd1,
-	0x4d, 0x45, 0x36, 0x66, 0x36, 0x13, 0x1a, 0x04, 0x20, 0x5d, 0xe5, 0x87, 0x08, 0xdc, 0x1e, 0x6f,
-	0x54, 0x00, 0x49, 0xc3, 0x7c, 0xcd, 0x68, 0x7b, 0x24, 0x37, 0x25, 0x6d, 0xcc, 0x6c, 0x46, 0xb5,
-	0x60, 0x8d, 0xb6, 0x40, 0xa6, 0x84, 0x1a, 0x6d, 0xbd, 0xe7, 0x5b, 0x70, 0x45, 0xac, 0x16, 0x19,
-	0x3d, 0x30, 0xec, 0xa2, 0xa7, 0xb0, 0xc6, 0x45, 0x8d, 0x3a, 0x35, 0x7b, 0x38, 0xac, 0xc1, 0xd3,
-	0x7e, 0x83, 0xb1, 0xf3, 0x8c, 0x1b, 0xd2, 0x7b, 0x04, 0xa8, 0x63, 0xba, 0xae, 0x69, 0x35, 0xc3,
-	0x2a, 0x51, 0xb6, 0x8f, 0x25, 0xc1, 0x09, 0x89, 0x1f, 0xc0, 0x86, 0xd1, 0xc3, 0x8e, 0xd1, 0xc4,
-	0x23, 0x8e, 0x74, 0x01, 0x3b, 0x35, 0xbb, 0x21, 0x6d, 0x46, 0xb4, 0x3b, 0x42, 0x6e, 0xc8, 0xe3,
-	0x1e, 0x17, 0x52, 0x5e, 0x40, 0x3a, 0xa0, 0x31, 0x91, 0x81, 0xba, 0x19, 0x0a, 0xab, 0x34, 0x12,
-	0xd6, 0x9f, 0x22, 0xa1, 0x5c, 0x85, 0xf5, 0x45, 0x54, 0x9f, 0xc2, 0x0d, 0x83, 0x53, 0x71, 0x43,
-	0x1f, 0x31, 0xb5, 0x17, 0x49, 0x49, 0xda, 0x72, 0x20, 0x50, 0x0e, 0xec, 0xa2, 0x63, 0x98, 0xf7,
-	0x0e, 0x5d, 0xd7, 0xc5, 0x3c, 0x99, 0xf1, 0xdc, 0xb3, 0xcc, 0xf8, 0xee, 0x93, 0xb9, 0xc0, 0x7d,
-	0xa6, 0xc2, 0x6c, 0x68, 0x81, 0xad, 0xb4, 0x0d, 0x31, 0x4e, 0xbb, 0xec, 0x10, 0x1f, 0x40, 0x8c,
-	0x2b, 0xb1, 0x44, 0xc7, 0x73, 0xd9, 0x4b, 0xdd, 0x0b, 0x5f, 0xc2, 0xb5, 0x26, 0xd4, 0x95, 0x67,
-	0xb0, 0xa6, 0x7e, 0x32, 0x29, 0x6e, 0xf4, 0xb3, 0x37, 0x75, 0x74, 0x9f, 0x43, 0x6a, 0x54, 0x57,
-	0x44, 0xf6, 0x52, 0xe5, 0xb7, 0x80, 0xf6, 0x5b, 0x86, 0x69, 0x55, 0xa8, 0xe1, 0xf4, 0x9b, 0x46,
-	0x0a, 0xe6, 0x5c, 0x8f, 0x80, 0x1b, 0x6c, 0xcf, 0xf3, 0x9a, 0xbf, 0x44, 0x7f, 0x83, 0x44, 0x13,
-	0x5b, 0xd8, 0x35, 0x5d, 0x9d, 0x9a, 0x1d, 0x2c, 0x0a, 0x3c, 0x2e, 0x68, 0x55, 0xb3, 0x83, 0x95,
-	0xa7, 0x70, 0x23, 0x40, 0xc2, 0x7a, 0xc3, 0x74, 0x1d, 0x51, 0xc9, 0xc0, 0xea, 0xb0, 0x9e, 0x80,
-	0xb3, 0x02, 0xb3, 0xbc, 0xf5, 0xf0, 0xc3, 0xcc, 0x17, 0xca, 0x3b, 0x58, 0xca, 0xbb, 0x5e, 0x3f,
-	0xe9, 0x60, 0x8b, 0x86, 0xa2, 0x85, 0x6d, 0x52, 0x6f, 0xe9, 0x0c, 0xb0, 0x50, 0x00, 0x46, 0x62,
-	0x5b, 0xbc, 0xbc, 0x07, 0x7c, 0x37, 0x03, 0x28, 0x6c, 0x57, 0x60, 0x38, 0x83, 0x95, 0xfe, 0xe1,
-	0x31, 0x02, 0x3e, 0x0b, 0x69, 0x3c, 0xf7, 0xef, 0x49, 0x89, 0x1f, 0xb5, 0x14, 0x2a, 0xc5, 0x3e,
-	0x6f, 0xb9, 0x37, 0x4a, 0x4c, 0xff, 0x3f, 0x02, 0xcb, 0x63, 0x84, 0xd1, 0x6d, 0xb8, 0x1e, 0x34,
-	0x5f, 0xd1, 0x85, 0xfa, 0x84, 0xe9, 0x3b, 0xf6, 0x3d, 0x58, 0xe0, 0xf7, 0x28, 0x76, 0xf4, 0xd0,
-	0x8d, 0x93, 0xf0, 0x89, 0x15, 0x71, 0x67, 0xda, 0xfc, 0x3a, 0x14, 0x42, 0xfc, 0xde, 0x49, 0xf8,
-	0x44, 0x26, 0x34, 0x98, 0xd8, 0xd9, 0xe1, 0x53, 0xf2, 0x32, 0x38, 0x25, 0xb1, 0x0d, 0x69, 0x33,
-	0x99, 0x7b, 0x38, 0xed, 0x29, 0xf1, 0x4f, 0xc7, 0xaf, 0x11, 0x58, 0x9b, 0x70, 0x82, 0x42, 0xc6,
-	0xa5, 0xaf, 0x32, 0x8e, 0xfe, 0x05, 0x37, 0x31, 0x6d, 0xed, 0xea, 0x0d, 0x6c, 0x13, 0xd7, 0xa4,
-	0x7c, 0xea, 0xd0, 0xad, 0x6e, 0xa7, 0x86, 0x1d, 0x11, 0x39, 0x6f, 0xa4, 0xd9, 0x2d, 0x70, 0x3e,
-	0x9b, 0x32, 0x4a, 0x8c, 0x8b, 0x9e, 0xc0, 0xaa, 0xaf, 0x65, 0x5a, 0xf5, 0x76, 0xd7, 0x35, 0x89,
-	0x15, 0x0e, 0xe5, 0x8a, 0xe0, 0x16, 0x7d, 0x26, 0x8b, 0xd6, 0x16, 0xc8, 0x46, 0xd0, 0x84, 0x74,
-	0x56, 0x9a, 0x22, 0xaa, 0x8b, 0x7d, 0xba, 0xea, 0x91, 0xd1, 0x4b, 0xb8, 0xcd, 0x0c, 0x78, 0x82,
-	0xa6, 0xa5, 0x87, 0xd4, 0xce, 0xba, 0xb8, 0xcb, 0x9b, 0x77, 0x54, 0xbb, 0xe9, 0xcb, 0x14, 0xad,
-	0x7e, 0x77, 0x7b, 0xeb, 0x09, 0x28, 0x2f, 0x60, 0xa1, 0x40, 0x3a, 0x86, 0x19, 0xf4, 0xea, 0x15,
-	0x98, 0xe5, 0x1e, 0xc5, 0x51, 0x62, 0x0b, 0xb4, 0x0a, 0xb1, 0x06, 0x13, 0xf3, 0x67, 0x11, 0xbe,
-	0x52, 0x9e, 0x43, 0xd2, 0x57, 0x17, 0xe1, 0xde, 0x02, 0x39, 0xb8, 0xc2, 0x75, 0xa1, 0xc3, 0x4d,
-	0x2d, 0x06, 0x74, 0xae, 0xa2, 0x7c, 0x1f, 0x81, 0x25, 0x16, 0xad, 0xaa, 0x83, 0xfb, 0x37, 0xe8,
-	0x2b, 0x88, 0x52, 0x47, 0xd4, 0x6d, 0x3c, 0x97, 0x9b, 0x94, 0xad, 0x11, 0xc5, 0x8c, 0xb7, 0x28,
-	0x91, 0x06, 0xd6, 0x98, 0x7e, 0xfa, 0x17, 0x09, 0xe6, 0x7d, 0x12, 0xfa, 0x27, 0xcc, 0xb2, 0xb4,
-	0x31, 0x28, 0xf1, 0x9c, 0xd2, 0xb7, 0x8a, 0x69, 0x2b, 0xe3, 0x8f, 0x8d, 0x99, 0x3d, 0xe6, 0x82,
-	0xcf, 0x89, 0x5c, 0x61, 0x68, 0xb6, 0x8b, 0x0c, 0xcd, 0x76, 0xde, 0x85, 0x6b, 0x1b, 0x0e, 0x35,
-	0xeb, 0xa6, 0xcd, 0x2e, 0xa7, 0x1e, 0xa1, 0xd8, 0xbf, 0xa3, 0x97, 0xc2, 0x9c, 0x63, 0x8f, 0xe1,
-	0x35, 0x17, 0x31, 0x02, 0x30, 0x39, 0x9e, 0x55, 0xe0, 0xb7, 0xbf, 0x47, 0x51, 0xde, 0xc0, 0x8a,
-	0x07, 0x9a, 0x41, 0xf0, 0x8a, 0xc1, 0x4f, 0xcb, 0x2d, 0xb8, 0xce, 0xc6, 0xa3, 0x53, 0x87, 0x74,
-	0x44, 0x3c, 0xe7, 0x3d, 0xc2, 0x2b, 0x87, 0x74, 0xbc, 0x51, 0x91, 0x31, 0x29, 0x11, 0xf5, 0x18,
-	0xf3, 0x96, 0x55, 0xb2, 0x7d, 0x08, 0x0b, 0x41, 0x55, 0x6b, 0xa4, 0x8d, 0x51, 0x1c, 0xe6, 0xde,
-	0x95, 0x5e, 0x97, 0x8e, 0x4e, 0x4a, 0xf2, 0x35, 0x94, 0x80, 0xf9, 0x7c, 0xb5, 0xaa, 0x56, 0xaa,
-	0xaa, 0x26, 0x4b, 0xde, 0xaa, 0xac, 0x1d, 0x95, 0x8f, 0x2a, 0xaa, 0x26, 0x47, 0x50, 0x12, 0x20,
-	0x7f, 0x70, 0xa0, 0xa9, 0x07, 0xf9, 0xea, 0x91, 0x26, 0xcf, 0x6c, 0xff, 0x2c, 0xc1, 0xe2, 0xd0,
-	0x01, 0x41, 0x08, 0x92, 0xc2, 0x98, 0x5e, 0xa9, 0xe6, 0xab, 0xef, 0x2a, 0xf2, 0x35, 0xb4, 0x02,
-	0x72, 0x41, 0x2d, 0x1f, 0x55, 0x8a, 0x55, 0x5d, 0x53, 0xf7, 0xd5, 0xe2, 0xb1, 0x5a, 0x90, 0x25,
-	0x4f, 0xb2, 0xac, 0x96, 0x0a, 0xc5, 0xd2, 0x81, 0x9e, 0xdf, 0xaf, 0x16, 0x8f, 0x55, 0x39, 0x82,
-	0x00, 0x62, 0xe2, 0x7b, 0xc6, 0xe3, 0x17, 0x4b, 0xc5, 0x6a, 0x31, 0x5f, 0x55, 0x0b, 0xba, 0xfa,
-	0xbe, 0x58, 0x95, 0xa3, 0x48, 0x86, 0xc4, 0x49, 0xb1, 0x7a, 0x58, 0xd0, 0xf2, 0x27, 0xf9, 0xbd,
-	0x37, 0xaa, 0x3c, 0xeb, 0x69, 0x78, 0x3c, 0xb5, 0x20, 0xc7, 0x3c, 0x0d, 0xfe, 0xad, 0x57, 0xde,
-	0xe4, 0x2b, 0x87, 0x6a, 0x41, 0x9e, 0xcb, 0xfd, 0x2e, 0xc1, 0x62, 0xde, 0xef, 0x4d, 0xfc, 0xcd,
-	0x81, 0x5a, 0x80, 0x44, 0x08, 0x43, 0x13, 0x38, 0xda, 0x9e, 0xd8, 0x8d, 0x47, 0xc6, 0xf4, 0xf4,
-	0xdf, 0x27, 0xd4, 0x4a, 0x48, 0xb4, 0x60, 0x50, 0x03, 0xe9, 0xb0, 0x54, 0xe9, 0xd6, 0x3a, 0xe6,
-	0x80, 0x23, 0xe5, 0x72, 0xe5, 0xb0, 0x83, 0x71, 0x60, 0xfc, 0xfa, 0xce, 0x7d, 0x96, 0x82, 0x97,
-	0x47, 0xb0, 0xbd, 0xf7, 0x90, 0x10, 0x38, 0x59, 0xc5, 0xa0, 0xfb, 0x17, 0x1e, 0x17, 0x7f, 0x4b,
-	0x53, 0x94, 0x3f, 0xfa, 0x00, 0x09, 0xe1, 0x8c, 0xaf, 0xa7, 0xd0, 0x49, 0x4f, 0x6c, 0xad, 0x43,
-	0x0f, 0xa6, 0xdc, 0x37, 0x12, 0x2c, 0xf9, 0x63, 0x3c, 0x09, 0x36, 0xe3, 0xc0, 0x9a, 0x88, 0xa0,
-	0x60, 0xe1, 0xbc, 0xd5, 0x28, 0x3b, 0x84, 0x9c, 0x5e, 0x90, 0xb0, 0x91, 0x57, 0x4a, 0xfa, 0x1f,
-	0x53, 0xc9, 0x0a, 0x24, 0x7f, 0xce, 0x81, 0xdc, 0xaf, 0x6b, 0x01, 0xe4, 0x03, 0x00, 0x6f, 0x51,
-	0x2c, 0xb1, 0x0f, 0x26, 0xd9, 0x1b, 0x68, 0x9c, 0x93, 0xd3, 0x38, 0xd4, 0x20, 0xff, 0x0b, 0x4b,
-	0x27, 0x86, 0x49, 0x5f, 0x85, 0x27, 0x4d, 0x94, 0xbb, 0xd2, 0x58, 0xca, 0x1d, 0x3e, 0xfe, 0x8a,
-	0x51, 0x76, 0x47, 0x42, 0x04, 0x92, 0x83, 0x53, 0x14, 0x7a, 0x74, 0xa9, 0xa1, 0xf0, 0x94, 0x96,
-	0xce, 0x4c, 0x2b, 0x2e, 0x36, 0xdc, 0x86, 0xe5, 0x7d, 0x7f, 0xb0, 0x08, 0x0d, 0x29, 0x5b, 0xd3,
-	0x4c, 0x44, 0xdc, 0xe3, 0xf6, 0xf4, 0xc3, 0x13, 0x3a, 0x1b, 0xed, 0x53, 0x57, 0xdc, 0xdf, 0x55,
-	0x67, 0x74, 0xf4, 0x3f, 0x09, 0x56, 0xc6, 0x3d, 0x0a, 0xd1, 0xe5, 0x19, 0x1a, 0x7d, 0x97, 0xa6,
-	0x9f, 0x5c, 0x4d, 0x49, 0x60, 0xe8, 0x82, 0x3c, 0x3c, 0xe3, 0xa3, 0x89, 0x1b, 0x99, 0xf0, 0x92,
-	0x48, 0xef, 0x4c, 0xaf, 0x20, 0xdc, 0xfe, 0x27, 0x28, 0xe6, 0xfe, 0x23, 0x01, 0xad, 0x66, 0xf8,
-	0x9f, 0x9c, 0x8c, 0xff, 0x27, 0x27, 0xa3, 0x76, 0x6c, 0x7a, 0x3e, 0x39, 0x8d, 0xa3, 0x0f, 0x8c,
-	0x1d, 0x09, 0xbd, 0x86, 0x85, 0x7d, 0xc3, 0x22, 0x96, 0x59, 0x37, 0xda, 0x87, 0xd8, 0x68, 0x4c,
-	0x34, 0x3b, 0x45, 0x67, 0xda, 0x4b, 0x7c, 0xfe, 0xb2, 0x2e, 0xfd, 0xf6, 0x65, 0x5d, 0xfa, 0xe3,
-	0xcb, 0xba, 0x54, 0x8b, 0x31, 0x0b, 0x8f, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x3c, 0x79,
-	0x88, 0xae, 0x12, 0x00, 0x00,
+func (m *ValidatorPerformanceResponse) GetAverageActiveValidatorBalance() float32 {
+	if m != nil {
+		return m.AverageActiveValidatorBalance
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// AttesterServiceClient is the client API for AttesterService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AttesterServiceClient interface {
-	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+type ValidatorActivationRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type attesterServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ValidatorActivationRequest) Reset()         { *m = ValidatorActivationRequest{} }
+func (m *ValidatorActivationRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorActivationRequest) ProtoMessage()    {}
+func (*ValidatorActivationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25}
 }
-
-func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
-	return &attesterServiceClient{cc}
+func (m *ValidatorActivationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
-	out := new(v1alpha1.AttestationData)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorActivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorActivationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ValidatorActivationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorActivationRequest.Merge(m, src)
+}
+func (m *ValidatorActivationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorActivationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorActivationRequest.DiscardUnknown(m)
 }
 
-func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
-	out := new(AttestResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorActivationRequest proto.InternalMessageInfo
+
+func (m *ValidatorActivationRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
 	}
-	return out, nil
+	return nil
 }
 
-// AttesterServiceServer is the server API for AttesterService service.
-type AttesterServiceServer interface {
-	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
+type ValidatorActivationResponse struct {
+	ActivatedPublicKeys  [][]byte                              `protobuf:"bytes,1,rep,name=activated_public_keys,json=activatedPublicKeys,proto3" json:"activated_public_keys,omitempty"` // Deprecated: Do not use.
+	Statuses             []*ValidatorActivationResponse_Status `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
-func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
-	s.RegisterService(&_AttesterService_serviceDesc, srv)
+func (m *ValidatorActivationResponse) Reset()         { *m = ValidatorActivationResponse{} }
+func (m *ValidatorActivationResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorActivationResponse) ProtoMessage()    {}
+func (*ValidatorActivationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{26}
 }
-
-func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttestationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ValidatorActivationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.Attestation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
+func (m *ValidatorActivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorActivationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _AttesterService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
-	HandlerType: (*AttesterServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestAttestation",
-			Handler:    _AttesterService_RequestAttestation_Handler,
-		},
-		{
-			MethodName: "SubmitAttestation",
-			Handler:    _AttesterService_SubmitAttestation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *ValidatorActivationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorActivationResponse.Merge(m, src)
 }
-
-// ProposerServiceClient is the client API for ProposerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProposerServiceClient interface {
-	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+func (m *ValidatorActivationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-type proposerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ValidatorActivationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorActivationResponse.DiscardUnknown(m)
 }
 
-func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
-	return &proposerServiceClient{cc}
-}
+var xxx_messageInfo_ValidatorActivationResponse proto.InternalMessageInfo
 
-func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+// Deprecated: Do not use.
+func (m *ValidatorActivationResponse) GetActivatedPublicKeys() [][]byte {
+	if m != nil {
+		return m.ActivatedPublicKeys
 	}
-	return out, nil
+	return nil
 }
 
-func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
-	out := new(ProposeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorActivationResponse) GetStatuses() []*ValidatorActivationResponse_Status {
+	if m != nil {
+		return m.Statuses
 	}
-	return out, nil
+	return nil
 }
 
-// ProposerServiceServer is the server API for ProposerService service.
-type ProposerServiceServer interface {
-	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
+type ValidatorActivationResponse_Status struct {
+	PublicKey            []byte                   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status               *ValidatorStatusResponse `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
-	s.RegisterService(&_ProposerService_serviceDesc, srv)
+func (m *ValidatorActivationResponse_Status) Reset()         { *m = ValidatorActivationResponse_Status{} }
+func (m *ValidatorActivationResponse_Status) String() string { return proto.CompactTextString(m) }
+func (*ValidatorActivationResponse_Status) ProtoMessage()    {}
+func (*ValidatorActivationResponse_Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{26, 0}
 }
-
-func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
+func (m *ValidatorActivationResponse_Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorActivationResponse_Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorActivationResponse_Status.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
+}
+func (m *ValidatorActivationResponse_Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorActivationResponse_Status.Merge(m, src)
+}
+func (m *ValidatorActivationResponse_Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorActivationResponse_Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorActivationResponse_Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorActivationResponse_Status proto.InternalMessageInfo
+
+func (m *ValidatorActivationResponse_Status) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
+	return nil
+}
+
+func (m *ValidatorActivationResponse_Status) GetStatus() *ValidatorStatusResponse {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.BeaconBlock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
-	}
-	return interceptor(ctx, in, info, handler)
+type ExitedValidatorsRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var _ProposerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
-	HandlerType: (*ProposerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestBlock",
-			Handler:    _ProposerService_RequestBlock_Handler,
-		},
-		{
-			MethodName: "ProposeBlock",
-			Handler:    _ProposerService_ProposeBlock_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *ExitedValidatorsRequest) Reset()         { *m = ExitedValidatorsRequest{} }
+func (m *ExitedValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExitedValidatorsRequest) ProtoMessage()    {}
+func (*ExitedValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{27}
 }
-
-// AggregatorServiceClient is the client API for AggregatorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AggregatorServiceClient interface {
-	SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error)
+func (m *ExitedValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type aggregatorServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ExitedValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitedValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewAggregatorServiceClient(cc *grpc.ClientConn) AggregatorServiceClient {
-	return &aggregatorServiceClient{cc}
+func (m *ExitedValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitedValidatorsRequest.Merge(m, src)
+}
+func (m *ExitedValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitedValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitedValidatorsRequest.DiscardUnknown(m)
 }
 
-func (c *aggregatorServiceClient) SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error) {
-	out := new(AggregationResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExitedValidatorsRequest proto.InternalMessageInfo
+
+func (m *ExitedValidatorsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
 	}
-	return out, nil
+	return nil
 }
 
-// AggregatorServiceServer is the server API for AggregatorService service.
-type AggregatorServiceServer interface {
-	SubmitAggregateAndProof(context.Context, *AggregationRequest) (*AggregationResponse, error)
+type ExitedValidatorsResponse struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterAggregatorServiceServer(s *grpc.Server, srv AggregatorServiceServer) {
-	s.RegisterService(&_AggregatorService_serviceDesc, srv)
+func (m *ExitedValidatorsResponse) Reset()         { *m = ExitedValidatorsResponse{} }
+func (m *ExitedValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExitedValidatorsResponse) ProtoMessage()    {}
+func (*ExitedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28}
 }
-
-func _AggregatorService_SubmitAggregateAndProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AggregationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, req.(*AggregationRequest))
+func (m *ExitedValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitedValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _AggregatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AggregatorService",
-	HandlerType: (*AggregatorServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitAggregateAndProof",
-			Handler:    _AggregatorService_SubmitAggregateAndProof_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *ExitedValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitedValidatorsResponse.Merge(m, src)
 }
-
-// ValidatorServiceClient is the client API for ValidatorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ValidatorServiceClient interface {
-	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
-	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
-	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
-	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
-	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error)
-	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+func (m *ExitedValidatorsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-type validatorServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ExitedValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitedValidatorsResponse.DiscardUnknown(m)
 }
 
-func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
-	return &validatorServiceClient{cc}
-}
+var xxx_messageInfo_ExitedValidatorsResponse proto.InternalMessageInfo
 
-func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
-	out := new(DomainResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExitedValidatorsResponse) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
 	}
-	return out, nil
+	return nil
 }
 
-func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &validatorServiceWaitForActivationClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type ChainStartResponse struct {
+	Started              bool     `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
+	GenesisTime          uint64   `protobuf:"varint,2,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type ValidatorService_WaitForActivationClient interface {
-	Recv() (*ValidatorActivationResponse, error)
-	grpc.ClientStream
+func (m *ChainStartResponse) Reset()         { *m = ChainStartResponse{} }
+func (m *ChainStartResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainStartResponse) ProtoMessage()    {}
+func (*ChainStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{29}
 }
-
-type validatorServiceWaitForActivationClient struct {
-	grpc.ClientStream
+func (m *ChainStartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
-	m := new(ValidatorActivationResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ChainStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *ChainStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStartResponse.Merge(m, src)
+}
+func (m *ChainStartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStartResponse.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
-	out := new(ValidatorIndexResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChainStartResponse proto.InternalMessageInfo
+
+func (m *ChainStartResponse) GetStarted() bool {
+	if m != nil {
+		return m.Started
 	}
-	return out, nil
+	return false
 }
 
-func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
-	out := new(AssignmentResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChainStartResponse) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
-	out := new(ValidatorStatusResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ValidatorIndexRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
-	out := new(ValidatorPerformanceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidatorIndexRequest) Reset()         { *m = ValidatorIndexRequest{} }
+func (m *ValidatorIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorIndexRequest) ProtoMessage()    {}
+func (*ValidatorIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{30}
 }
-
-func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
-	out := new(ExitedValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ValidatorIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorIndexRequest.Merge(m, src)
+}
+func (m *ValidatorIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorIndexRequest.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForChainStart", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &validatorServiceWaitForChainStartClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorIndexRequest proto.InternalMessageInfo
+
+func (m *ValidatorIndexRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return x, nil
+	return nil
 }
 
-type ValidatorService_WaitForChainStartClient interface {
-	Recv() (*ChainStartResponse, error)
-	grpc.ClientStream
+type ValidatorIndexResponse struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type validatorServiceWaitForChainStartClient struct {
-	grpc.ClientStream
+func (m *ValidatorIndexResponse) Reset()         { *m = ValidatorIndexResponse{} }
+func (m *ValidatorIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorIndexResponse) ProtoMessage()    {}
+func (*ValidatorIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{31}
 }
-
-func (x *validatorServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
-	m := new(ChainStartResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ValidatorIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *ValidatorIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorIndexResponse.Merge(m, src)
+}
+func (m *ValidatorIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorIndexResponse.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorIndexResponse proto.InternalMessageInfo
+
+func (m *ValidatorIndexResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-// ValidatorServiceServer is the server API for ValidatorService service.
-type ValidatorServiceServer interface {
-	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
-	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
-	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
-	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
-	WaitForChainStart(*types.Empty, ValidatorService_WaitForChainStartServer) error
-	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
+type AssignmentRequest struct {
+	EpochStart           uint64   `protobuf:"varint,1,opt,name=epoch_start,json=epochStart,proto3" json:"epoch_start,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
-	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+func (m *AssignmentRequest) Reset()         { *m = AssignmentRequest{} }
+func (m *AssignmentRequest) String() string { return proto.CompactTextString(m) }
+func (*AssignmentRequest) ProtoMessage()    {}
+func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{32}
 }
-
-func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DomainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).DomainData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AssignmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ValidatorActivationRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *AssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
 }
-
-type ValidatorService_WaitForActivationServer interface {
-	Send(*ValidatorActivationResponse) error
-	grpc.ServerStream
+func (m *AssignmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentRequest.Merge(m, src)
 }
-
-type validatorServiceWaitForActivationServer struct {
-	grpc.ServerStream
+func (m *AssignmentRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *AssignmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentRequest.DiscardUnknown(m)
 }
 
-func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+var xxx_messageInfo_AssignmentRequest proto.InternalMessageInfo
+
+func (m *AssignmentRequest) GetEpochStart() uint64 {
+	if m != nil {
+		return m.EpochStart
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
+func (m *AssignmentRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AssignmentResponse struct {
+	ValidatorAssignment  []*AssignmentResponse_ValidatorAssignment `protobuf:"bytes,1,rep,name=validator_assignment,json=validatorAssignment,proto3" json:"validator_assignment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
-func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorPerformanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AssignmentResponse) Reset()         { *m = AssignmentResponse{} }
+func (m *AssignmentResponse) String() string { return proto.CompactTextString(m) }
+func (*AssignmentResponse) ProtoMessage()    {}
+func (*AssignmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{33}
 }
-
-func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExitedValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+func (m *AssignmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AssignmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentResponse.Merge(m, src)
+}
+func (m *AssignmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentResponse.DiscardUnknown(m)
 }
 
-func _ValidatorService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_AssignmentResponse proto.InternalMessageInfo
+
+func (m *AssignmentResponse) GetValidatorAssignment() []*AssignmentResponse_ValidatorAssignment {
+	if m != nil {
+		return m.ValidatorAssignment
 	}
-	return srv.(ValidatorServiceServer).WaitForChainStart(m, &validatorServiceWaitForChainStartServer{stream})
+	return nil
 }
 
-type ValidatorService_WaitForChainStartServer interface {
-	Send(*ChainStartResponse) error
-	grpc.ServerStream
+type AssignmentResponse_ValidatorAssignment struct {
+	Committee            []uint64        `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
+	CommitteeIndex       uint64          `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	AttesterSlot         uint64          `protobuf:"varint,3,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
+	ProposerSlot         uint64          `protobuf:"varint,4,opt,name=proposer_slot,json=proposerSlot,proto3" json:"proposer_slot,omitempty"`
+	PublicKey            []byte          `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status               ValidatorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-type validatorServiceWaitForChainStartServer struct {
-	grpc.ServerStream
+func (m *AssignmentResponse_ValidatorAssignment) Reset() {
+	*m = AssignmentResponse_ValidatorAssignment{}
 }
-
-func (x *validatorServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *AssignmentResponse_ValidatorAssignment) String() string { return proto.CompactTextString(m) }
+func (*AssignmentResponse_ValidatorAssignment) ProtoMessage()    {}
+func (*AssignmentResponse_ValidatorAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{33, 0}
 }
-
-func _ValidatorService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CanonicalHead(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CanonicalHead(ctx, req.(*types.Empty))
+func (m *AssignmentResponse_ValidatorAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignmentResponse_ValidatorAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentResponse_ValidatorAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _ValidatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
-	HandlerType: (*ValidatorServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DomainData",
-			Handler:    _ValidatorService_DomainData_Handler,
-		},
-		{
-			MethodName: "ValidatorIndex",
-			Handler:    _ValidatorService_ValidatorIndex_Handler,
-		},
-		{
-			MethodName: "CommitteeAssignment",
-			Handler:    _ValidatorService_CommitteeAssignment_Handler,
-		},
-		{
-			MethodName: "ValidatorStatus",
-			Handler:    _ValidatorService_ValidatorStatus_Handler,
-		},
-		{
-			MethodName: "ValidatorPerformance",
-			Handler:    _ValidatorService_ValidatorPerformance_Handler,
-		},
-		{
-			MethodName: "ExitedValidators",
-			Handler:    _ValidatorService_ExitedValidators_Handler,
-		},
-		{
-			MethodName: "CanonicalHead",
-			Handler:    _ValidatorService_CanonicalHead_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WaitForActivation",
-			Handler:       _ValidatorService_WaitForActivation_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "WaitForChainStart",
-			Handler:       _ValidatorService_WaitForChainStart_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *AssignmentResponse_ValidatorAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentResponse_ValidatorAssignment.Merge(m, src)
+}
+func (m *AssignmentResponse_ValidatorAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentResponse_ValidatorAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentResponse_ValidatorAssignment.DiscardUnknown(m)
 }
 
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssignmentResponse_ValidatorAssignment proto.InternalMessageInfo
+
+func (m *AssignmentResponse_ValidatorAssignment) GetCommittee() []uint64 {
+	if m != nil {
+		return m.Committee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if len(m.RandaoReveal) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
-		i += copy(dAtA[i:], m.RandaoReveal)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AssignmentResponse_ValidatorAssignment) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AssignmentResponse_ValidatorAssignment) GetAttesterSlot() uint64 {
+	if m != nil {
+		return m.AttesterSlot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AssignmentResponse_ValidatorAssignment) GetProposerSlot() uint64 {
+	if m != nil {
+		return m.ProposerSlot
 	}
-	return i, nil
+	return 0
 }
 
-func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AssignmentResponse_ValidatorAssignment) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if len(m.PocBit) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
-		i += copy(dAtA[i:], m.PocBit)
-	}
-	if m.Slot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if m.CommitteeIndex != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AssignmentResponse_ValidatorAssignment) GetStatus() ValidatorStatus {
+	if m != nil {
+		return m.Status
 	}
-	return i, nil
+	return ValidatorStatus_UNKNOWN_STATUS
 }
 
-func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ValidatorStatusResponse struct {
+	Status                    ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	Eth1DepositBlockNumber    uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
+	DepositInclusionSlot      uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
+	ActivationEpoch           uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	PositionInActivationQueue uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
+	XXX_unrecognized          []byte          `json:"-"`
+	XXX_sizecache             int32           `json:"-"`
 }
 
-func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Root) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
-		i += copy(dAtA[i:], m.Root)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
+func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorStatusResponse) ProtoMessage()    {}
+func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{34}
 }
-
-func (m *AggregationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ValidatorStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorStatusResponse.Merge(m, src)
+}
+func (m *ValidatorStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorStatusResponse.DiscardUnknown(m)
 }
 
-func (m *AggregationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if m.CommitteeIndex != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
-	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if len(m.SlotSignature) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.SlotSignature)))
-		i += copy(dAtA[i:], m.SlotSignature)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ValidatorStatusResponse proto.InternalMessageInfo
+
+func (m *ValidatorStatusResponse) GetStatus() ValidatorStatus {
+	if m != nil {
+		return m.Status
 	}
-	return i, nil
+	return ValidatorStatus_UNKNOWN_STATUS
 }
 
-func (m *AggregationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ValidatorStatusResponse) GetEth1DepositBlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1DepositBlockNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AggregationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Root) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
-		i += copy(dAtA[i:], m.Root)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ValidatorStatusResponse) GetDepositInclusionSlot() uint64 {
+	if m != nil {
+		return m.DepositInclusionSlot
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ValidatorStatusResponse) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ValidatorStatusResponse) GetPositionInActivationQueue() uint64 {
+	if m != nil {
+		return m.PositionInActivationQueue
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type DomainRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Domain               []byte   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		dAtA2 := make([]byte, len(m.Balances)*10)
-		var j1 int
-		for _, num := range m.Balances {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(j1))
-		i += copy(dAtA[i:], dAtA2[:j1])
-	}
-	if m.TotalValidators != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
-	}
-	if m.TotalActiveValidators != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
-	}
-	if len(m.MissingValidators) > 0 {
-		for _, b := range m.MissingValidators {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+func (m *DomainRequest) Reset()         { *m = DomainRequest{} }
+func (m *DomainRequest) String() string { return proto.CompactTextString(m) }
+func (*DomainRequest) ProtoMessage()    {}
+func (*DomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{35}
+}
+func (m *DomainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.AverageActiveValidatorBalance != 0 {
-		dAtA[i] = 0x2d
-		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
-		i += 4
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *DomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainRequest.Merge(m, src)
+}
+func (m *DomainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainRequest.DiscardUnknown(m)
 }
 
-func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DomainRequest proto.InternalMessageInfo
+
+func (m *DomainRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DomainRequest) GetDomain() []byte {
+	if m != nil {
+		return m.Domain
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type DomainResponse struct {
+	SignatureDomain      uint64   `protobuf:"varint,1,opt,name=signature_domain,json=signatureDomain,proto3" json:"signature_domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.Statuses) > 0 {
-		for _, msg := range m.Statuses {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *DomainResponse) Reset()         { *m = DomainResponse{} }
+func (m *DomainResponse) String() string { return proto.CompactTextString(m) }
+func (*DomainResponse) ProtoMessage()    {}
+func (*DomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{36}
+}
+func (m *DomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *DomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainResponse.Merge(m, src)
+}
+func (m *DomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainResponse.DiscardUnknown(m)
 }
 
-func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DomainResponse proto.InternalMessageInfo
+
+func (m *DomainResponse) GetSignatureDomain() uint64 {
+	if m != nil {
+		return m.SignatureDomain
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.Status != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status.Size()))
-		n3, err := m.Status.MarshalTo(dAtA[i:])
+type ForkResponse struct {
+	PreviousVersion      []byte   `protobuf:"bytes,1,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	CurrentVersion       []byte   `protobuf:"bytes,2,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	Epoch                uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkResponse) Reset()         { *m = ForkResponse{} }
+func (m *ForkResponse) String() string { return proto.CompactTextString(m) }
+func (*ForkResponse) ProtoMessage()    {}
+func (*ForkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{37}
+}
+func (m *ForkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ForkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkResponse.Merge(m, src)
+}
+func (m *ForkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkResponse.DiscardUnknown(m)
 }
 
-func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ForkResponse proto.InternalMessageInfo
+
+func (m *ForkResponse) GetPreviousVersion() []byte {
+	if m != nil {
+		return m.PreviousVersion
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ForkResponse) GetCurrentVersion() []byte {
+	if m != nil {
+		return m.CurrentVersion
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ForkResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+type CommitteeSubnetsSubscribeRequest struct {
+	Subscriptions        []*CommitteeSubnetSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) Reset()         { *m = CommitteeSubnetsSubscribeRequest{} }
+func (m *CommitteeSubnetsSubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitteeSubnetsSubscribeRequest) ProtoMessage()    {}
+func (*CommitteeSubnetsSubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{38}
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeSubnetsSubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeSubnetsSubscribeRequest.Merge(m, src)
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeSubnetsSubscribeRequest.DiscardUnknown(m)
 }
 
-func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CommitteeSubnetsSubscribeRequest proto.InternalMessageInfo
+
+func (m *CommitteeSubnetsSubscribeRequest) GetSubscriptions() []*CommitteeSubnetSubscription {
+	if m != nil {
+		return m.Subscriptions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Started {
-		dAtA[i] = 0x8
-		i++
-		if m.Started {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.GenesisTime != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type CommitteeSubnetSubscription struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	IsAggregator         bool     `protobuf:"varint,3,opt,name=is_aggregator,json=isAggregator,proto3" json:"is_aggregator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CommitteeSubnetSubscription) Reset()         { *m = CommitteeSubnetSubscription{} }
+func (m *CommitteeSubnetSubscription) String() string { return proto.CompactTextString(m) }
+func (*CommitteeSubnetSubscription) ProtoMessage()    {}
+func (*CommitteeSubnetSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{39}
+}
+func (m *CommitteeSubnetSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeSubnetSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeSubnetSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *CommitteeSubnetSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeSubnetSubscription.Merge(m, src)
+}
+func (m *CommitteeSubnetSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeSubnetSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeSubnetSubscription.DiscardUnknown(m)
 }
 
-func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_CommitteeSubnetSubscription proto.InternalMessageInfo
+
+func (m *CommitteeSubnetSubscription) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CommitteeSubnetSubscription) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Index))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *CommitteeSubnetSubscription) GetIsAggregator() bool {
+	if m != nil {
+		return m.IsAggregator
 	}
-	return i, nil
+	return false
 }
 
-func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ProposerDutiesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.EpochStart != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+func (m *ProposerDutiesRequest) Reset()         { *m = ProposerDutiesRequest{} }
+func (m *ProposerDutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposerDutiesRequest) ProtoMessage()    {}
+func (*ProposerDutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{40}
+}
+func (m *ProposerDutiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerDutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerDutiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
 }
-
-func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ProposerDutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerDutiesRequest.Merge(m, src)
+}
+func (m *ProposerDutiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerDutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerDutiesRequest.DiscardUnknown(m)
 }
 
-func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAssignment) > 0 {
-		for _, msg := range m.ValidatorAssignment {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ProposerDutiesRequest proto.InternalMessageInfo
+
+func (m *ProposerDutiesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return i, nil
+	return 0
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ProposerDutiesResponse struct {
+	Duties               []*ProposerDutiesResponse_Duty `protobuf:"bytes,1,rep,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Committee) > 0 {
-		dAtA5 := make([]byte, len(m.Committee)*10)
-		var j4 int
-		for _, num := range m.Committee {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+func (m *ProposerDutiesResponse) Reset()         { *m = ProposerDutiesResponse{} }
+func (m *ProposerDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposerDutiesResponse) ProtoMessage()    {}
+func (*ProposerDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{41}
+}
+func (m *ProposerDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerDutiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(j4))
-		i += copy(dAtA[i:], dAtA5[:j4])
-	}
-	if m.CommitteeIndex != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
-	}
-	if m.AttesterSlot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.AttesterSlot))
-	}
-	if m.ProposerSlot != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ProposerSlot))
-	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.Status != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ProposerDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerDutiesResponse.Merge(m, src)
+}
+func (m *ProposerDutiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerDutiesResponse.DiscardUnknown(m)
 }
 
-func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProposerDutiesResponse proto.InternalMessageInfo
+
+func (m *ProposerDutiesResponse) GetDuties() []*ProposerDutiesResponse_Duty {
+	if m != nil {
+		return m.Duties
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
-	}
-	if m.Eth1DepositBlockNumber != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
-	}
-	if m.DepositInclusionSlot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
-	}
-	if m.ActivationEpoch != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
-	}
-	if m.PositionInActivationQueue != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type ProposerDutiesResponse_Duty struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex        uint64   `protobuf:"varint,2,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ProposerDutiesResponse_Duty) Reset()         { *m = ProposerDutiesResponse_Duty{} }
+func (m *ProposerDutiesResponse_Duty) String() string { return proto.CompactTextString(m) }
+func (*ProposerDutiesResponse_Duty) ProtoMessage()    {}
+func (*ProposerDutiesResponse_Duty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{41, 0}
+}
+func (m *ProposerDutiesResponse_Duty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerDutiesResponse_Duty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerDutiesResponse_Duty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ProposerDutiesResponse_Duty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerDutiesResponse_Duty.Merge(m, src)
+}
+func (m *ProposerDutiesResponse_Duty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerDutiesResponse_Duty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerDutiesResponse_Duty.DiscardUnknown(m)
 }
 
-func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.Domain) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
-		i += copy(dAtA[i:], m.Domain)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ProposerDutiesResponse_Duty proto.InternalMessageInfo
+
+func (m *ProposerDutiesResponse_Duty) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	return i, nil
+	return 0
 }
 
-func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ProposerDutiesResponse_Duty) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SignatureDomain != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ProposerDutiesResponse_Duty) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return i, nil
+	return nil
 }
 
-func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type SyncStatusResponse struct {
+	Syncing  bool   `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	HeadSlot uint64 `protobuf:"varint,2,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	// The highest head slot reported by the peers of the node, or its head slot if it is higher.
+	HighestKnownSlot uint64 `protobuf:"varint,3,opt,name=highest_known_slot,json=highestKnownSlot,proto3" json:"highest_known_slot,omitempty"`
+	// The estimated time for the head to reach the highest known slot at the recent block processing
+	// rate. Unset when no block was processed recently to derive the rate from.
+	EstimatedTimeToSync  *types.Duration `protobuf:"bytes,4,opt,name=estimated_time_to_sync,json=estimatedTimeToSync,proto3" json:"estimated_time_to_sync,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tree) > 0 {
-		for _, msg := range m.Tree {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *SyncStatusResponse) Reset()         { *m = SyncStatusResponse{} }
+func (m *SyncStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncStatusResponse) ProtoMessage()    {}
+func (*SyncStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{42}
+}
+func (m *SyncStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *SyncStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatusResponse.Merge(m, src)
+}
+func (m *SyncStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatusResponse.DiscardUnknown(m)
 }
 
-func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SyncStatusResponse proto.InternalMessageInfo
+
+func (m *SyncStatusResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Block != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Block.Size()))
-		n6, err := m.Block.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.ParticipatedVotes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
-	}
-	if m.TotalVotes != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SyncStatusResponse) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
 	}
-	return i, nil
+	return 0
 }
 
-func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *SyncStatusResponse) GetHighestKnownSlot() uint64 {
+	if m != nil {
+		return m.HighestKnownSlot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SlotFrom != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
-	}
-	if m.SlotTo != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SyncStatusResponse) GetEstimatedTimeToSync() *types.Duration {
+	if m != nil {
+		return m.EstimatedTimeToSync
 	}
-	return i, nil
+	return nil
 }
 
-func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+type BlockTreeResponse struct {
+	Tree                 []*BlockTreeResponse_TreeNode `protobuf:"bytes,1,rep,name=tree,proto3" json:"tree,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	l = len(m.RandaoReveal)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *BlockTreeResponse) Reset()         { *m = BlockTreeResponse{} }
+func (m *BlockTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeResponse) ProtoMessage()    {}
+func (*BlockTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{43}
+}
+func (m *BlockTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BlockTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeResponse.Merge(m, src)
+}
+func (m *BlockTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeResponse.DiscardUnknown(m)
 }
 
-func (m *ProposeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_BlockTreeResponse proto.InternalMessageInfo
+
+func (m *BlockTreeResponse) GetTree() []*BlockTreeResponse_TreeNode {
+	if m != nil {
+		return m.Tree
 	}
-	return n
+	return nil
 }
 
-func (m *AttestationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.PocBit)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type BlockTreeResponse_TreeNode struct {
+	Block                *v1alpha1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockRoot            []byte                `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ParticipatedVotes    uint64                `protobuf:"varint,3,opt,name=participated_votes,json=participatedVotes,proto3" json:"participated_votes,omitempty"`
+	TotalVotes           uint64                `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *AttestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *BlockTreeResponse_TreeNode) Reset()         { *m = BlockTreeResponse_TreeNode{} }
+func (m *BlockTreeResponse_TreeNode) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeResponse_TreeNode) ProtoMessage()    {}
+func (*BlockTreeResponse_TreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{43, 0}
+}
+func (m *BlockTreeResponse_TreeNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTreeResponse_TreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTreeResponse_TreeNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BlockTreeResponse_TreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeResponse_TreeNode.Merge(m, src)
+}
+func (m *BlockTreeResponse_TreeNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTreeResponse_TreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeResponse_TreeNode.DiscardUnknown(m)
 }
 
-func (m *AggregationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.SlotSignature)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_BlockTreeResponse_TreeNode proto.InternalMessageInfo
+
+func (m *BlockTreeResponse_TreeNode) GetBlock() *v1alpha1.BeaconBlock {
+	if m != nil {
+		return m.Block
 	}
-	return n
+	return nil
 }
 
-func (m *AggregationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *BlockTreeResponse_TreeNode) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	return n
+	return nil
 }
 
-func (m *ValidatorPerformanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *BlockTreeResponse_TreeNode) GetParticipatedVotes() uint64 {
+	if m != nil {
+		return m.ParticipatedVotes
 	}
-	return n
+	return 0
 }
 
-func (m *ValidatorPerformanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		l = 0
-		for _, e := range m.Balances {
-			l += sovServices(uint64(e))
-		}
-		n += 1 + sovServices(uint64(l)) + l
-	}
-	if m.TotalValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalValidators))
-	}
-	if m.TotalActiveValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalActiveValidators))
-	}
-	if len(m.MissingValidators) > 0 {
-		for _, b := range m.MissingValidators {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.AverageActiveValidatorBalance != 0 {
-		n += 5
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *BlockTreeResponse_TreeNode) GetTotalVotes() uint64 {
+	if m != nil {
+		return m.TotalVotes
 	}
-	return n
+	return 0
 }
 
-func (m *ValidatorActivationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type TreeBlockSlotRequest struct {
+	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
+	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorActivationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if len(m.Statuses) > 0 {
-		for _, e := range m.Statuses {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
+func (m *TreeBlockSlotRequest) Reset()         { *m = TreeBlockSlotRequest{} }
+func (m *TreeBlockSlotRequest) String() string { return proto.CompactTextString(m) }
+func (*TreeBlockSlotRequest) ProtoMessage()    {}
+func (*TreeBlockSlotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{44}
+}
+func (m *TreeBlockSlotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreeBlockSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreeBlockSlotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *TreeBlockSlotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeBlockSlotRequest.Merge(m, src)
+}
+func (m *TreeBlockSlotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreeBlockSlotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeBlockSlotRequest.DiscardUnknown(m)
 }
 
-func (m *ValidatorActivationResponse_Status) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_TreeBlockSlotRequest proto.InternalMessageInfo
+
+func (m *TreeBlockSlotRequest) GetSlotFrom() uint64 {
+	if m != nil {
+		return m.SlotFrom
 	}
-	return n
+	return 0
 }
 
-func (m *ExitedValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
+	if m != nil {
+		return m.SlotTo
 	}
-	return n
+	return 0
 }
 
-func (m *ExitedValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+type HeadUpdateResponse struct {
+	HeadRoot             []byte   `protobuf:"bytes,1,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"`
+	HeadSlot             uint64   `protobuf:"varint,2,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeadUpdateResponse) Reset()         { *m = HeadUpdateResponse{} }
+func (m *HeadUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*HeadUpdateResponse) ProtoMessage()    {}
+func (*HeadUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{45}
+}
+func (m *HeadUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *HeadUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadUpdateResponse.Merge(m, src)
+}
+func (m *HeadUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadUpdateResponse.DiscardUnknown(m)
 }
 
-func (m *ChainStartResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Started {
-		n += 2
-	}
-	if m.GenesisTime != 0 {
-		n += 1 + sovServices(uint64(m.GenesisTime))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_HeadUpdateResponse proto.InternalMessageInfo
+
+func (m *HeadUpdateResponse) GetHeadRoot() []byte {
+	if m != nil {
+		return m.HeadRoot
 	}
-	return n
+	return nil
 }
 
-func (m *ValidatorIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *HeadUpdateResponse) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
 	}
-	return n
+	return 0
 }
 
-func (m *ValidatorIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovServices(uint64(m.Index))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type SpecResponse struct {
+	// Config values keyed by their name in the spec config files, or by their Go field name for
+	// the values which are specific to Prysm.
+	Data                 map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *AssignmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochStart != 0 {
-		n += 1 + sovServices(uint64(m.EpochStart))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+func (m *SpecResponse) Reset()         { *m = SpecResponse{} }
+func (m *SpecResponse) String() string { return proto.CompactTextString(m) }
+func (*SpecResponse) ProtoMessage()    {}
+func (*SpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{46}
+}
+func (m *SpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *SpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecResponse.Merge(m, src)
+}
+func (m *SpecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecResponse.DiscardUnknown(m)
 }
 
-func (m *AssignmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_SpecResponse proto.InternalMessageInfo
+
+func (m *SpecResponse) GetData() map[string]string {
+	if m != nil {
+		return m.Data
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorAssignment) > 0 {
-		for _, e := range m.ValidatorAssignment {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
+	return nil
+}
+
+type AggregatedAttestationRequest struct {
+	AttestationDataRoot  []byte   `protobuf:"bytes,1,opt,name=attestation_data_root,json=attestationDataRoot,proto3" json:"attestation_data_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregatedAttestationRequest) Reset()         { *m = AggregatedAttestationRequest{} }
+func (m *AggregatedAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregatedAttestationRequest) ProtoMessage()    {}
+func (*AggregatedAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{47}
+}
+func (m *AggregatedAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *AggregatedAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedAttestationRequest.Merge(m, src)
+}
+func (m *AggregatedAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedAttestationRequest.DiscardUnknown(m)
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_AggregatedAttestationRequest proto.InternalMessageInfo
+
+func (m *AggregatedAttestationRequest) GetAttestationDataRoot() []byte {
+	if m != nil {
+		return m.AttestationDataRoot
 	}
-	var l int
-	_ = l
-	if len(m.Committee) > 0 {
-		l = 0
-		for _, e := range m.Committee {
-			l += sovServices(uint64(e))
-		}
-		n += 1 + sovServices(uint64(l)) + l
-	}
-	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.SigningRootRequest_ObjectType", SigningRootRequest_ObjectType_name, SigningRootRequest_ObjectType_value)
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*BlockRootRequest)(nil), "ethereum.beacon.rpc.v1.BlockRootRequest")
+	proto.RegisterType((*BlockRootResponse)(nil), "ethereum.beacon.rpc.v1.BlockRootResponse")
+	proto.RegisterType((*BeaconBlockRequest)(nil), "ethereum.beacon.rpc.v1.BeaconBlockRequest")
+	proto.RegisterType((*BlocksByRangeRequest)(nil), "ethereum.beacon.rpc.v1.BlocksByRangeRequest")
+	proto.RegisterType((*SigningRootRequest)(nil), "ethereum.beacon.rpc.v1.SigningRootRequest")
+	proto.RegisterType((*SigningRootResponse)(nil), "ethereum.beacon.rpc.v1.SigningRootResponse")
+	proto.RegisterType((*GenesisResponse)(nil), "ethereum.beacon.rpc.v1.GenesisResponse")
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BeaconStateChunk)(nil), "ethereum.beacon.rpc.v1.BeaconStateChunk")
+	proto.RegisterType((*DepositProofRequest)(nil), "ethereum.beacon.rpc.v1.DepositProofRequest")
+	proto.RegisterType((*DepositProofResponse)(nil), "ethereum.beacon.rpc.v1.DepositProofResponse")
+	proto.RegisterType((*IndividualVotesRequest)(nil), "ethereum.beacon.rpc.v1.IndividualVotesRequest")
+	proto.RegisterType((*IndividualVote)(nil), "ethereum.beacon.rpc.v1.IndividualVote")
+	proto.RegisterType((*IndividualVotesResponse)(nil), "ethereum.beacon.rpc.v1.IndividualVotesResponse")
+	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
+	proto.RegisterType((*StateRootResponse)(nil), "ethereum.beacon.rpc.v1.StateRootResponse")
+	proto.RegisterType((*ReconstructFullBlockRequest)(nil), "ethereum.beacon.rpc.v1.ReconstructFullBlockRequest")
+	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
+	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
+	proto.RegisterType((*AggregationRequest)(nil), "ethereum.beacon.rpc.v1.AggregationRequest")
+	proto.RegisterType((*AggregationResponse)(nil), "ethereum.beacon.rpc.v1.AggregationResponse")
+	proto.RegisterType((*AggregateSelectionResponse)(nil), "ethereum.beacon.rpc.v1.AggregateSelectionResponse")
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
+	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
+	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
+	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
+	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
+	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
+	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
+	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
+	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
+	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
+	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
+	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
+	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
+	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
+	proto.RegisterType((*ForkResponse)(nil), "ethereum.beacon.rpc.v1.ForkResponse")
+	proto.RegisterType((*CommitteeSubnetsSubscribeRequest)(nil), "ethereum.beacon.rpc.v1.CommitteeSubnetsSubscribeRequest")
+	proto.RegisterType((*CommitteeSubnetSubscription)(nil), "ethereum.beacon.rpc.v1.CommitteeSubnetSubscription")
+	proto.RegisterType((*ProposerDutiesRequest)(nil), "ethereum.beacon.rpc.v1.ProposerDutiesRequest")
+	proto.RegisterType((*ProposerDutiesResponse)(nil), "ethereum.beacon.rpc.v1.ProposerDutiesResponse")
+	proto.RegisterType((*ProposerDutiesResponse_Duty)(nil), "ethereum.beacon.rpc.v1.ProposerDutiesResponse.Duty")
+	proto.RegisterType((*SyncStatusResponse)(nil), "ethereum.beacon.rpc.v1.SyncStatusResponse")
+	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
+	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
+	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+	proto.RegisterType((*HeadUpdateResponse)(nil), "ethereum.beacon.rpc.v1.HeadUpdateResponse")
+	proto.RegisterType((*SpecResponse)(nil), "ethereum.beacon.rpc.v1.SpecResponse")
+	proto.RegisterMapType((map[string]string)(nil), "ethereum.beacon.rpc.v1.SpecResponse.DataEntry")
+	proto.RegisterType((*AggregatedAttestationRequest)(nil), "ethereum.beacon.rpc.v1.AggregatedAttestationRequest")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285)
+}
+
+var fileDescriptor_9eb4e94b85965285 = []byte{
+	// 3231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x1a, 0x4d, 0x73, 0xdb, 0xc6,
+	0x35, 0xa0, 0x64, 0x59, 0x7a, 0xa2, 0x24, 0x6a, 0x25, 0xcb, 0x34, 0xfc, 0x11, 0x17, 0x8e, 0xbf,
+	0x63, 0xca, 0xa6, 0xd3, 0xd4, 0x75, 0x9a, 0x66, 0x28, 0x91, 0xa6, 0x34, 0x72, 0x25, 0x05, 0xa0,
+	0xe5, 0xa4, 0x99, 0x14, 0x01, 0x81, 0x15, 0x85, 0x9a, 0x04, 0x68, 0x60, 0xa1, 0x86, 0x99, 0x69,
+	0x33, 0xb9, 0xb4, 0x69, 0x7b, 0x69, 0x0f, 0x9d, 0x1e, 0x3b, 0x9d, 0xce, 0xf4, 0xd0, 0x43, 0x67,
+	0x3a, 0x3d, 0xf4, 0x27, 0x34, 0xbd, 0xf5, 0x07, 0xf4, 0xd0, 0xc9, 0xbd, 0xff, 0xa1, 0xb3, 0x1f,
+	0x58, 0x02, 0x24, 0x21, 0x52, 0xee, 0xf4, 0x86, 0x7d, 0xfb, 0xde, 0xdb, 0x7d, 0x6f, 0xdf, 0xbe,
+	0xaf, 0x05, 0x68, 0xdd, 0xc0, 0x27, 0xfe, 0x7a, 0x13, 0x5b, 0xb6, 0xef, 0xad, 0x07, 0x5d, 0x7b,
+	0xfd, 0xf8, 0xc1, 0x7a, 0x88, 0x83, 0x63, 0xd7, 0xc6, 0x61, 0x89, 0x4d, 0xa2, 0x35, 0x4c, 0x8e,
+	0x70, 0x80, 0xa3, 0x4e, 0x89, 0xa3, 0x95, 0x82, 0xae, 0x5d, 0x3a, 0x7e, 0xa0, 0x5e, 0x69, 0xf9,
+	0x7e, 0xab, 0x8d, 0xd7, 0x19, 0x56, 0x33, 0x3a, 0x5c, 0x77, 0xa2, 0xc0, 0x22, 0xae, 0xef, 0x71,
+	0x3a, 0xf5, 0xe2, 0xe0, 0x3c, 0xee, 0x74, 0x49, 0x4f, 0x4c, 0xbe, 0x8e, 0xc9, 0xd1, 0xfa, 0xf1,
+	0x03, 0xab, 0xdd, 0x3d, 0xb2, 0x1e, 0x88, 0xf5, 0xcd, 0x66, 0xdb, 0xb7, 0x5f, 0x08, 0x84, 0x2b,
+	0x29, 0x04, 0x8b, 0x10, 0x1c, 0x92, 0x24, 0xf7, 0x4b, 0xa9, 0xf9, 0x63, 0xab, 0xed, 0x3a, 0x16,
+	0xf1, 0x03, 0x3e, 0xab, 0xd9, 0x90, 0xdf, 0xa0, 0xcc, 0x74, 0xfc, 0x32, 0xc2, 0x21, 0x41, 0x08,
+	0xa6, 0xc3, 0xb6, 0x4f, 0x8a, 0xca, 0x55, 0xe5, 0xd6, 0xb4, 0xce, 0xbe, 0xd1, 0x35, 0x58, 0x08,
+	0x2c, 0xcf, 0xb1, 0x7c, 0x33, 0xc0, 0xc7, 0xd8, 0x6a, 0x17, 0x73, 0x57, 0x95, 0x5b, 0x79, 0x3d,
+	0xcf, 0x81, 0x3a, 0x83, 0x21, 0x15, 0x66, 0x5b, 0x81, 0x75, 0x78, 0xe8, 0x12, 0xb7, 0x38, 0xc5,
+	0xe6, 0xe5, 0x58, 0xbb, 0x01, 0x05, 0xbe, 0x88, 0xef, 0x93, 0x13, 0x16, 0xd2, 0xca, 0xb0, 0x9c,
+	0xc0, 0x0b, 0xbb, 0xbe, 0x17, 0x62, 0x74, 0x19, 0x80, 0x89, 0x6b, 0x06, 0xbe, 0x40, 0xcf, 0xeb,
+	0x73, 0xcd, 0x18, 0x4d, 0xfb, 0x08, 0xd0, 0x06, 0x53, 0x4a, 0x4a, 0x8c, 0xd7, 0x87, 0x89, 0xb6,
+	0x5e, 0x4b, 0x90, 0xa1, 0x55, 0xb1, 0x3c, 0x15, 0x65, 0x7a, 0xeb, 0x35, 0xbe, 0x81, 0x8d, 0x45,
+	0xc8, 0xbf, 0x8c, 0x70, 0xd0, 0x33, 0x0f, 0xdd, 0x36, 0xc1, 0x81, 0x66, 0xc2, 0x2a, 0x63, 0x1b,
+	0x6e, 0xf4, 0x74, 0xcb, 0x6b, 0xe1, 0x98, 0xfd, 0x65, 0x80, 0x90, 0x58, 0x01, 0x31, 0x13, 0x22,
+	0xcc, 0x31, 0x88, 0xd1, 0x66, 0xcc, 0xcf, 0xd8, 0x7e, 0xe4, 0x09, 0xee, 0x3a, 0x1f, 0x30, 0x89,
+	0x09, 0xee, 0x16, 0xa7, 0x84, 0xc4, 0x04, 0x77, 0xb5, 0x5f, 0xe4, 0x00, 0x19, 0x6e, 0xcb, 0x73,
+	0xbd, 0x56, 0x52, 0x39, 0x07, 0x30, 0xef, 0x37, 0x7f, 0x88, 0x6d, 0x62, 0x92, 0x5e, 0x17, 0xb3,
+	0x05, 0x16, 0xcb, 0xdf, 0x2c, 0x8d, 0xb6, 0xaf, 0xd2, 0x30, 0x83, 0xd2, 0x1e, 0xa3, 0x6e, 0xf4,
+	0xba, 0x58, 0x07, 0x5f, 0x7e, 0xa3, 0x35, 0x98, 0xe1, 0x23, 0x71, 0x84, 0x62, 0x44, 0x37, 0x8c,
+	0xbb, 0xbe, 0x7d, 0x24, 0xf6, 0xc6, 0x07, 0x9a, 0x07, 0xd0, 0xe7, 0x83, 0xe6, 0xe1, 0xec, 0xb3,
+	0xdd, 0x9d, 0xdd, 0xbd, 0xe7, 0xbb, 0x85, 0xd7, 0xd0, 0x2a, 0x14, 0x2a, 0x8d, 0x46, 0xcd, 0x68,
+	0x54, 0x1a, 0xdb, 0x7b, 0xbb, 0x66, 0xb5, 0xd2, 0xa8, 0x14, 0x14, 0x54, 0x80, 0xfc, 0x46, 0xad,
+	0xb2, 0xb9, 0xb7, 0x6b, 0x6e, 0x3c, 0xdd, 0xdb, 0xdc, 0x29, 0xe4, 0xd0, 0x79, 0x58, 0x49, 0x42,
+	0xcc, 0xad, 0x5a, 0xa5, 0x5a, 0xd3, 0x0b, 0x53, 0x08, 0xc1, 0xe2, 0xc1, 0xde, 0xd3, 0x67, 0xbb,
+	0x8d, 0x8a, 0xfe, 0xa1, 0x59, 0xfb, 0x60, 0xbb, 0x51, 0x98, 0xd6, 0x6c, 0x58, 0x49, 0x89, 0x22,
+	0x0c, 0xe0, 0x1b, 0x90, 0x0f, 0x39, 0x38, 0x69, 0x02, 0xf3, 0x61, 0x1f, 0x15, 0xdd, 0x86, 0x02,
+	0x1d, 0x5a, 0x24, 0x0a, 0xb0, 0xe9, 0xf8, 0x1d, 0xcb, 0xf5, 0x84, 0xee, 0x97, 0x24, 0xbc, 0xca,
+	0xc0, 0xda, 0x1f, 0x15, 0x58, 0xaa, 0x63, 0x0f, 0x87, 0x6e, 0x98, 0x5c, 0xa1, 0xc5, 0x41, 0x26,
+	0x71, 0x3b, 0x58, 0x1c, 0xe8, 0xbc, 0x80, 0x35, 0xdc, 0x0e, 0x46, 0x6f, 0xc3, 0xf9, 0x18, 0x45,
+	0x5e, 0xa1, 0x90, 0xef, 0x87, 0xab, 0xf2, 0x9c, 0x98, 0x3e, 0x90, 0xb3, 0x6c, 0x67, 0x8f, 0xa0,
+	0xe8, 0xe0, 0xae, 0x1f, 0xba, 0xc4, 0xb4, 0x7d, 0x8f, 0x04, 0x96, 0x4d, 0x4c, 0xcb, 0x71, 0x02,
+	0x1c, 0x86, 0xe2, 0x9a, 0xac, 0x89, 0xf9, 0x4d, 0x31, 0x5d, 0xe1, 0xb3, 0x7d, 0xc3, 0x36, 0x88,
+	0x45, 0x70, 0xc2, 0xb0, 0xe9, 0xf5, 0xc6, 0x03, 0x86, 0xcd, 0x60, 0xa7, 0x30, 0xec, 0x8f, 0xa1,
+	0x90, 0x60, 0xbe, 0x79, 0x14, 0x79, 0x2f, 0xa8, 0x7d, 0x3a, 0x16, 0xb1, 0x84, 0x7e, 0xd9, 0x37,
+	0x33, 0x98, 0xc3, 0xc3, 0x10, 0xc7, 0xa6, 0x2c, 0x46, 0xf4, 0x02, 0x10, 0x9f, 0x58, 0x6d, 0x33,
+	0x74, 0x3f, 0xc3, 0xc2, 0x6a, 0xe6, 0x18, 0xc4, 0x70, 0x3f, 0xc3, 0xda, 0x63, 0x58, 0xa9, 0x72,
+	0xa9, 0xf6, 0x03, 0xdf, 0x3f, 0x8c, 0x37, 0x7f, 0x0d, 0x16, 0x62, 0x65, 0xb8, 0x9e, 0x83, 0x3f,
+	0x15, 0x8a, 0xce, 0x0b, 0xe0, 0x36, 0x85, 0x69, 0x5f, 0x2a, 0xb0, 0x9a, 0x26, 0x16, 0xa7, 0x84,
+	0x60, 0xba, 0x8d, 0xad, 0xc3, 0x78, 0x7f, 0xf4, 0x9b, 0x1a, 0x6e, 0x97, 0x22, 0x15, 0x73, 0x57,
+	0xa7, 0x6e, 0xe5, 0x75, 0x3e, 0xa0, 0xe7, 0x19, 0xaf, 0xc3, 0xd4, 0xc4, 0x15, 0x3d, 0x2f, 0x60,
+	0x4c, 0x4d, 0x89, 0xad, 0xf0, 0xab, 0x3a, 0x9d, 0xda, 0xca, 0x26, 0x85, 0x69, 0x5b, 0xb0, 0xb6,
+	0xed, 0x39, 0xee, 0xb1, 0xeb, 0x44, 0x56, 0xfb, 0xc0, 0x27, 0x38, 0x8c, 0x25, 0x91, 0x17, 0x46,
+	0x49, 0x5c, 0x18, 0x54, 0x84, 0xb3, 0xae, 0xe7, 0xd0, 0x88, 0xc0, 0xf6, 0x33, 0xad, 0xc7, 0x43,
+	0xed, 0x3f, 0x39, 0x58, 0x4c, 0xb3, 0x42, 0x37, 0x61, 0x49, 0x5a, 0x52, 0x4a, 0x1d, 0x8b, 0x12,
+	0xcc, 0x14, 0x82, 0xee, 0x02, 0x72, 0x43, 0xd3, 0xb2, 0x89, 0x7b, 0x8c, 0x4d, 0xd7, 0x33, 0xf9,
+	0xc2, 0xf4, 0x3c, 0x66, 0xf5, 0x25, 0x37, 0xac, 0xb0, 0x89, 0x6d, 0xaf, 0xc6, 0xb6, 0x70, 0x19,
+	0xc0, 0x0d, 0xcd, 0xb0, 0x6d, 0x85, 0x47, 0xd8, 0x61, 0x82, 0xcf, 0xea, 0x73, 0x6e, 0x68, 0x70,
+	0x00, 0xd5, 0xcc, 0xb1, 0x4f, 0xb0, 0x63, 0x86, 0x7e, 0x14, 0xd8, 0x98, 0x49, 0x3d, 0xab, 0xcf,
+	0x33, 0x98, 0xc1, 0x40, 0x7d, 0x14, 0x62, 0x05, 0x2d, 0x4c, 0x8a, 0x67, 0x12, 0x28, 0x0d, 0x06,
+	0xa2, 0x8b, 0x70, 0x94, 0x23, 0x6c, 0x39, 0xc5, 0x19, 0xbe, 0x08, 0x83, 0x6c, 0x61, 0xcb, 0x41,
+	0x77, 0x61, 0x19, 0x1f, 0x1e, 0x62, 0xbe, 0xe1, 0xa6, 0xd5, 0xb6, 0x3c, 0x1b, 0x17, 0xcf, 0x32,
+	0xd9, 0x0a, 0x72, 0x62, 0x83, 0xc3, 0xd1, 0x75, 0x58, 0x74, 0x3d, 0xbb, 0x1d, 0x85, 0xae, 0xef,
+	0x71, 0x77, 0x3a, 0xcb, 0x30, 0x17, 0x24, 0x94, 0xb9, 0xd4, 0x7b, 0x80, 0xfa, 0x68, 0x8e, 0x1b,
+	0x12, 0xc6, 0x74, 0x8e, 0xa1, 0x2e, 0xcb, 0x99, 0xaa, 0x98, 0xd0, 0x3a, 0x70, 0x7e, 0xe8, 0xe4,
+	0x84, 0x19, 0x8d, 0x3e, 0xba, 0xef, 0xc0, 0x19, 0x2a, 0x00, 0x3f, 0xb8, 0xf9, 0xf2, 0x8d, 0x2c,
+	0x5f, 0x9b, 0xe6, 0xaa, 0x73, 0x22, 0xed, 0x3e, 0x2c, 0xed, 0x07, 0x7e, 0xd7, 0x0f, 0xf1, 0xa4,
+	0x61, 0xab, 0x0c, 0xcb, 0x46, 0x7c, 0x67, 0x93, 0x34, 0x83, 0x97, 0x3b, 0x71, 0xb5, 0xb5, 0x3f,
+	0x2b, 0x70, 0x51, 0xc7, 0xb6, 0xef, 0x85, 0x24, 0x88, 0x6c, 0xf2, 0x24, 0x6a, 0xb7, 0x53, 0x41,
+	0xcf, 0x80, 0x85, 0x66, 0x9b, 0x5a, 0x92, 0xc3, 0x13, 0x04, 0xc6, 0x61, 0xbe, 0x5c, 0xea, 0xcb,
+	0x82, 0xc9, 0x51, 0x29, 0x4e, 0x05, 0x58, 0xd8, 0xc0, 0x4e, 0x22, 0x78, 0xd2, 0xc3, 0xc3, 0x81,
+	0x9e, 0x17, 0x4c, 0x18, 0x0c, 0x3d, 0x86, 0xe9, 0xa6, 0xef, 0xf4, 0x98, 0xbd, 0xa5, 0xf4, 0x92,
+	0xe2, 0x95, 0xe0, 0xb2, 0xe1, 0x3b, 0x3d, 0x9d, 0xd1, 0x68, 0x3f, 0x57, 0x00, 0x55, 0xfa, 0x09,
+	0x49, 0x22, 0x7a, 0x76, 0xa3, 0x66, 0xdb, 0xb5, 0xcd, 0x17, 0xb8, 0x17, 0x8b, 0xc9, 0x21, 0x3b,
+	0xb8, 0x87, 0xce, 0xc3, 0xd9, 0xae, 0x6f, 0x9b, 0x4d, 0x57, 0x46, 0xa9, 0xae, 0x6f, 0x6f, 0xb8,
+	0xfd, 0x94, 0x61, 0x2a, 0x91, 0x9b, 0xdc, 0x84, 0x25, 0xdb, 0xef, 0x74, 0x5c, 0x42, 0x30, 0x16,
+	0xb7, 0x88, 0xdf, 0xe4, 0x45, 0x09, 0xe6, 0x6e, 0xe5, 0x0d, 0x58, 0xe4, 0x5b, 0x49, 0xfa, 0x93,
+	0x84, 0x9e, 0xd9, 0xb7, 0xf6, 0x5b, 0xba, 0xe3, 0x56, 0x2b, 0xc0, 0xad, 0xd4, 0x8e, 0x47, 0x65,
+	0x45, 0x23, 0x56, 0xce, 0x8d, 0x5a, 0x79, 0x40, 0xdc, 0xa9, 0x41, 0x71, 0xaf, 0xc3, 0x22, 0xe5,
+	0x67, 0xca, 0x40, 0xc5, 0x04, 0xc8, 0xeb, 0x0b, 0x14, 0x6a, 0xc4, 0x40, 0xed, 0x36, 0xac, 0xa4,
+	0x36, 0x76, 0x82, 0x10, 0x5f, 0x28, 0xa0, 0xc6, 0xb8, 0xd8, 0xc0, 0x6d, 0x6c, 0xa7, 0x48, 0x6c,
+	0x58, 0xb1, 0xe2, 0x59, 0xd3, 0xf2, 0x1c, 0x93, 0x7b, 0x50, 0x6e, 0x2c, 0x0f, 0x33, 0x0e, 0x58,
+	0xf2, 0x4b, 0x9c, 0x67, 0xc5, 0x73, 0xb8, 0x87, 0x5e, 0x96, 0xfc, 0x62, 0x90, 0xa6, 0xc3, 0x45,
+	0x19, 0x09, 0xf7, 0x71, 0x70, 0xe8, 0x07, 0x1d, 0x7a, 0x31, 0x4f, 0x52, 0xe8, 0xeb, 0x30, 0xdf,
+	0xd7, 0x53, 0x28, 0x3c, 0x3a, 0x48, 0x45, 0x85, 0xda, 0x6f, 0x72, 0x70, 0x69, 0x34, 0x53, 0x21,
+	0x99, 0x0a, 0xb3, 0xc2, 0xdd, 0x84, 0x45, 0x85, 0x39, 0x60, 0x39, 0xa6, 0x29, 0x02, 0x8f, 0x58,
+	0xfd, 0xf0, 0x1d, 0xa7, 0x08, 0x0c, 0xde, 0x8f, 0xdb, 0x34, 0xd6, 0x73, 0x54, 0xe1, 0x73, 0x13,
+	0x14, 0xdc, 0xf4, 0xce, 0xb1, 0x69, 0xee, 0x78, 0x13, 0x74, 0xf7, 0x00, 0x75, 0xdc, 0x30, 0xa4,
+	0x89, 0x4a, 0x82, 0x64, 0x9a, 0xc9, 0xb1, 0x2c, 0x66, 0x12, 0xe8, 0x75, 0xb8, 0x6a, 0x1d, 0xe3,
+	0xc0, 0x6a, 0xe1, 0xa1, 0x85, 0xa4, 0xd7, 0xa4, 0xce, 0x37, 0xa7, 0x5f, 0x16, 0x78, 0x03, 0x2b,
+	0x0a, 0x17, 0xaa, 0xbd, 0x0b, 0xaa, 0x84, 0x31, 0x94, 0x94, 0xed, 0x0e, 0xa8, 0x55, 0x19, 0x52,
+	0xeb, 0xef, 0x72, 0x70, 0x71, 0x24, 0xbd, 0xd0, 0xea, 0xdb, 0x70, 0xce, 0xe2, 0x50, 0xec, 0x98,
+	0x43, 0xac, 0x36, 0x72, 0x45, 0x45, 0x5f, 0x91, 0x08, 0xfb, 0x92, 0x2f, 0x3a, 0x80, 0x59, 0x6a,
+	0x28, 0x51, 0x28, 0xbd, 0xea, 0xe3, 0x2c, 0xaf, 0x7a, 0xc2, 0xf2, 0x25, 0x83, 0xf1, 0xd0, 0x25,
+	0x2f, 0xb5, 0x0b, 0x33, 0x1c, 0x36, 0xce, 0x91, 0xd4, 0x61, 0x86, 0x13, 0x09, 0xe7, 0xb5, 0x3e,
+	0x76, 0x79, 0xb1, 0x96, 0x58, 0x5a, 0x17, 0xe4, 0xda, 0x63, 0x38, 0x5f, 0xfb, 0xd4, 0x25, 0xd8,
+	0x49, 0x24, 0x77, 0x93, 0x6a, 0xf7, 0x1d, 0x28, 0x0e, 0xd3, 0x0a, 0xcd, 0x8e, 0x25, 0x7e, 0x1f,
+	0xd0, 0xe6, 0x91, 0xe5, 0xd2, 0x2c, 0x2d, 0xe8, 0x3b, 0xae, 0x22, 0x9c, 0x65, 0xb5, 0x06, 0x76,
+	0x98, 0xcc, 0xb3, 0x7a, 0x3c, 0x1c, 0x4a, 0x64, 0x73, 0x43, 0x89, 0xac, 0xf6, 0x36, 0x9c, 0x3b,
+	0x48, 0xe5, 0x17, 0x93, 0x79, 0x65, 0xad, 0x04, 0x6b, 0x83, 0x74, 0xfd, 0x80, 0x9a, 0x4c, 0x5f,
+	0xf8, 0x40, 0x7b, 0x06, 0xcb, 0x95, 0x90, 0xfa, 0xb4, 0x0e, 0xf6, 0x48, 0x42, 0x5b, 0x2c, 0xdc,
+	0x9a, 0x6c, 0xc3, 0x82, 0x00, 0x18, 0x88, 0x89, 0x38, 0xde, 0x07, 0xfc, 0x6a, 0x0a, 0x50, 0x92,
+	0xaf, 0xd8, 0xc3, 0x4b, 0x58, 0xed, 0x5f, 0x1e, 0x4b, 0xce, 0x33, 0x95, 0xce, 0x97, 0xbf, 0x9b,
+	0x75, 0xf0, 0xc3, 0x9c, 0x12, 0xa6, 0xd8, 0x9f, 0x5b, 0x39, 0x1e, 0x06, 0xaa, 0x3f, 0xcd, 0xc1,
+	0xca, 0x08, 0x64, 0x74, 0x09, 0xe6, 0x64, 0x00, 0x10, 0x5e, 0xa8, 0x0f, 0x98, 0x3c, 0x6a, 0x5c,
+	0x83, 0x05, 0x5e, 0xcb, 0xe3, 0xc0, 0x4c, 0x44, 0xbd, 0x7c, 0x0c, 0x34, 0x44, 0x65, 0xde, 0xe5,
+	0x79, 0x87, 0x40, 0x12, 0x59, 0x6c, 0x0c, 0x64, 0x48, 0xe9, 0x83, 0x3d, 0x33, 0x78, 0x4b, 0xde,
+	0x93, 0xb7, 0x64, 0x86, 0x95, 0x99, 0x37, 0x27, 0xbd, 0x25, 0xf1, 0xed, 0xf8, 0x5b, 0x0e, 0xce,
+	0x67, 0xdc, 0xa0, 0x04, 0x73, 0xe5, 0x95, 0x98, 0xa3, 0x6f, 0xc3, 0x05, 0x4c, 0x8e, 0x1e, 0x98,
+	0x71, 0xb2, 0xce, 0x73, 0x2a, 0x2f, 0xea, 0x34, 0x71, 0x20, 0x34, 0x47, 0xdb, 0x2e, 0x0f, 0x44,
+	0xc5, 0xc0, 0x32, 0x90, 0x5d, 0x36, 0x8b, 0xde, 0x82, 0xb5, 0x7e, 0xb5, 0x91, 0xca, 0x30, 0xb9,
+	0x2a, 0x57, 0x65, 0xd9, 0x91, 0x4c, 0x34, 0x6f, 0x43, 0xc1, 0x92, 0x4e, 0x48, 0xe4, 0xda, 0x5c,
+	0xab, 0x4b, 0x7d, 0x38, 0xcf, 0xb5, 0xdf, 0x83, 0x4b, 0x8c, 0x01, 0x45, 0x74, 0x3d, 0x33, 0x41,
+	0xf6, 0x32, 0xc2, 0x11, 0x77, 0xde, 0xd3, 0xfa, 0x85, 0x18, 0x67, 0xdb, 0xeb, 0x7b, 0xb7, 0xf7,
+	0x29, 0x82, 0xf6, 0x2e, 0x2c, 0xf0, 0xaa, 0xf4, 0xe4, 0xb2, 0x62, 0x0d, 0x66, 0x12, 0x35, 0x6d,
+	0x5e, 0x17, 0x23, 0xed, 0x1d, 0x58, 0x8c, 0xc9, 0x85, 0xba, 0x47, 0xd5, 0xc1, 0xca, 0xe8, 0x3a,
+	0xf8, 0x53, 0xc8, 0x3f, 0xf1, 0x83, 0x17, 0x49, 0xd2, 0x6e, 0x80, 0x8f, 0x5d, 0x3f, 0x0a, 0xcd,
+	0x63, 0x1c, 0x50, 0x7d, 0x08, 0x27, 0xb0, 0x14, 0xc3, 0x0f, 0x38, 0x98, 0xd9, 0x70, 0x14, 0x04,
+	0xd8, 0x23, 0x12, 0x93, 0x6f, 0x6c, 0x51, 0x80, 0x63, 0xc4, 0xd1, 0x6d, 0x85, 0x1f, 0xc3, 0xd5,
+	0xcd, 0xd8, 0xd6, 0x8d, 0xa8, 0xe9, 0x61, 0x12, 0x1a, 0x51, 0x33, 0xb4, 0x03, 0xb7, 0x29, 0xf3,
+	0x83, 0x0f, 0x61, 0x21, 0xe4, 0xb0, 0x2e, 0x55, 0x57, 0x28, 0x2e, 0xf2, 0xc3, 0x2c, 0xf3, 0x19,
+	0x60, 0x68, 0x24, 0x68, 0xf5, 0x34, 0x27, 0xed, 0x73, 0xb8, 0x78, 0x02, 0xf6, 0xff, 0x96, 0xea,
+	0x5d, 0x83, 0x05, 0x5a, 0xaa, 0x89, 0x6c, 0xc8, 0x0f, 0x44, 0x01, 0x96, 0x77, 0xc3, 0x8a, 0x84,
+	0x69, 0xf7, 0xe0, 0x9c, 0x28, 0x16, 0x82, 0x6a, 0x44, 0xdc, 0x31, 0x45, 0xa5, 0xf6, 0x77, 0x05,
+	0xd6, 0x06, 0xf1, 0xc5, 0x99, 0xed, 0xc0, 0x8c, 0xc3, 0x20, 0xe3, 0xd4, 0x33, 0x9a, 0xbe, 0x54,
+	0x8d, 0x48, 0x4f, 0x17, 0x2c, 0xd4, 0x4f, 0x60, 0x9a, 0x8e, 0x47, 0x2a, 0xe0, 0x3a, 0x2c, 0x4a,
+	0x3f, 0x93, 0x94, 0x5f, 0x7a, 0x9f, 0x49, 0x32, 0x5d, 0xed, 0x1f, 0x0a, 0x20, 0xa3, 0xe7, 0xd9,
+	0x03, 0x3e, 0x82, 0x86, 0xb3, 0x9e, 0x67, 0xbb, 0x5e, 0x4b, 0x86, 0x33, 0x3e, 0x44, 0x17, 0x61,
+	0x8e, 0x56, 0x98, 0x66, 0xbf, 0xa1, 0xa1, 0xcf, 0x52, 0x00, 0xbb, 0xa8, 0x6f, 0x02, 0x3a, 0x72,
+	0x5b, 0x47, 0x38, 0x24, 0xe6, 0x0b, 0xcf, 0xff, 0x51, 0xea, 0x6a, 0x17, 0xc4, 0xcc, 0x0e, 0x9d,
+	0x60, 0xd8, 0xbb, 0xb0, 0x86, 0x43, 0xe2, 0x76, 0x58, 0x12, 0x43, 0x63, 0xa3, 0x49, 0x7c, 0x93,
+	0xae, 0xc3, 0x2e, 0xf7, 0x7c, 0xf9, 0x42, 0x89, 0x37, 0x61, 0x4b, 0x71, 0x13, 0xb6, 0x54, 0x15,
+	0x4d, 0x5a, 0x7d, 0x45, 0x12, 0xd2, 0x00, 0xda, 0xf0, 0xa9, 0x08, 0xda, 0xaf, 0x73, 0xa2, 0x57,
+	0xd9, 0x08, 0x70, 0x3f, 0x01, 0x7d, 0x02, 0xd3, 0x24, 0x10, 0x6e, 0x7f, 0xbe, 0x5c, 0xce, 0x3a,
+	0x8e, 0x21, 0xc2, 0x12, 0x1d, 0xec, 0xfa, 0x0e, 0xd6, 0x19, 0xbd, 0xfa, 0x57, 0x05, 0x66, 0x63,
+	0x10, 0x7a, 0x04, 0x67, 0x92, 0xe5, 0x9c, 0x36, 0xbe, 0x04, 0xd3, 0x39, 0xc1, 0x40, 0x0d, 0x9a,
+	0x1b, 0xa8, 0x41, 0x69, 0xbe, 0xda, 0xb5, 0x02, 0xe2, 0xda, 0x6e, 0x97, 0xa9, 0x85, 0x17, 0xc0,
+	0x5c, 0x83, 0xcb, 0xc9, 0x19, 0x56, 0x40, 0xd3, 0xd8, 0x2c, 0x32, 0x68, 0x86, 0xc7, 0x9d, 0x22,
+	0x6f, 0x03, 0x31, 0x04, 0xed, 0x29, 0xac, 0xd2, 0x4d, 0xb3, 0x2d, 0x50, 0xa5, 0xc7, 0x76, 0x7d,
+	0x11, 0xe6, 0x58, 0x85, 0x73, 0x18, 0xf8, 0x1d, 0x61, 0x56, 0xb3, 0x14, 0xf0, 0x24, 0xf0, 0x3b,
+	0xb4, 0xda, 0x63, 0x93, 0xc4, 0x8f, 0x5b, 0x4c, 0x74, 0xd8, 0xf0, 0xb5, 0x5d, 0x40, 0xb4, 0x20,
+	0x7d, 0xd6, 0x75, 0x2c, 0x22, 0x15, 0x25, 0x4d, 0x22, 0x51, 0xf4, 0x30, 0x93, 0x60, 0x02, 0x9d,
+	0x64, 0x2f, 0xda, 0x2f, 0x15, 0xc8, 0x1b, 0x5d, 0x6c, 0x4b, 0x56, 0x1b, 0xb2, 0xdf, 0x35, 0x95,
+	0xae, 0x92, 0x07, 0xba, 0xab, 0x09, 0x9a, 0x52, 0xd5, 0x22, 0x56, 0xcd, 0x23, 0x41, 0x8f, 0xf7,
+	0xc7, 0xd4, 0x6f, 0xc1, 0x9c, 0x04, 0xa1, 0x02, 0x4c, 0xc5, 0xa9, 0xd3, 0x9c, 0x4e, 0x3f, 0xe9,
+	0x8d, 0x3e, 0xb6, 0xda, 0x11, 0x4f, 0xc4, 0xe6, 0x74, 0x3e, 0x78, 0x9c, 0x7b, 0xa4, 0x68, 0x3a,
+	0x5c, 0x92, 0x25, 0x95, 0x33, 0xa2, 0x46, 0x2e, 0xc3, 0xb9, 0x44, 0x2b, 0xdf, 0xa4, 0x8b, 0x25,
+	0x65, 0x5e, 0x49, 0x4c, 0xd2, 0x0d, 0x50, 0xf1, 0xef, 0x6c, 0xc1, 0x82, 0x0c, 0xa3, 0xba, 0xdf,
+	0x1e, 0x68, 0xd9, 0xe6, 0x61, 0x96, 0xb7, 0x6c, 0x6b, 0x7a, 0x41, 0xa1, 0xa3, 0x7d, 0x7d, 0x6f,
+	0x7f, 0xcf, 0xa8, 0xe9, 0x85, 0x1c, 0x5a, 0x04, 0xa8, 0xd4, 0xeb, 0x7a, 0xad, 0x5e, 0x69, 0xec,
+	0xe9, 0x85, 0xa9, 0x3b, 0xbf, 0x57, 0x60, 0x69, 0x20, 0x22, 0xd3, 0x8e, 0xad, 0x60, 0x66, 0xd2,
+	0xb6, 0xef, 0x33, 0x83, 0xb7, 0x81, 0xab, 0xb5, 0xfd, 0x3d, 0x63, 0xbb, 0x61, 0xea, 0xb5, 0xcd,
+	0xda, 0xf6, 0x41, 0xad, 0x5a, 0x50, 0x28, 0xe6, 0x7e, 0x6d, 0xb7, 0xba, 0xbd, 0x5b, 0x37, 0x2b,
+	0x9b, 0x8d, 0xed, 0x83, 0x5a, 0x21, 0x87, 0x00, 0x66, 0xc4, 0x37, 0xeb, 0xfd, 0x6e, 0xef, 0x6e,
+	0x37, 0xb6, 0x2b, 0x8d, 0x5a, 0x55, 0xf4, 0x7e, 0x69, 0xeb, 0xf8, 0xf9, 0x76, 0x63, 0xab, 0xaa,
+	0x57, 0x9e, 0x57, 0x36, 0x9e, 0xd6, 0x0a, 0x67, 0x28, 0x05, 0x9d, 0xab, 0x55, 0x0b, 0x33, 0x94,
+	0x82, 0x7f, 0x9b, 0xc6, 0xd3, 0x8a, 0xb1, 0x55, 0xab, 0x16, 0xce, 0x96, 0xff, 0xa5, 0xc0, 0x52,
+	0x25, 0x4e, 0x86, 0xf8, 0x43, 0x0c, 0x3a, 0x02, 0x24, 0x14, 0x98, 0x50, 0x29, 0xba, 0x93, 0x99,
+	0xfe, 0x0d, 0xe9, 0x5d, 0xcd, 0x6a, 0x70, 0x54, 0xd2, 0xfa, 0x46, 0x26, 0x2c, 0x1b, 0x51, 0xb3,
+	0xe3, 0xa6, 0x16, 0xd2, 0xc6, 0x13, 0xab, 0x37, 0x4e, 0xde, 0x4c, 0x6c, 0x69, 0xe5, 0xaf, 0x14,
+	0xd9, 0x53, 0x92, 0xe2, 0x7d, 0x00, 0x79, 0xb1, 0x4f, 0xde, 0x9b, 0x79, 0xe3, 0x44, 0x07, 0x13,
+	0x8b, 0x34, 0x81, 0xc3, 0x40, 0x1f, 0x41, 0x5e, 0x2c, 0xc6, 0xc7, 0x13, 0xd0, 0xa8, 0x37, 0xc7,
+	0x44, 0x1b, 0x29, 0xca, 0x97, 0xd3, 0xb0, 0xdc, 0x8f, 0x7f, 0xb1, 0x30, 0x01, 0x9c, 0x17, 0x1a,
+	0x1c, 0x6c, 0x1e, 0x9c, 0x70, 0x60, 0x43, 0xad, 0x19, 0xf5, 0xee, 0x44, 0xb8, 0xe2, 0xca, 0x7f,
+	0x0e, 0x97, 0x07, 0xd6, 0x94, 0xed, 0x91, 0xd3, 0xaf, 0x5c, 0x1e, 0x87, 0x3b, 0xa2, 0xf7, 0xf2,
+	0x33, 0x05, 0xae, 0xf1, 0x1d, 0xf0, 0xee, 0x5b, 0xd6, 0x3e, 0x5e, 0xa5, 0x0d, 0x73, 0x3a, 0x55,
+	0x10, 0x28, 0xd6, 0x31, 0x19, 0xe9, 0x83, 0xd0, 0x5b, 0x63, 0x25, 0x1b, 0xe1, 0xb2, 0xd4, 0x09,
+	0xac, 0xbf, 0xfc, 0xa7, 0x33, 0xb0, 0xc0, 0x93, 0x90, 0xd8, 0x0c, 0x3e, 0x86, 0xbc, 0x41, 0x02,
+	0x6c, 0x75, 0x38, 0x18, 0xbd, 0x91, 0xc1, 0x25, 0x95, 0x2a, 0xa9, 0xd7, 0xc7, 0x60, 0x71, 0x21,
+	0xef, 0x2b, 0xa8, 0x03, 0x17, 0x64, 0x72, 0x39, 0x98, 0x75, 0xa2, 0x47, 0x13, 0xa6, 0x93, 0x43,
+	0xf9, 0xa9, 0xba, 0x36, 0x94, 0x2e, 0xd4, 0xe8, 0x9b, 0x2d, 0x0a, 0x60, 0xb9, 0x8e, 0x49, 0x3a,
+	0xdd, 0x42, 0xf7, 0x26, 0x4d, 0xcb, 0x38, 0xef, 0xd2, 0xe9, 0xb2, 0x38, 0xd4, 0x86, 0x73, 0x9b,
+	0x7e, 0xa7, 0x1b, 0x11, 0x11, 0x79, 0xe5, 0x53, 0xd0, 0xad, 0x49, 0x1b, 0xbf, 0xea, 0xed, 0xcc,
+	0xe0, 0x37, 0xd4, 0xa3, 0x8e, 0x60, 0x75, 0x54, 0x0f, 0x1a, 0x65, 0xe6, 0x9e, 0x27, 0x74, 0xac,
+	0xd5, 0x89, 0x77, 0x88, 0x02, 0x58, 0x91, 0x0e, 0x2a, 0xd1, 0x9d, 0x7e, 0xa5, 0x55, 0xb3, 0x7c,
+	0xfc, 0xa0, 0xdf, 0xfa, 0xc3, 0x5c, 0xfc, 0x04, 0xc7, 0x9b, 0x30, 0xc2, 0x62, 0x6d, 0xc8, 0xd7,
+	0x31, 0x91, 0x0f, 0xd5, 0xe8, 0x56, 0xd6, 0x1e, 0x06, 0xdf, 0xbc, 0xd5, 0xdb, 0x13, 0x60, 0x0a,
+	0x35, 0x7f, 0x02, 0xb3, 0xf1, 0x22, 0xd9, 0x4e, 0x69, 0xf8, 0xe1, 0xfb, 0x14, 0x1a, 0xfd, 0x1e,
+	0x40, 0x1d, 0x13, 0xf1, 0x14, 0x8a, 0x32, 0x0c, 0x3a, 0xdb, 0xc9, 0x0f, 0xbe, 0xa1, 0xfe, 0x00,
+	0x16, 0xea, 0x98, 0xf0, 0xe2, 0x92, 0x45, 0xc8, 0xeb, 0x59, 0x94, 0xa9, 0x92, 0x57, 0xbd, 0x31,
+	0x0e, 0x4d, 0xf0, 0xaf, 0xc3, 0xd9, 0x3a, 0x26, 0xb4, 0x64, 0xcd, 0xdc, 0x6b, 0x66, 0x38, 0x4c,
+	0x15, 0xba, 0x2f, 0xd8, 0x15, 0x4d, 0xbc, 0x7e, 0x1a, 0xc6, 0xf7, 0xc7, 0xa9, 0x38, 0xf9, 0x04,
+	0xab, 0xde, 0x9a, 0x00, 0x97, 0xbd, 0xa8, 0xde, 0x57, 0x50, 0x9b, 0x3e, 0x36, 0x93, 0xe4, 0x73,
+	0x26, 0xca, 0xf4, 0xd2, 0x23, 0x5e, 0x4c, 0xd5, 0x37, 0x27, 0x43, 0x96, 0x77, 0x13, 0xd5, 0x31,
+	0x19, 0x78, 0xf8, 0x42, 0xa5, 0xc9, 0xde, 0xb2, 0xa4, 0xff, 0x59, 0x9f, 0x18, 0x5f, 0x2c, 0x6b,
+	0xb0, 0xa3, 0xef, 0x57, 0x76, 0x99, 0x07, 0x94, 0xa9, 0xe5, 0x11, 0x55, 0xa1, 0x0f, 0x2b, 0x3c,
+	0x2e, 0xa4, 0x7e, 0xc0, 0x40, 0x6f, 0x9e, 0x78, 0x85, 0x06, 0xfe, 0xd3, 0x98, 0xfc, 0x36, 0xb0,
+	0x48, 0x81, 0x84, 0x1b, 0x4d, 0xfc, 0x84, 0x90, 0x6d, 0x18, 0xc3, 0x3f, 0x5d, 0xa8, 0x77, 0x27,
+	0xc2, 0x4d, 0xd9, 0x33, 0x2d, 0x2e, 0x4e, 0x6f, 0xcf, 0xc9, 0x92, 0xa4, 0xfc, 0x97, 0x59, 0x28,
+	0xf4, 0x73, 0x75, 0xe1, 0xa3, 0x3e, 0x02, 0xf8, 0xff, 0x5d, 0xc5, 0x9f, 0xc0, 0xf2, 0x73, 0xcb,
+	0xa5, 0x77, 0xb1, 0xdf, 0xd0, 0x42, 0xe5, 0x53, 0xf5, 0xf6, 0xf9, 0x82, 0x0f, 0x5f, 0xe1, 0x3d,
+	0xe0, 0xbe, 0x82, 0x7c, 0x58, 0x4c, 0xb7, 0xa2, 0xb3, 0x23, 0xec, 0xc8, 0x56, 0xb7, 0x5a, 0x9a,
+	0x14, 0x5d, 0x46, 0xd8, 0x15, 0x99, 0x11, 0x24, 0x3a, 0xbd, 0xb7, 0x27, 0x69, 0x2b, 0xf3, 0x15,
+	0xef, 0x4c, 0xde, 0x81, 0x46, 0x2f, 0x87, 0x6b, 0xaf, 0x53, 0xca, 0x77, 0xda, 0x87, 0x0e, 0xf4,
+	0x85, 0x02, 0xab, 0xa3, 0x5e, 0xd6, 0xd0, 0xf8, 0x13, 0x1a, 0x7e, 0xdc, 0x53, 0xdf, 0x3a, 0x1d,
+	0x91, 0x74, 0x5e, 0x85, 0xc1, 0x87, 0x12, 0x94, 0x29, 0x48, 0xc6, 0x73, 0x8c, 0x7a, 0x7f, 0x72,
+	0x02, 0xb1, 0xec, 0x87, 0xd2, 0x98, 0xfb, 0x2f, 0x2d, 0xa7, 0x77, 0x60, 0xc3, 0xaf, 0x34, 0xf7,
+	0x15, 0xb4, 0x03, 0x0b, 0x9b, 0x96, 0xe7, 0x7b, 0xae, 0x6d, 0xb5, 0xd9, 0x8f, 0x11, 0x59, 0x6c,
+	0x27, 0xa9, 0xd0, 0x76, 0x60, 0x5e, 0xe4, 0x27, 0x54, 0x94, 0xcc, 0x34, 0xf9, 0xc0, 0x6f, 0x47,
+	0x1e, 0xb1, 0x82, 0x1e, 0xc5, 0xca, 0x4a, 0x53, 0xcb, 0x18, 0xf2, 0x55, 0xdc, 0x8c, 0x5a, 0xb1,
+	0xbb, 0x78, 0x06, 0x4b, 0x4f, 0xfc, 0xc0, 0xc6, 0xfd, 0x86, 0xcb, 0xe9, 0x55, 0x30, 0xdc, 0xac,
+	0xd9, 0xc8, 0x7f, 0xf5, 0xf5, 0x15, 0xe5, 0x9f, 0x5f, 0x5f, 0x51, 0xfe, 0xfd, 0xf5, 0x15, 0xa5,
+	0x39, 0xc3, 0x38, 0x3d, 0xfc, 0xef, 0x00, 0x23, 0x76, 0x56, 0xaa, 0x4b, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AttesterServiceClient is the client API for AttesterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttesterServiceClient interface {
+	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+}
+
+type attesterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
+	return &attesterServiceClient{cc}
+}
+
+func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
+	out := new(v1alpha1.AttestationData)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.AttesterSlot != 0 {
-		n += 1 + sovServices(uint64(m.AttesterSlot))
+	return out, nil
+}
+
+func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
+	out := new(AttestResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ProposerSlot != 0 {
-		n += 1 + sovServices(uint64(m.ProposerSlot))
+	return out, nil
+}
+
+// AttesterServiceServer is the server API for AttesterService service.
+type AttesterServiceServer interface {
+	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
+}
+
+// UnimplementedAttesterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAttesterServiceServer struct {
+}
+
+func (*UnimplementedAttesterServiceServer) RequestAttestation(ctx context.Context, req *AttestationRequest) (*v1alpha1.AttestationData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAttestation not implemented")
+}
+func (*UnimplementedAttesterServiceServer) SubmitAttestation(ctx context.Context, req *v1alpha1.Attestation) (*AttestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestation not implemented")
+}
+
+func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
+	s.RegisterService(&_AttesterService_serviceDesc, srv)
+}
+
+func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
 	}
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.Attestation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Eth1DepositBlockNumber != 0 {
-		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
 	}
-	if m.DepositInclusionSlot != 0 {
-		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
 	}
-	if m.ActivationEpoch != 0 {
-		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
 	}
-	if m.PositionInActivationQueue != 0 {
-		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AttesterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
+	HandlerType: (*AttesterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestAttestation",
+			Handler:    _AttesterService_RequestAttestation_Handler,
+		},
+		{
+			MethodName: "SubmitAttestation",
+			Handler:    _AttesterService_SubmitAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ProposerServiceClient is the client API for ProposerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProposerServiceClient interface {
+	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+}
+
+type proposerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
+	return &proposerServiceClient{cc}
+}
+
+func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
+	out := new(ProposeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// ProposerServiceServer is the server API for ProposerService service.
+type ProposerServiceServer interface {
+	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
+}
+
+// UnimplementedProposerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProposerServiceServer struct {
+}
+
+func (*UnimplementedProposerServiceServer) RequestBlock(ctx context.Context, req *BlockRequest) (*v1alpha1.BeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBlock not implemented")
+}
+func (*UnimplementedProposerServiceServer) ProposeBlock(ctx context.Context, req *v1alpha1.BeaconBlock) (*ProposeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeBlock not implemented")
+}
+
+func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
+	s.RegisterService(&_ProposerService_serviceDesc, srv)
+}
+
+func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovServices(uint64(m.Epoch))
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
 	}
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.BeaconBlock)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SignatureDomain != 0 {
-		n += 1 + sovServices(uint64(m.SignatureDomain))
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BlockTreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var _ProposerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
+	HandlerType: (*ProposerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestBlock",
+			Handler:    _ProposerService_RequestBlock_Handler,
+		},
+		{
+			MethodName: "ProposeBlock",
+			Handler:    _ProposerService_ProposeBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// AggregatorServiceClient is the client API for AggregatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AggregatorServiceClient interface {
+	SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error)
+	SubmitAggregateSelectionProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregateSelectionResponse, error)
+	SubmitSignedAggregateSelectionProof(ctx context.Context, in *v1alpha1.AggregateAttestationAndProof, opts ...grpc.CallOption) (*AggregationResponse, error)
+	GetAggregatedAttestation(ctx context.Context, in *AggregatedAttestationRequest, opts ...grpc.CallOption) (*v1alpha1.Attestation, error)
+}
+
+type aggregatorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAggregatorServiceClient(cc *grpc.ClientConn) AggregatorServiceClient {
+	return &aggregatorServiceClient{cc}
+}
+
+func (c *aggregatorServiceClient) SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error) {
+	out := new(AggregationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Tree) > 0 {
-		for _, e := range m.Tree {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *aggregatorServiceClient) SubmitAggregateSelectionProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregateSelectionResponse, error) {
+	out := new(AggregateSelectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateSelectionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *aggregatorServiceClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *v1alpha1.AggregateAttestationAndProof, opts ...grpc.CallOption) (*AggregationResponse, error) {
+	out := new(AggregationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubmitSignedAggregateSelectionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BlockTreeResponse_TreeNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *aggregatorServiceClient) GetAggregatedAttestation(ctx context.Context, in *AggregatedAttestationRequest, opts ...grpc.CallOption) (*v1alpha1.Attestation, error) {
+	out := new(v1alpha1.Attestation)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/GetAggregatedAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovServices(uint64(l))
+	return out, nil
+}
+
+// AggregatorServiceServer is the server API for AggregatorService service.
+type AggregatorServiceServer interface {
+	SubmitAggregateAndProof(context.Context, *AggregationRequest) (*AggregationResponse, error)
+	SubmitAggregateSelectionProof(context.Context, *AggregationRequest) (*AggregateSelectionResponse, error)
+	SubmitSignedAggregateSelectionProof(context.Context, *v1alpha1.AggregateAttestationAndProof) (*AggregationResponse, error)
+	GetAggregatedAttestation(context.Context, *AggregatedAttestationRequest) (*v1alpha1.Attestation, error)
+}
+
+// UnimplementedAggregatorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAggregatorServiceServer struct {
+}
+
+func (*UnimplementedAggregatorServiceServer) SubmitAggregateAndProof(ctx context.Context, req *AggregationRequest) (*AggregationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAggregateAndProof not implemented")
+}
+func (*UnimplementedAggregatorServiceServer) SubmitAggregateSelectionProof(ctx context.Context, req *AggregationRequest) (*AggregateSelectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAggregateSelectionProof not implemented")
+}
+func (*UnimplementedAggregatorServiceServer) SubmitSignedAggregateSelectionProof(ctx context.Context, req *v1alpha1.AggregateAttestationAndProof) (*AggregationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignedAggregateSelectionProof not implemented")
+}
+func (*UnimplementedAggregatorServiceServer) GetAggregatedAttestation(ctx context.Context, req *AggregatedAttestationRequest) (*v1alpha1.Attestation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAggregatedAttestation not implemented")
+}
+
+func RegisterAggregatorServiceServer(s *grpc.Server, srv AggregatorServiceServer) {
+	s.RegisterService(&_AggregatorService_serviceDesc, srv)
+}
+
+func _AggregatorService_SubmitAggregateAndProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if interceptor == nil {
+		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, in)
 	}
-	if m.ParticipatedVotes != 0 {
-		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof",
 	}
-	if m.TotalVotes != 0 {
-		n += 1 + sovServices(uint64(m.TotalVotes))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, req.(*AggregationRequest))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorService_SubmitAggregateSelectionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(AggregatorServiceServer).SubmitAggregateSelectionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateSelectionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorServiceServer).SubmitAggregateSelectionProof(ctx, req.(*AggregationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TreeBlockSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _AggregatorService_SubmitSignedAggregateSelectionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.AggregateAttestationAndProof)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SlotFrom != 0 {
-		n += 1 + sovServices(uint64(m.SlotFrom))
+	if interceptor == nil {
+		return srv.(AggregatorServiceServer).SubmitSignedAggregateSelectionProof(ctx, in)
 	}
-	if m.SlotTo != 0 {
-		n += 1 + sovServices(uint64(m.SlotTo))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubmitSignedAggregateSelectionProof",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorServiceServer).SubmitSignedAggregateSelectionProof(ctx, req.(*v1alpha1.AggregateAttestationAndProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AggregatorService_GetAggregatedAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregatedAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorServiceServer).GetAggregatedAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/GetAggregatedAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorServiceServer).GetAggregatedAttestation(ctx, req.(*AggregatedAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AggregatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AggregatorService",
+	HandlerType: (*AggregatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitAggregateAndProof",
+			Handler:    _AggregatorService_SubmitAggregateAndProof_Handler,
+		},
+		{
+			MethodName: "SubmitAggregateSelectionProof",
+			Handler:    _AggregatorService_SubmitAggregateSelectionProof_Handler,
+		},
+		{
+			MethodName: "SubmitSignedAggregateSelectionProof",
+			Handler:    _AggregatorService_SubmitSignedAggregateSelectionProof_Handler,
+		},
+		{
+			MethodName: "GetAggregatedAttestation",
+			Handler:    _AggregatorService_GetAggregatedAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// DutiesServiceClient is the client API for DutiesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DutiesServiceClient interface {
+	StreamDuties(ctx context.Context, in *v1alpha1.DutiesRequest, opts ...grpc.CallOption) (DutiesService_StreamDutiesClient, error)
+	SubscribeCommitteeSubnets(ctx context.Context, in *CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetProposerDuties(ctx context.Context, in *ProposerDutiesRequest, opts ...grpc.CallOption) (*ProposerDutiesResponse, error)
+	ComputeBlockStateRoot(ctx context.Context, in *v1alpha1.SignedBeaconBlock, opts ...grpc.CallOption) (*StateRootResponse, error)
+	ReconstructFullBlock(ctx context.Context, in *ReconstructFullBlockRequest, opts ...grpc.CallOption) (*v1alpha1.SignedBeaconBlock, error)
+	ProposeBlindedBlock(ctx context.Context, in *ReconstructFullBlockRequest, opts ...grpc.CallOption) (*v1alpha1.ProposeResponse, error)
+}
+
+type dutiesServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDutiesServiceClient(cc *grpc.ClientConn) DutiesServiceClient {
+	return &dutiesServiceClient{cc}
+}
+
+func (c *dutiesServiceClient) StreamDuties(ctx context.Context, in *v1alpha1.DutiesRequest, opts ...grpc.CallOption) (DutiesService_StreamDutiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DutiesService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.DutiesService/StreamDuties", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dutiesServiceStreamDutiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DutiesService_StreamDutiesClient interface {
+	Recv() (*v1alpha1.DutiesResponse, error)
+	grpc.ClientStream
+}
+
+type dutiesServiceStreamDutiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *dutiesServiceStreamDutiesClient) Recv() (*v1alpha1.DutiesResponse, error) {
+	m := new(v1alpha1.DutiesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dutiesServiceClient) SubscribeCommitteeSubnets(ctx context.Context, in *CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DutiesService/SubscribeCommitteeSubnets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dutiesServiceClient) GetProposerDuties(ctx context.Context, in *ProposerDutiesRequest, opts ...grpc.CallOption) (*ProposerDutiesResponse, error) {
+	out := new(ProposerDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DutiesService/GetProposerDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dutiesServiceClient) ComputeBlockStateRoot(ctx context.Context, in *v1alpha1.SignedBeaconBlock, opts ...grpc.CallOption) (*StateRootResponse, error) {
+	out := new(StateRootResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DutiesService/ComputeBlockStateRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dutiesServiceClient) ReconstructFullBlock(ctx context.Context, in *ReconstructFullBlockRequest, opts ...grpc.CallOption) (*v1alpha1.SignedBeaconBlock, error) {
+	out := new(v1alpha1.SignedBeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DutiesService/ReconstructFullBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dutiesServiceClient) ProposeBlindedBlock(ctx context.Context, in *ReconstructFullBlockRequest, opts ...grpc.CallOption) (*v1alpha1.ProposeResponse, error) {
+	out := new(v1alpha1.ProposeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DutiesService/ProposeBlindedBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DutiesServiceServer is the server API for DutiesService service.
+type DutiesServiceServer interface {
+	StreamDuties(*v1alpha1.DutiesRequest, DutiesService_StreamDutiesServer) error
+	SubscribeCommitteeSubnets(context.Context, *CommitteeSubnetsSubscribeRequest) (*types.Empty, error)
+	GetProposerDuties(context.Context, *ProposerDutiesRequest) (*ProposerDutiesResponse, error)
+	ComputeBlockStateRoot(context.Context, *v1alpha1.SignedBeaconBlock) (*StateRootResponse, error)
+	ReconstructFullBlock(context.Context, *ReconstructFullBlockRequest) (*v1alpha1.SignedBeaconBlock, error)
+	ProposeBlindedBlock(context.Context, *ReconstructFullBlockRequest) (*v1alpha1.ProposeResponse, error)
+}
+
+// UnimplementedDutiesServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDutiesServiceServer struct {
+}
+
+func (*UnimplementedDutiesServiceServer) StreamDuties(req *v1alpha1.DutiesRequest, srv DutiesService_StreamDutiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDuties not implemented")
+}
+func (*UnimplementedDutiesServiceServer) SubscribeCommitteeSubnets(ctx context.Context, req *CommitteeSubnetsSubscribeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeCommitteeSubnets not implemented")
+}
+func (*UnimplementedDutiesServiceServer) GetProposerDuties(ctx context.Context, req *ProposerDutiesRequest) (*ProposerDutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposerDuties not implemented")
+}
+func (*UnimplementedDutiesServiceServer) ComputeBlockStateRoot(ctx context.Context, req *v1alpha1.SignedBeaconBlock) (*StateRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeBlockStateRoot not implemented")
+}
+func (*UnimplementedDutiesServiceServer) ReconstructFullBlock(ctx context.Context, req *ReconstructFullBlockRequest) (*v1alpha1.SignedBeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconstructFullBlock not implemented")
+}
+func (*UnimplementedDutiesServiceServer) ProposeBlindedBlock(ctx context.Context, req *ReconstructFullBlockRequest) (*v1alpha1.ProposeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeBlindedBlock not implemented")
+}
+
+func RegisterDutiesServiceServer(s *grpc.Server, srv DutiesServiceServer) {
+	s.RegisterService(&_DutiesService_serviceDesc, srv)
+}
+
+func _DutiesService_StreamDuties_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1alpha1.DutiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DutiesServiceServer).StreamDuties(m, &dutiesServiceStreamDutiesServer{stream})
+}
+
+type DutiesService_StreamDutiesServer interface {
+	Send(*v1alpha1.DutiesResponse) error
+	grpc.ServerStream
+}
+
+type dutiesServiceStreamDutiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *dutiesServiceStreamDutiesServer) Send(m *v1alpha1.DutiesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DutiesService_SubscribeCommitteeSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitteeSubnetsSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutiesServiceServer).SubscribeCommitteeSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DutiesService/SubscribeCommitteeSubnets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutiesServiceServer).SubscribeCommitteeSubnets(ctx, req.(*CommitteeSubnetsSubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DutiesService_GetProposerDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerDutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutiesServiceServer).GetProposerDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DutiesService/GetProposerDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutiesServiceServer).GetProposerDuties(ctx, req.(*ProposerDutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DutiesService_ComputeBlockStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.SignedBeaconBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutiesServiceServer).ComputeBlockStateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DutiesService/ComputeBlockStateRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutiesServiceServer).ComputeBlockStateRoot(ctx, req.(*v1alpha1.SignedBeaconBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DutiesService_ReconstructFullBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconstructFullBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutiesServiceServer).ReconstructFullBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DutiesService/ReconstructFullBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutiesServiceServer).ReconstructFullBlock(ctx, req.(*ReconstructFullBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DutiesService_ProposeBlindedBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconstructFullBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutiesServiceServer).ProposeBlindedBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DutiesService/ProposeBlindedBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutiesServiceServer).ProposeBlindedBlock(ctx, req.(*ReconstructFullBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DutiesService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.DutiesService",
+	HandlerType: (*DutiesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubscribeCommitteeSubnets",
+			Handler:    _DutiesService_SubscribeCommitteeSubnets_Handler,
+		},
+		{
+			MethodName: "GetProposerDuties",
+			Handler:    _DutiesService_GetProposerDuties_Handler,
+		},
+		{
+			MethodName: "ComputeBlockStateRoot",
+			Handler:    _DutiesService_ComputeBlockStateRoot_Handler,
+		},
+		{
+			MethodName: "ReconstructFullBlock",
+			Handler:    _DutiesService_ReconstructFullBlock_Handler,
+		},
+		{
+			MethodName: "ProposeBlindedBlock",
+			Handler:    _DutiesService_ProposeBlindedBlock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDuties",
+			Handler:       _DutiesService_StreamDuties_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// BeaconChainServiceClient is the client API for BeaconChainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconChainServiceClient interface {
+	GetBlockRoot(ctx context.Context, in *BlockRootRequest, opts ...grpc.CallOption) (*BlockRootResponse, error)
+	GetBlock(ctx context.Context, in *BeaconBlockRequest, opts ...grpc.CallOption) (*v1alpha1.SignedBeaconBlock, error)
+	GetGenesis(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenesisResponse, error)
+	GetDomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
+	GetFork(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ForkResponse, error)
+	GetBeaconStateSSZ(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (BeaconChainService_GetBeaconStateSSZClient, error)
+	GetDepositProof(ctx context.Context, in *DepositProofRequest, opts ...grpc.CallOption) (*DepositProofResponse, error)
+	GetIndividualVotes(ctx context.Context, in *IndividualVotesRequest, opts ...grpc.CallOption) (*IndividualVotesResponse, error)
+	GetSyncStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncStatusResponse, error)
+	StreamBlocksByRange(ctx context.Context, in *BlocksByRangeRequest, opts ...grpc.CallOption) (BeaconChainService_StreamBlocksByRangeClient, error)
+	ComputeSigningRoot(ctx context.Context, in *SigningRootRequest, opts ...grpc.CallOption) (*SigningRootResponse, error)
+	GetSpec(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SpecResponse, error)
+}
+
+type beaconChainServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconChainServiceClient(cc *grpc.ClientConn) BeaconChainServiceClient {
+	return &beaconChainServiceClient{cc}
+}
+
+func (c *beaconChainServiceClient) GetBlockRoot(ctx context.Context, in *BlockRootRequest, opts ...grpc.CallOption) (*BlockRootResponse, error) {
+	out := new(BlockRootResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetBlockRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) GetBlock(ctx context.Context, in *BeaconBlockRequest, opts ...grpc.CallOption) (*v1alpha1.SignedBeaconBlock, error) {
+	out := new(v1alpha1.SignedBeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) GetGenesis(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenesisResponse, error) {
+	out := new(GenesisResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) GetDomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
+	out := new(DomainResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetDomainData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) GetFork(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ForkResponse, error) {
+	out := new(ForkResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetFork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) GetBeaconStateSSZ(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (BeaconChainService_GetBeaconStateSSZClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChainService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconChainService/GetBeaconStateSSZ", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainServiceGetBeaconStateSSZClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChainService_GetBeaconStateSSZClient interface {
+	Recv() (*BeaconStateChunk, error)
+	grpc.ClientStream
+}
+
+type beaconChainServiceGetBeaconStateSSZClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainServiceGetBeaconStateSSZClient) Recv() (*BeaconStateChunk, error) {
+	m := new(BeaconStateChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainServiceClient) GetDepositProof(ctx context.Context, in *DepositProofRequest, opts ...grpc.CallOption) (*DepositProofResponse, error) {
+	out := new(DepositProofResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetDepositProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) GetIndividualVotes(ctx context.Context, in *IndividualVotesRequest, opts ...grpc.CallOption) (*IndividualVotesResponse, error) {
+	out := new(IndividualVotesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetIndividualVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) GetSyncStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncStatusResponse, error) {
+	out := new(SyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) StreamBlocksByRange(ctx context.Context, in *BlocksByRangeRequest, opts ...grpc.CallOption) (BeaconChainService_StreamBlocksByRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChainService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.BeaconChainService/StreamBlocksByRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainServiceStreamBlocksByRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChainService_StreamBlocksByRangeClient interface {
+	Recv() (*v1alpha1.SignedBeaconBlock, error)
+	grpc.ClientStream
+}
+
+type beaconChainServiceStreamBlocksByRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainServiceStreamBlocksByRangeClient) Recv() (*v1alpha1.SignedBeaconBlock, error) {
+	m := new(v1alpha1.SignedBeaconBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainServiceClient) ComputeSigningRoot(ctx context.Context, in *SigningRootRequest, opts ...grpc.CallOption) (*SigningRootResponse, error) {
+	out := new(SigningRootResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/ComputeSigningRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainServiceClient) GetSpec(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SpecResponse, error) {
+	out := new(SpecResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChainService/GetSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconChainServiceServer is the server API for BeaconChainService service.
+type BeaconChainServiceServer interface {
+	GetBlockRoot(context.Context, *BlockRootRequest) (*BlockRootResponse, error)
+	GetBlock(context.Context, *BeaconBlockRequest) (*v1alpha1.SignedBeaconBlock, error)
+	GetGenesis(context.Context, *types.Empty) (*GenesisResponse, error)
+	GetDomainData(context.Context, *DomainRequest) (*DomainResponse, error)
+	GetFork(context.Context, *types.Empty) (*ForkResponse, error)
+	GetBeaconStateSSZ(*BeaconStateRequest, BeaconChainService_GetBeaconStateSSZServer) error
+	GetDepositProof(context.Context, *DepositProofRequest) (*DepositProofResponse, error)
+	GetIndividualVotes(context.Context, *IndividualVotesRequest) (*IndividualVotesResponse, error)
+	GetSyncStatus(context.Context, *types.Empty) (*SyncStatusResponse, error)
+	StreamBlocksByRange(*BlocksByRangeRequest, BeaconChainService_StreamBlocksByRangeServer) error
+	ComputeSigningRoot(context.Context, *SigningRootRequest) (*SigningRootResponse, error)
+	GetSpec(context.Context, *types.Empty) (*SpecResponse, error)
+}
+
+// UnimplementedBeaconChainServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconChainServiceServer struct {
+}
+
+func (*UnimplementedBeaconChainServiceServer) GetBlockRoot(ctx context.Context, req *BlockRootRequest) (*BlockRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockRoot not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetBlock(ctx context.Context, req *BeaconBlockRequest) (*v1alpha1.SignedBeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetGenesis(ctx context.Context, req *types.Empty) (*GenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenesis not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetDomainData(ctx context.Context, req *DomainRequest) (*DomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDomainData not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetFork(ctx context.Context, req *types.Empty) (*ForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFork not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetBeaconStateSSZ(req *BeaconStateRequest, srv BeaconChainService_GetBeaconStateSSZServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetBeaconStateSSZ not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetDepositProof(ctx context.Context, req *DepositProofRequest) (*DepositProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositProof not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetIndividualVotes(ctx context.Context, req *IndividualVotesRequest) (*IndividualVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndividualVotes not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetSyncStatus(ctx context.Context, req *types.Empty) (*SyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncStatus not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) StreamBlocksByRange(req *BlocksByRangeRequest, srv BeaconChainService_StreamBlocksByRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocksByRange not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) ComputeSigningRoot(ctx context.Context, req *SigningRootRequest) (*SigningRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeSigningRoot not implemented")
+}
+func (*UnimplementedBeaconChainServiceServer) GetSpec(ctx context.Context, req *types.Empty) (*SpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpec not implemented")
+}
+
+func RegisterBeaconChainServiceServer(s *grpc.Server, srv BeaconChainServiceServer) {
+	s.RegisterService(&_BeaconChainService_serviceDesc, srv)
+}
+
+func _BeaconChainService_GetBlockRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetBlockRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetBlockRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetBlockRoot(ctx, req.(*BlockRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetBlock(ctx, req.(*BeaconBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_GetGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetGenesis(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_GetDomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetDomainData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetDomainData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetDomainData(ctx, req.(*DomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_GetFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetFork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetFork(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_GetBeaconStateSSZ_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BeaconStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServiceServer).GetBeaconStateSSZ(m, &beaconChainServiceGetBeaconStateSSZServer{stream})
+}
+
+type BeaconChainService_GetBeaconStateSSZServer interface {
+	Send(*BeaconStateChunk) error
+	grpc.ServerStream
+}
+
+type beaconChainServiceGetBeaconStateSSZServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainServiceGetBeaconStateSSZServer) Send(m *BeaconStateChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChainService_GetDepositProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetDepositProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetDepositProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetDepositProof(ctx, req.(*DepositProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_GetIndividualVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndividualVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetIndividualVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetIndividualVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetIndividualVotes(ctx, req.(*IndividualVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetSyncStatus(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_StreamBlocksByRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksByRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServiceServer).StreamBlocksByRange(m, &beaconChainServiceStreamBlocksByRangeServer{stream})
+}
+
+type BeaconChainService_StreamBlocksByRangeServer interface {
+	Send(*v1alpha1.SignedBeaconBlock) error
+	grpc.ServerStream
+}
+
+type beaconChainServiceStreamBlocksByRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainServiceStreamBlocksByRangeServer) Send(m *v1alpha1.SignedBeaconBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChainService_ComputeSigningRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).ComputeSigningRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/ComputeSigningRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).ComputeSigningRoot(ctx, req.(*SigningRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChainService_GetSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServiceServer).GetSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChainService/GetSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServiceServer).GetSpec(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconChainService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BeaconChainService",
+	HandlerType: (*BeaconChainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlockRoot",
+			Handler:    _BeaconChainService_GetBlockRoot_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _BeaconChainService_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetGenesis",
+			Handler:    _BeaconChainService_GetGenesis_Handler,
+		},
+		{
+			MethodName: "GetDomainData",
+			Handler:    _BeaconChainService_GetDomainData_Handler,
+		},
+		{
+			MethodName: "GetFork",
+			Handler:    _BeaconChainService_GetFork_Handler,
+		},
+		{
+			MethodName: "GetDepositProof",
+			Handler:    _BeaconChainService_GetDepositProof_Handler,
+		},
+		{
+			MethodName: "GetIndividualVotes",
+			Handler:    _BeaconChainService_GetIndividualVotes_Handler,
+		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _BeaconChainService_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "ComputeSigningRoot",
+			Handler:    _BeaconChainService_ComputeSigningRoot_Handler,
+		},
+		{
+			MethodName: "GetSpec",
+			Handler:    _BeaconChainService_GetSpec_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetBeaconStateSSZ",
+			Handler:       _BeaconChainService_GetBeaconStateSSZ_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBlocksByRange",
+			Handler:       _BeaconChainService_StreamBlocksByRange_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ValidatorServiceClient is the client API for ValidatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorServiceClient interface {
+	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
+	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
+	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error)
+	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	ProposeExit(ctx context.Context, in *v1alpha1.VoluntaryExit, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type validatorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
+	return &validatorServiceClient{cc}
+}
+
+func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
+	out := new(DomainResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &validatorServiceWaitForActivationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ValidatorService_WaitForActivationClient interface {
+	Recv() (*ValidatorActivationResponse, error)
+	grpc.ClientStream
+}
+
+type validatorServiceWaitForActivationClient struct {
+	grpc.ClientStream
+}
+
+func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
+	m := new(ValidatorActivationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
+	out := new(ValidatorIndexResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
+	out := new(ValidatorStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
+	out := new(ExitedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForChainStart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &validatorServiceWaitForChainStartClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ValidatorService_WaitForChainStartClient interface {
+	Recv() (*ChainStartResponse, error)
+	grpc.ClientStream
+}
+
+type validatorServiceWaitForChainStartClient struct {
+	grpc.ClientStream
+}
+
+func (x *validatorServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
+	m := new(ChainStartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *validatorServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ProposeExit(ctx context.Context, in *v1alpha1.VoluntaryExit, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorServiceServer is the server API for ValidatorService service.
+type ValidatorServiceServer interface {
+	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
+	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
+	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
+	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+	WaitForChainStart(*types.Empty, ValidatorService_WaitForChainStartServer) error
+	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
+	ProposeExit(context.Context, *v1alpha1.VoluntaryExit) (*types.Empty, error)
+}
+
+// UnimplementedValidatorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorServiceServer struct {
+}
+
+func (*UnimplementedValidatorServiceServer) DomainData(ctx context.Context, req *DomainRequest) (*DomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainData not implemented")
+}
+func (*UnimplementedValidatorServiceServer) WaitForActivation(req *ValidatorActivationRequest, srv ValidatorService_WaitForActivationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForActivation not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorIndex(ctx context.Context, req *ValidatorIndexRequest) (*ValidatorIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorIndex not implemented")
+}
+func (*UnimplementedValidatorServiceServer) CommitteeAssignment(ctx context.Context, req *AssignmentRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitteeAssignment not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorStatus(ctx context.Context, req *ValidatorIndexRequest) (*ValidatorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStatus not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorPerformance(ctx context.Context, req *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ExitedValidators(ctx context.Context, req *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitedValidators not implemented")
+}
+func (*UnimplementedValidatorServiceServer) WaitForChainStart(req *types.Empty, srv ValidatorService_WaitForChainStartServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForChainStart not implemented")
+}
+func (*UnimplementedValidatorServiceServer) CanonicalHead(ctx context.Context, req *types.Empty) (*v1alpha1.BeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalHead not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ProposeExit(ctx context.Context, req *v1alpha1.VoluntaryExit) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeExit not implemented")
+}
+
+func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
+	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+}
+
+func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).DomainData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidatorActivationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
+}
+
+type ValidatorService_WaitForActivationServer interface {
+	Send(*ValidatorActivationResponse) error
+	grpc.ServerStream
+}
+
+type validatorServiceWaitForActivationServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ValidatorServiceServer).WaitForChainStart(m, &validatorServiceWaitForChainStartServer{stream})
+}
+
+type ValidatorService_WaitForChainStartServer interface {
+	Send(*ChainStartResponse) error
+	grpc.ServerStream
+}
+
+type validatorServiceWaitForChainStartServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ValidatorService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CanonicalHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CanonicalHead(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ProposeExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.VoluntaryExit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, req.(*v1alpha1.VoluntaryExit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
+	HandlerType: (*ValidatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DomainData",
+			Handler:    _ValidatorService_DomainData_Handler,
+		},
+		{
+			MethodName: "ValidatorIndex",
+			Handler:    _ValidatorService_ValidatorIndex_Handler,
+		},
+		{
+			MethodName: "CommitteeAssignment",
+			Handler:    _ValidatorService_CommitteeAssignment_Handler,
+		},
+		{
+			MethodName: "ValidatorStatus",
+			Handler:    _ValidatorService_ValidatorStatus_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _ValidatorService_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ExitedValidators",
+			Handler:    _ValidatorService_ExitedValidators_Handler,
+		},
+		{
+			MethodName: "CanonicalHead",
+			Handler:    _ValidatorService_CanonicalHead_Handler,
+		},
+		{
+			MethodName: "ProposeExit",
+			Handler:    _ValidatorService_ProposeExit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForActivation",
+			Handler:       _ValidatorService_WaitForActivation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WaitForChainStart",
+			Handler:       _ValidatorService_WaitForChainStart_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// DebugServiceClient is the client API for DebugService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugServiceClient interface {
+	ForceHeadUpdate(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HeadUpdateResponse, error)
+}
+
+type debugServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDebugServiceClient(cc *grpc.ClientConn) DebugServiceClient {
+	return &debugServiceClient{cc}
+}
+
+func (c *debugServiceClient) ForceHeadUpdate(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HeadUpdateResponse, error) {
+	out := new(HeadUpdateResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DebugService/ForceHeadUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServiceServer is the server API for DebugService service.
+type DebugServiceServer interface {
+	ForceHeadUpdate(context.Context, *types.Empty) (*HeadUpdateResponse, error)
+}
+
+// UnimplementedDebugServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServiceServer struct {
+}
+
+func (*UnimplementedDebugServiceServer) ForceHeadUpdate(ctx context.Context, req *types.Empty) (*HeadUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceHeadUpdate not implemented")
+}
+
+func RegisterDebugServiceServer(s *grpc.Server, srv DebugServiceServer) {
+	s.RegisterService(&_DebugService_serviceDesc, srv)
+}
+
+func _DebugService_ForceHeadUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).ForceHeadUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DebugService/ForceHeadUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).ForceHeadUpdate(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DebugService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.DebugService",
+	HandlerType: (*DebugServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ForceHeadUpdate",
+			Handler:    _DebugService_ForceHeadUpdate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RandaoReveal) > 0 {
+		i -= len(m.RandaoReveal)
+		copy(dAtA[i:], m.RandaoReveal)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconBlockRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconBlockRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BeaconBlockRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconBlockRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *BlocksByRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlocksByRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlocksByRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Step != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Object) > 0 {
+		i -= len(m.Object)
+		copy(dAtA[i:], m.Object)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Object)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectType != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ObjectType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SignatureDomain != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SigningRoot) > 0 {
+		i -= len(m.SigningRoot)
+		copy(dAtA[i:], m.SigningRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.SigningRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DepositContractAddress) > 0 {
+		i -= len(m.DepositContractAddress)
+		copy(dAtA[i:], m.DepositContractAddress)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.DepositContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GenesisValidatorsRoot) > 0 {
+		i -= len(m.GenesisValidatorsRoot)
+		copy(dAtA[i:], m.GenesisValidatorsRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.GenesisValidatorsRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GenesisTime != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest_StateRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_StateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StateRoot != nil {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DepositIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DepositCount != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DepositRoot) > 0 {
+		i -= len(m.DepositRoot)
+		copy(dAtA[i:], m.DepositRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.DepositRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndividualVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndividualVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndividualVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintServices(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndividualVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndividualVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndividualVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InclusionDistance != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.InclusionDistance))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InclusionSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.InclusionSlot))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EffectiveBalance != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.EffectiveBalance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VotedHead {
+		i--
+		if m.VotedHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VotedTarget {
+		i--
+		if m.VotedTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VotedSource {
+		i--
+		if m.VotedSource {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsSlashed {
+		i--
+		if m.IsSlashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsActiveInEpoch {
+		i--
+		if m.IsActiveInEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndividualVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndividualVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndividualVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconstructFullBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconstructFullBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconstructFullBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Body != nil {
+		{
+			size, err := m.Body.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlindedBlock != nil {
+		{
+			size, err := m.BlindedBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PocBit) > 0 {
+		i -= len(m.PocBit)
+		copy(dAtA[i:], m.PocBit)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SlotSignature) > 0 {
+		i -= len(m.SlotSignature)
+		copy(dAtA[i:], m.SlotSignature)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.SlotSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateSelectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateSelectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateSelectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AggregateAndProof != nil {
+		{
+			size, err := m.AggregateAndProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AverageActiveValidatorBalance != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if len(m.MissingValidators) > 0 {
+		for iNdEx := len(m.MissingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingValidators[iNdEx])
+			copy(dAtA[i:], m.MissingValidators[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.MissingValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalActiveValidators != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalValidators != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Balances) > 0 {
+		dAtA7 := make([]byte, len(m.Balances)*10)
+		var j6 int
+		for _, num := range m.Balances {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintServices(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ActivatedPublicKeys) > 0 {
+		for iNdEx := len(m.ActivatedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivatedPublicKeys[iNdEx])
+			copy(dAtA[i:], m.ActivatedPublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.ActivatedPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationResponse_Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GenesisTime != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Index != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochStart != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidatorAssignment) > 0 {
+		for iNdEx := len(m.ValidatorAssignment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorAssignment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProposerSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ProposerSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AttesterSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.AttesterSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Committee) > 0 {
+		dAtA10 := make([]byte, len(m.Committee)*10)
+		var j9 int
+		for _, num := range m.Committee {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintServices(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PositionInActivationQueue != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DepositInclusionSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Eth1DepositBlockNumber != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SignatureDomain != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CurrentVersion) > 0 {
+		i -= len(m.CurrentVersion)
+		copy(dAtA[i:], m.CurrentVersion)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.CurrentVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousVersion) > 0 {
+		i -= len(m.PreviousVersion)
+		copy(dAtA[i:], m.PreviousVersion)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PreviousVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeSubnetSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeSubnetSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeSubnetSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsAggregator {
+		i--
+		if m.IsAggregator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerDutiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerDutiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerDutiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerDutiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerDutiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerDutiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Duties) > 0 {
+		for iNdEx := len(m.Duties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Duties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerDutiesResponse_Duty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerDutiesResponse_Duty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerDutiesResponse_Duty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposerIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ProposerIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EstimatedTimeToSync != nil {
+		{
+			size, err := m.EstimatedTimeToSync.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HighestKnownSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.HighestKnownSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tree) > 0 {
+		for iNdEx := len(m.Tree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTreeResponse_TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalVotes != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParticipatedVotes != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeBlockSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlotTo != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlotFrom != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HeadRoot) > 0 {
+		i -= len(m.HeadRoot)
+		copy(dAtA[i:], m.HeadRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.HeadRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for k := range m.Data {
+			v := m.Data[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintServices(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintServices(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintServices(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregatedAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AttestationDataRoot) > 0 {
+		i -= len(m.AttestationDataRoot)
+		copy(dAtA[i:], m.AttestationDataRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.AttestationDataRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
+	offset -= sovServices(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.RandaoReveal)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconBlockRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovServices(uint64(l))
+	}
+	return n
+}
+func (m *BeaconBlockRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovServices(uint64(m.Slot))
+	return n
+}
+func (m *BlocksByRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovServices(uint64(m.StartSlot))
+	}
+	if m.Count != 0 {
+		n += 1 + sovServices(uint64(m.Count))
+	}
+	if m.Step != 0 {
+		n += 1 + sovServices(uint64(m.Step))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SigningRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectType != 0 {
+		n += 1 + sovServices(uint64(m.ObjectType))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SigningRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SigningRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.SignatureDomain != 0 {
+		n += 1 + sovServices(uint64(m.SignatureDomain))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GenesisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GenesisTime != 0 {
+		n += 1 + sovServices(uint64(m.GenesisTime))
+	}
+	l = len(m.GenesisValidatorsRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.DepositContractAddress)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest_StateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StateRoot != nil {
+		l = len(m.StateRoot)
+		n += 1 + l + sovServices(uint64(l))
+	}
+	return n
+}
+func (m *BeaconStateRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovServices(uint64(m.Slot))
+	return n
+}
+func (m *BeaconStateChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovServices(uint64(m.Offset))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovServices(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DepositProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositIndex != 0 {
+		n += 1 + sovServices(uint64(m.DepositIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DepositProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovServices(uint64(m.DepositCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndividualVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndividualVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovServices(uint64(m.ValidatorIndex))
+	}
+	if m.IsActiveInEpoch {
+		n += 2
+	}
+	if m.IsSlashed {
+		n += 2
+	}
+	if m.VotedSource {
+		n += 2
+	}
+	if m.VotedTarget {
+		n += 2
+	}
+	if m.VotedHead {
+		n += 2
+	}
+	if m.EffectiveBalance != 0 {
+		n += 1 + sovServices(uint64(m.EffectiveBalance))
+	}
+	if m.InclusionSlot != 0 {
+		n += 1 + sovServices(uint64(m.InclusionSlot))
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovServices(uint64(m.InclusionDistance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndividualVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReconstructFullBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlindedBlock != nil {
+		l = m.BlindedBlock.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Body != nil {
+		l = m.Body.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.PocBit)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.SlotSignature)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregateSelectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AggregateAndProof != nil {
+		l = m.AggregateAndProof.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		l = 0
+		for _, e := range m.Balances {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.TotalValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalValidators))
+	}
+	if m.TotalActiveValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalActiveValidators))
+	}
+	if len(m.MissingValidators) > 0 {
+		for _, b := range m.MissingValidators {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.AverageActiveValidatorBalance != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse_Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Started {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovServices(uint64(m.GenesisTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovServices(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStart != 0 {
+		n += 1 + sovServices(uint64(m.EpochStart))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorAssignment) > 0 {
+		for _, e := range m.ValidatorAssignment {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Committee) > 0 {
+		l = 0
+		for _, e := range m.Committee {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.AttesterSlot != 0 {
+		n += 1 + sovServices(uint64(m.AttesterSlot))
+	}
+	if m.ProposerSlot != 0 {
+		n += 1 + sovServices(uint64(m.ProposerSlot))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.Eth1DepositBlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	}
+	if m.DepositInclusionSlot != 0 {
+		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	}
+	if m.PositionInActivationQueue != 0 {
+		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignatureDomain != 0 {
+		n += 1 + sovServices(uint64(m.SignatureDomain))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousVersion)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.CurrentVersion)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeSubnetSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.IsAggregator {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerDutiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerDutiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Duties) > 0 {
+		for _, e := range m.Duties {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerDutiesResponse_Duty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.ProposerIndex != 0 {
+		n += 1 + sovServices(uint64(m.ProposerIndex))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.HeadSlot))
+	}
+	if m.HighestKnownSlot != 0 {
+		n += 1 + sovServices(uint64(m.HighestKnownSlot))
+	}
+	if m.EstimatedTimeToSync != nil {
+		l = m.EstimatedTimeToSync.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tree) > 0 {
+		for _, e := range m.Tree {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse_TreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.ParticipatedVotes != 0 {
+		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+	}
+	if m.TotalVotes != 0 {
+		n += 1 + sovServices(uint64(m.TotalVotes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeBlockSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlotFrom != 0 {
+		n += 1 + sovServices(uint64(m.SlotFrom))
+	}
+	if m.SlotTo != 0 {
+		n += 1 + sovServices(uint64(m.SlotTo))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeadUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeadRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.HeadSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovServices(uint64(len(k))) + 1 + len(v) + sovServices(uint64(len(v)))
+			n += mapEntrySize + 1 + sovServices(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregatedAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttestationDataRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServices(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozServices(x uint64) (n int) {
+	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
+			if m.Graffiti == nil {
+				m.Graffiti = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &BeaconBlockRequest_BlockRoot{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &BeaconBlockRequest_Slot{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocksByRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocksByRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocksByRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectType", wireType)
+			}
+			m.ObjectType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectType |= SigningRootRequest_ObjectType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Object = append(m.Object[:0], dAtA[iNdEx:postIndex]...)
+			if m.Object == nil {
+				m.Object = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningRoot = append(m.SigningRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningRoot == nil {
+				m.SigningRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
+			}
+			m.SignatureDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureDomain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			m.GenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidatorsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisValidatorsRoot = append(m.GenesisValidatorsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisValidatorsRoot == nil {
+				m.GenesisValidatorsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositContractAddress == nil {
+				m.DepositContractAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &BeaconStateRequest_StateRoot{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &BeaconStateRequest_Slot{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIndex", wireType)
+			}
+			m.DepositIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = append(m.Leaf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Leaf == nil {
+				m.Leaf = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndividualVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndividualVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndividualVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndividualVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndividualVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndividualVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActiveInEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActiveInEpoch = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSlashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSlashed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedSource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VotedSource = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VotedTarget = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedHead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VotedHead = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBalance", wireType)
+			}
+			m.EffectiveBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionSlot", wireType)
+			}
+			m.InclusionSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndividualVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndividualVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndividualVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &IndividualVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconstructFullBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconstructFullBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconstructFullBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlindedBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlindedBlock == nil {
+				m.BlindedBlock = &v1alpha1.SignedBeaconBlockHeader{}
+			}
+			if err := m.BlindedBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Body == nil {
+				m.Body = &v1alpha1.BeaconBlockBody{}
+			}
+			if err := m.Body.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
+			if m.PocBit == nil {
+				m.PocBit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlotSignature = append(m.SlotSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SlotSignature == nil {
+				m.SlotSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateSelectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateSelectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateSelectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateAndProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregateAndProof == nil {
+				m.AggregateAndProof = &v1alpha1.AggregateAttestationAndProof{}
+			}
+			if err := m.AggregateAndProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovServices(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozServices(x uint64) (n int) {
-	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3766,17 +11513,93 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Balances = append(m.Balances, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Balances) == 0 {
+					m.Balances = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Balances = append(m.Balances, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
 			}
-			m.Slot = 0
+			m.TotalValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3786,14 +11609,33 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.TotalValidators |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+			}
+			m.TotalActiveValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3820,10 +11662,105 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandaoReveal == nil {
-				m.RandaoReveal = []byte{}
+			m.MissingValidators = append(m.MissingValidators, make([]byte, postIndex-iNdEx))
+			copy(m.MissingValidators[len(m.MissingValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageActiveValidatorBalance", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AverageActiveValidatorBalance = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3850,7 +11787,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3873,15 +11810,15 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3908,9 +11845,41 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &ValidatorActivationResponse_Status{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3938,7 +11907,7 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3961,10 +11930,10 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4003,9 +11972,9 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4015,50 +11984,87 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
-			if m.PocBit == nil {
-				m.PocBit = []byte{}
+			if m.Status == nil {
+				m.Status = &ValidatorStatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
 			}
-			m.CommitteeIndex = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitedValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4068,11 +12074,24 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4098,7 +12117,7 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4121,15 +12140,15 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitedValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4156,10 +12175,8 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4186,7 +12203,7 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
+func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4209,17 +12226,17 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.Slot = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4229,16 +12246,17 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Started = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
 			}
-			m.CommitteeIndex = 0
+			m.GenesisTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4248,48 +12266,68 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				m.GenesisTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthServices
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4316,9 +12354,9 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlotSignature = append(m.SlotSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.SlotSignature == nil {
-				m.SlotSignature = []byte{}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4346,7 +12384,7 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4369,17 +12407,17 @@ func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var byteLen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4389,26 +12427,11 @@ func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4434,7 +12457,7 @@ func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4457,17 +12480,17 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
 			}
-			m.Slot = 0
+			m.EpochStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4477,7 +12500,7 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.EpochStart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4539,7 +12562,7 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4562,10 +12585,98 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAssignment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAssignment = append(m.ValidatorAssignment, &AssignmentResponse_ValidatorAssignment{})
+			if err := m.ValidatorAssignment[len(m.ValidatorAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4585,7 +12696,7 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Balances = append(m.Balances, v)
+				m.Committee = append(m.Committee, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -4620,8 +12731,8 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Balances) == 0 {
-					m.Balances = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.Committee) == 0 {
+					m.Committee = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -4639,16 +12750,16 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Balances = append(m.Balances, v)
+					m.Committee = append(m.Committee, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
 			}
-			m.TotalValidators = 0
+			m.CommitteeIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4658,16 +12769,16 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalValidators |= uint64(b&0x7F) << shift
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlot", wireType)
 			}
-			m.TotalActiveValidators = 0
+			m.AttesterSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4677,16 +12788,16 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				m.AttesterSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlot", wireType)
 			}
-			var byteLen int
+			m.ProposerSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4696,92 +12807,14 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ProposerSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MissingValidators = append(m.MissingValidators, make([]byte, postIndex-iNdEx))
-			copy(m.MissingValidators[len(m.MissingValidators)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageActiveValidatorBalance", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.AverageActiveValidatorBalance = float32(math.Float32frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4808,9 +12841,30 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4836,7 +12890,7 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4859,17 +12913,17 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4879,29 +12933,35 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= ValidatorStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositBlockNumber", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Eth1DepositBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1DepositBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInclusionSlot", wireType)
 			}
-			var msglen int
+			m.DepositInclusionSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4911,26 +12971,49 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DepositInclusionSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthServices
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
 			}
-			m.Statuses = append(m.Statuses, &ValidatorActivationResponse_Status{})
-			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PositionInActivationQueue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4956,7 +13039,7 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
+func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4979,17 +13062,17 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4999,31 +13082,16 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5033,26 +13101,24 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &ValidatorStatusResponse{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Domain = append(m.Domain[:0], dAtA[iNdEx:postIndex]...)
+			if m.Domain == nil {
+				m.Domain = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5080,7 +13146,7 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5103,17 +13169,17 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExitedValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExitedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
 			}
-			var byteLen int
+			m.SignatureDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5123,24 +13189,11 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SignatureDomain |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5166,7 +13219,7 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *ForkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5189,15 +13242,15 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExitedValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExitedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5221,71 +13274,19 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PreviousVersion = append(m.PreviousVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousVersion == nil {
+				m.PreviousVersion = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersion", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5295,17 +13296,31 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Started = bool(v != 0)
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentVersion = append(m.CurrentVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentVersion == nil {
+				m.CurrentVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.GenesisTime = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5315,7 +13330,7 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GenesisTime |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5345,7 +13360,7 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitteeSubnetsSubscribeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5368,17 +13383,17 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitteeSubnetsSubscribeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitteeSubnetsSubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5388,24 +13403,24 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.Subscriptions = append(m.Subscriptions, &CommitteeSubnetSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5433,7 +13448,7 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitteeSubnetSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5456,17 +13471,17 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitteeSubnetSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitteeSubnetSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.Index = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5476,11 +13491,50 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAggregator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsAggregator = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5506,7 +13560,7 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
+func (m *ProposerDutiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5529,36 +13583,17 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposerDutiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposerDutiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
-			}
-			m.EpochStart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochStart |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5568,24 +13603,11 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5611,7 +13633,7 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
+func (m *ProposerDutiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5634,15 +13656,15 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposerDutiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposerDutiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAssignment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5669,8 +13691,8 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAssignment = append(m.ValidatorAssignment, &AssignmentResponse_ValidatorAssignment{})
-			if err := m.ValidatorAssignment[len(m.ValidatorAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Duties = append(m.Duties, &ProposerDutiesResponse_Duty{})
+			if err := m.Duties[len(m.Duties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5699,7 +13721,7 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
+func (m *ProposerDutiesResponse_Duty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5709,125 +13731,30 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowServices
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAssignment: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Committee = append(m.Committee, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthServices
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthServices
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Committee) == 0 {
-					m.Committee = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowServices
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Committee = append(m.Committee, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
-			}
-			m.CommitteeIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitteeIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Duty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Duty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.AttesterSlot = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5837,16 +13764,16 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttesterSlot |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndex", wireType)
 			}
-			m.ProposerSlot = 0
+			m.ProposerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5856,12 +13783,12 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposerSlot |= uint64(b&0x7F) << shift
+				m.ProposerIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
@@ -5895,25 +13822,6 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5939,7 +13847,7 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5962,17 +13870,17 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5982,16 +13890,17 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Syncing = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositBlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
 			}
-			m.Eth1DepositBlockNumber = 0
+			m.HeadSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6001,16 +13910,16 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eth1DepositBlockNumber |= uint64(b&0x7F) << shift
+				m.HeadSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositInclusionSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestKnownSlot", wireType)
 			}
-			m.DepositInclusionSlot = 0
+			m.HighestKnownSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6020,16 +13929,16 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositInclusionSlot |= uint64(b&0x7F) << shift
+				m.HighestKnownSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedTimeToSync", wireType)
 			}
-			m.ActivationEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6039,16 +13948,87 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthServices
 			}
-			m.PositionInActivationQueue = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedTimeToSync == nil {
+				m.EstimatedTimeToSync = &types.Duration{}
+			}
+			if err := m.EstimatedTimeToSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6058,11 +14038,26 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tree = append(m.Tree, &BlockTreeResponse_TreeNode{})
+			if err := m.Tree[len(m.Tree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6088,7 +14083,7 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DomainRequest) Unmarshal(dAtA []byte) error {
+func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6111,17 +14106,17 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DomainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6131,14 +14126,31 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v1alpha1.BeaconBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6165,11 +14177,49 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = append(m.Domain[:0], dAtA[iNdEx:postIndex]...)
-			if m.Domain == nil {
-				m.Domain = []byte{}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedVotes", wireType)
+			}
+			m.ParticipatedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipatedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			m.TotalVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6195,7 +14245,7 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DomainResponse) Unmarshal(dAtA []byte) error {
+func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6218,17 +14268,17 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeBlockSlotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeBlockSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotFrom", wireType)
 			}
-			m.SignatureDomain = 0
+			m.SlotFrom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6238,7 +14288,26 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignatureDomain |= uint64(b&0x7F) << shift
+				m.SlotFrom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotTo", wireType)
+			}
+			m.SlotTo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotTo |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6268,7 +14337,7 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
+func (m *HeadUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6291,17 +14360,17 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockTreeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeadUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeadUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6311,26 +14380,45 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tree = append(m.Tree, &BlockTreeResponse_TreeNode{})
-			if err := m.Tree[len(m.Tree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.HeadRoot = append(m.HeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeadRoot == nil {
+				m.HeadRoot = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6356,7 +14444,7 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
+func (m *SpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6379,15 +14467,15 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6414,85 +14502,104 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &v1alpha1.BeaconBlock{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			if m.Data == nil {
+				m.Data = make(map[string]string)
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthServices
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthServices
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthServices
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthServices
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipServices(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthServices
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
-			}
+			m.Data[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedVotes", wireType)
-			}
-			m.ParticipatedVotes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ParticipatedVotes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
-			}
-			m.TotalVotes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalVotes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6518,7 +14625,7 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
+func (m *AggregatedAttestationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6541,17 +14648,17 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeBlockSlotRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedAttestationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeBlockSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotFrom", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationDataRoot", wireType)
 			}
-			m.SlotFrom = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6561,30 +14668,26 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotFrom |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotTo", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
 			}
-			m.SlotTo = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlotTo |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.AttestationDataRoot = append(m.AttestationDataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationDataRoot == nil {
+				m.AttestationDataRoot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6613,6 +14716,7 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 func skipServices(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -6644,10 +14748,8 @@ func skipServices(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -6668,55 +14770,30 @@ func skipServices(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthServices
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthServices
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipServices(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthServices
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupServices
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthServices
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthServices = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowServices   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthServices        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowServices          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupServices = fmt.Errorf("proto: unexpected end of group")
 )
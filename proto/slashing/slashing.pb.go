@@ -32,8 +32,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ValidatorIDToIdxAtt struct {
-	Indices              []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
-	DataRoot             []byte   `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	Indices  []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	DataRoot []byte   `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// 96 bytes aggregate signature.
 	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -142,11 +143,11 @@ func (m *ValidatorIDToIdxAttList) GetIndicesList() []*ValidatorIDToIdxAtt {
 }
 
 type ProposerSlashingRequest struct {
-	BlockHeader          *v1alpha1.BeaconBlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
-	ValidatorIndex       uint64                      `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	BlockHeader          *v1alpha1.SignedBeaconBlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	ValidatorIndex       uint64                            `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
 func (m *ProposerSlashingRequest) Reset()         { *m = ProposerSlashingRequest{} }
@@ -182,7 +183,7 @@ func (m *ProposerSlashingRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProposerSlashingRequest proto.InternalMessageInfo
 
-func (m *ProposerSlashingRequest) GetBlockHeader() *v1alpha1.BeaconBlockHeader {
+func (m *ProposerSlashingRequest) GetBlockHeader() *v1alpha1.SignedBeaconBlockHeader {
 	if m != nil {
 		return m.BlockHeader
 	}
@@ -290,6 +291,57 @@ func (m *AttesterSlashingResponse) GetAttesterSlashing() []*v1alpha1.AttesterSla
 	return nil
 }
 
+type SlashingsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlashingsRequest) Reset()         { *m = SlashingsRequest{} }
+func (m *SlashingsRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashingsRequest) ProtoMessage()    {}
+func (*SlashingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{5}
+}
+func (m *SlashingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingsRequest.Merge(m, src)
+}
+func (m *SlashingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingsRequest proto.InternalMessageInfo
+
+func (m *SlashingsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// In order to detect surrounded attestation we need to compare
+// each attestation source to those spans
+// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
+// for further details.
 type MinMaxEpochSpan struct {
 	MinEpochSpan         uint32   `protobuf:"varint,1,opt,name=min_epoch_span,json=minEpochSpan,proto3" json:"min_epoch_span,omitempty"`
 	MaxEpochSpan         uint32   `protobuf:"varint,2,opt,name=max_epoch_span,json=maxEpochSpan,proto3" json:"max_epoch_span,omitempty"`
@@ -302,7 +354,7 @@ func (m *MinMaxEpochSpan) Reset()         { *m = MinMaxEpochSpan{} }
 func (m *MinMaxEpochSpan) String() string { return proto.CompactTextString(m) }
 func (*MinMaxEpochSpan) ProtoMessage()    {}
 func (*MinMaxEpochSpan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{5}
+	return fileDescriptor_da7e95107d0081b4, []int{6}
 }
 func (m *MinMaxEpochSpan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +397,12 @@ func (m *MinMaxEpochSpan) GetMaxEpochSpan() uint32 {
 	return 0
 }
 
+// Every validator will have their own spans map containing min distance from each epoch
+// to the closest target epoch of another attestation (surrounded) and max distance to
+// a target attestation (surrounding), in order to detect slashable attestation as quickly
+// as possible.
 type EpochSpanMap struct {
+	// uint64 is for storing the epoch
 	EpochSpanMap         map[uint64]*MinMaxEpochSpan `protobuf:"bytes,1,rep,name=epoch_span_map,json=epochSpanMap,proto3" json:"epoch_span_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
@@ -356,7 +413,7 @@ func (m *EpochSpanMap) Reset()         { *m = EpochSpanMap{} }
 func (m *EpochSpanMap) String() string { return proto.CompactTextString(m) }
 func (*EpochSpanMap) ProtoMessage()    {}
 func (*EpochSpanMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{6}
+	return fileDescriptor_da7e95107d0081b4, []int{7}
 }
 func (m *EpochSpanMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,6 +449,10 @@ func (m *EpochSpanMap) GetEpochSpanMap() map[uint64]*MinMaxEpochSpan {
 	return nil
 }
 
+// ProposalHistory defines the structure for recording a validators historical proposals.
+// Using a bitlist to represent the epochs and an uint64 to mark the latest marked
+// epoch of the bitlist, we can easily store which epochs a validator has proposed
+// a block for while pruning the older data.
 type ProposalHistory struct {
 	EpochBits            github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,1,opt,name=epoch_bits,json=epochBits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"epoch_bits,omitempty"`
 	LatestEpochWritten   uint64                                       `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
@@ -404,7 +465,7 @@ func (m *ProposalHistory) Reset()         { *m = ProposalHistory{} }
 func (m *ProposalHistory) String() string { return proto.CompactTextString(m) }
 func (*ProposalHistory) ProtoMessage()    {}
 func (*ProposalHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{7}
+	return fileDescriptor_da7e95107d0081b4, []int{8}
 }
 func (m *ProposalHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,6 +514,7 @@ func init() {
 	proto.RegisterType((*ProposerSlashingRequest)(nil), "ethereum.slashing.ProposerSlashingRequest")
 	proto.RegisterType((*ProposerSlashingResponse)(nil), "ethereum.slashing.ProposerSlashingResponse")
 	proto.RegisterType((*AttesterSlashingResponse)(nil), "ethereum.slashing.AttesterSlashingResponse")
+	proto.RegisterType((*SlashingsRequest)(nil), "ethereum.slashing.SlashingsRequest")
 	proto.RegisterType((*MinMaxEpochSpan)(nil), "ethereum.slashing.MinMaxEpochSpan")
 	proto.RegisterType((*EpochSpanMap)(nil), "ethereum.slashing.EpochSpanMap")
 	proto.RegisterMapType((map[uint64]*MinMaxEpochSpan)(nil), "ethereum.slashing.EpochSpanMap.EpochSpanMapEntry")
@@ -462,52 +524,55 @@ func init() {
 func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
 
 var fileDescriptor_da7e95107d0081b4 = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x4a,
-	0x10, 0x97, 0x9b, 0xbe, 0xd7, 0xd7, 0x4d, 0x5e, 0x9b, 0xee, 0x7b, 0xb4, 0x51, 0x0a, 0x6d, 0x65,
-	0x21, 0x1a, 0xfe, 0xd4, 0x49, 0xc3, 0xa5, 0xe2, 0xd6, 0x88, 0x4a, 0x8d, 0xa0, 0x02, 0xb9, 0x15,
-	0x95, 0x90, 0x90, 0xb5, 0xb6, 0xb7, 0xf6, 0x52, 0x67, 0x77, 0xf1, 0x4e, 0x4a, 0xf2, 0x29, 0xb8,
-	0x70, 0xe5, 0xfb, 0x70, 0xe0, 0xc0, 0x27, 0x40, 0xa8, 0x1f, 0x83, 0x13, 0xf2, 0xda, 0x49, 0xdc,
-	0xc4, 0x91, 0xca, 0x6d, 0x67, 0xe6, 0x37, 0xf3, 0x9b, 0xfd, 0xed, 0xcc, 0xa2, 0x7b, 0x32, 0x16,
-	0x20, 0x9a, 0x2a, 0x22, 0x2a, 0x64, 0x3c, 0x18, 0x1f, 0x2c, 0xed, 0xc7, 0x6b, 0x14, 0x42, 0x1a,
-	0xd3, 0x7e, 0xcf, 0x1a, 0x05, 0xea, 0x9b, 0x81, 0x10, 0x41, 0x44, 0x9b, 0x1a, 0xe0, 0xf6, 0x2f,
-	0x9a, 0xb4, 0x27, 0x61, 0x98, 0xe2, 0xeb, 0xdb, 0x14, 0xc2, 0xe6, 0xd5, 0x3e, 0x89, 0x64, 0x48,
-	0xf6, 0x9b, 0x2e, 0x25, 0x9e, 0xe0, 0x8e, 0x1b, 0x09, 0xef, 0x32, 0x03, 0xec, 0x05, 0x0c, 0xc2,
-	0xbe, 0x6b, 0x79, 0xa2, 0xd7, 0x0c, 0x44, 0x20, 0x26, 0x65, 0x12, 0x2b, 0x6d, 0x26, 0x39, 0xa5,
-	0x70, 0xf3, 0x3d, 0xfa, 0xef, 0x0d, 0x89, 0x98, 0x4f, 0x40, 0xc4, 0xdd, 0xe7, 0x67, 0xa2, 0xeb,
-	0x0f, 0x0e, 0x01, 0x70, 0x0d, 0x2d, 0x31, 0xee, 0x33, 0x8f, 0xaa, 0x9a, 0xb1, 0x53, 0x6a, 0x2c,
-	0xda, 0x23, 0x13, 0x6f, 0xa2, 0x65, 0x9f, 0x00, 0x71, 0x62, 0x21, 0xa0, 0xb6, 0xb0, 0x63, 0x34,
-	0x2a, 0xf6, 0x3f, 0x89, 0xc3, 0x16, 0x02, 0xf0, 0x5d, 0xb4, 0xac, 0x58, 0xc0, 0x09, 0xf4, 0x63,
-	0x5a, 0x2b, 0xe9, 0xe0, 0xc4, 0x61, 0x7a, 0x68, 0xa3, 0x80, 0xeb, 0x25, 0x53, 0x80, 0x8f, 0x51,
-	0x39, 0x23, 0x48, 0x4c, 0xcd, 0x59, 0x6e, 0x3f, 0xb0, 0x66, 0xc4, 0xb1, 0x0a, 0x0a, 0xd8, 0xf9,
-	0x54, 0xf3, 0x93, 0x81, 0x36, 0x5e, 0xc7, 0x42, 0x0a, 0x45, 0xe3, 0xd3, 0x2c, 0xcb, 0xa6, 0x1f,
-	0xfa, 0x54, 0x01, 0x7e, 0x81, 0x2a, 0x5a, 0x2a, 0x27, 0xa4, 0xc4, 0xa7, 0x71, 0xcd, 0xd8, 0x31,
-	0x1a, 0xe5, 0x76, 0x63, 0x42, 0x43, 0x21, 0xb4, 0x46, 0xe2, 0x5a, 0x1d, 0x2d, 0x6e, 0x27, 0x49,
-	0x38, 0xd6, 0x78, 0xbb, 0xec, 0x4e, 0x0c, 0xbc, 0x8b, 0x56, 0xaf, 0x46, 0xcd, 0x38, 0x8c, 0xfb,
-	0x74, 0xa0, 0xe5, 0x58, 0xb4, 0x57, 0xc6, 0xee, 0x6e, 0xe2, 0x35, 0x25, 0xaa, 0xcd, 0x36, 0xa4,
-	0xa4, 0xe0, 0x8a, 0xe2, 0x33, 0xb4, 0x26, 0xb3, 0x98, 0x33, 0xba, 0x63, 0x76, 0xfb, 0xdd, 0x39,
-	0x6d, 0xcd, 0xd4, 0xaa, 0xca, 0x29, 0x4f, 0xc2, 0x78, 0x08, 0x40, 0x15, 0x14, 0x33, 0x92, 0x2c,
-	0x76, 0x5b, 0xc6, 0x99, 0x5a, 0x55, 0x32, 0xe5, 0x31, 0xdf, 0xa1, 0xd5, 0x13, 0xc6, 0x4f, 0xc8,
-	0xe0, 0x48, 0x0a, 0x2f, 0x3c, 0x95, 0x84, 0xe3, 0xfb, 0x68, 0xa5, 0xc7, 0xb8, 0x43, 0x13, 0x87,
-	0xa3, 0x24, 0xe1, 0x5a, 0xee, 0x7f, 0xed, 0x4a, 0x8f, 0xf1, 0x9b, 0x28, 0x32, 0xc8, 0xa3, 0x16,
-	0x32, 0x54, 0xae, 0x96, 0xf9, 0xcd, 0x40, 0x95, 0xb1, 0x75, 0x42, 0x24, 0x3e, 0x47, 0x2b, 0x93,
-	0x14, 0xa7, 0x47, 0x64, 0x76, 0x85, 0xfd, 0x82, 0x91, 0xc9, 0x27, 0xde, 0x30, 0x8e, 0x38, 0xc4,
-	0x43, 0xbb, 0x42, 0x73, 0xae, 0xba, 0x87, 0xd6, 0x66, 0x20, 0xb8, 0x8a, 0x4a, 0x97, 0x74, 0xa8,
-	0xfb, 0x5f, 0xb4, 0x93, 0x23, 0x3e, 0x40, 0x7f, 0x5d, 0x91, 0xa8, 0x4f, 0x75, 0xb7, 0xe5, 0xb6,
-	0x59, 0x40, 0x3b, 0xa5, 0x87, 0x9d, 0x26, 0x3c, 0x5b, 0x38, 0x30, 0xcc, 0xcf, 0x06, 0x5a, 0x4d,
-	0x9f, 0x91, 0x44, 0xc7, 0x4c, 0x81, 0x88, 0x87, 0xf8, 0x15, 0x42, 0xe9, 0x8d, 0x5c, 0x06, 0x4a,
-	0x53, 0x55, 0x3a, 0xad, 0x5f, 0x3f, 0xb6, 0x9f, 0xe4, 0xf6, 0x59, 0xc6, 0x43, 0xd5, 0x23, 0xc0,
-	0xbc, 0x88, 0xb8, 0xaa, 0x19, 0x88, 0x3d, 0x97, 0xc1, 0x05, 0xa3, 0x91, 0x6f, 0x75, 0x18, 0x44,
-	0x4c, 0x81, 0xbd, 0xac, 0x6b, 0x74, 0x18, 0x28, 0xdc, 0x42, 0xff, 0x47, 0x24, 0x79, 0xa6, 0x4c,
-	0xdc, 0x8f, 0x31, 0x03, 0xa0, 0x3c, 0x1b, 0x52, 0x9c, 0xc6, 0x74, 0x7b, 0xe7, 0x69, 0xa4, 0xfd,
-	0xa5, 0x84, 0x96, 0xf4, 0x8b, 0xd2, 0x18, 0x4b, 0xb4, 0xde, 0x55, 0xda, 0x20, 0x6e, 0x44, 0xd3,
-	0x09, 0x20, 0xc0, 0x04, 0xc7, 0x0f, 0xe7, 0x4c, 0x89, 0x1e, 0x76, 0xea, 0xe7, 0xa0, 0xf5, 0xc7,
-	0x05, 0xb2, 0xcc, 0x1d, 0xcc, 0x4b, 0x54, 0xcd, 0x31, 0xea, 0xb5, 0xc3, 0x8f, 0x0a, 0x0a, 0xcc,
-	0x59, 0xee, 0x42, 0xb2, 0xb9, 0x7b, 0x77, 0x8e, 0xf0, 0x98, 0x6a, 0xf4, 0x12, 0x0a, 0xaf, 0x5b,
-	0xe9, 0xd7, 0x6b, 0x8d, 0xfe, 0x4c, 0xeb, 0x28, 0xf9, 0x7a, 0xeb, 0xb7, 0x5d, 0xc5, 0x96, 0x81,
-	0xdf, 0xa2, 0x3b, 0x45, 0xaa, 0xfd, 0x79, 0xed, 0x69, 0x9d, 0x5a, 0x46, 0xa7, 0xf2, 0xf5, 0x7a,
-	0xcb, 0xf8, 0x7e, 0xbd, 0x65, 0xfc, 0xbc, 0xde, 0x32, 0xdc, 0xbf, 0x75, 0xa1, 0xa7, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x6b, 0x4f, 0x9c, 0xca, 0x61, 0x06, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xd1, 0x4e, 0xdb, 0x4a,
+	0x10, 0x95, 0x21, 0x5c, 0x2e, 0x93, 0x5c, 0x08, 0x7b, 0xb9, 0x90, 0x1b, 0x5a, 0x40, 0x6e, 0x55,
+	0xd2, 0x52, 0x9c, 0x90, 0xbe, 0xa0, 0xbe, 0x11, 0x15, 0x89, 0x48, 0x45, 0x6d, 0x0d, 0x2a, 0x52,
+	0xa5, 0xca, 0x5a, 0xdb, 0x8b, 0xbd, 0x60, 0x7b, 0x5d, 0xef, 0x86, 0x26, 0xff, 0xd1, 0xfe, 0x53,
+	0x1f, 0xfa, 0xd0, 0x2f, 0xa8, 0x2a, 0xbe, 0xa2, 0xea, 0x53, 0xe5, 0xb5, 0x9d, 0x98, 0xc4, 0x91,
+	0xa0, 0x6f, 0x3b, 0x33, 0x67, 0xe6, 0xcc, 0x9e, 0xd9, 0x1d, 0xb8, 0x1f, 0x46, 0x4c, 0xb0, 0x26,
+	0xf7, 0x30, 0x77, 0x69, 0xe0, 0x0c, 0x0f, 0x9a, 0xf4, 0xa3, 0x65, 0x22, 0x5c, 0x12, 0x91, 0x9e,
+	0xaf, 0x65, 0x81, 0xfa, 0xba, 0xc3, 0x98, 0xe3, 0x91, 0xa6, 0x04, 0x98, 0xbd, 0xf3, 0x26, 0xf1,
+	0x43, 0x31, 0x48, 0xf0, 0xf5, 0x4d, 0x22, 0xdc, 0xe6, 0xd5, 0x1e, 0xf6, 0x42, 0x17, 0xef, 0x35,
+	0x4d, 0x82, 0x2d, 0x16, 0x18, 0xa6, 0xc7, 0xac, 0xcb, 0x14, 0xb0, 0xeb, 0x50, 0xe1, 0xf6, 0x4c,
+	0xcd, 0x62, 0x7e, 0xd3, 0x61, 0x0e, 0x1b, 0x95, 0x89, 0xad, 0xa4, 0x99, 0xf8, 0x94, 0xc0, 0xd5,
+	0x0b, 0xf8, 0xf7, 0x2d, 0xf6, 0xa8, 0x8d, 0x05, 0x8b, 0xba, 0x2f, 0x4e, 0x59, 0xd7, 0xee, 0x1f,
+	0x08, 0x81, 0x6a, 0x30, 0x4f, 0x03, 0x9b, 0x5a, 0x84, 0xd7, 0x94, 0xad, 0xd9, 0x46, 0x49, 0xcf,
+	0x4c, 0xb4, 0x0e, 0x0b, 0x36, 0x16, 0xd8, 0x88, 0x18, 0x13, 0xb5, 0x99, 0x2d, 0xa5, 0x51, 0xd1,
+	0xff, 0x8e, 0x1d, 0x3a, 0x63, 0x02, 0xdd, 0x83, 0x05, 0x4e, 0x9d, 0x00, 0x8b, 0x5e, 0x44, 0x6a,
+	0xb3, 0x32, 0x38, 0x72, 0xa8, 0x16, 0xac, 0x15, 0x70, 0xbd, 0xa4, 0x5c, 0xa0, 0x23, 0x28, 0xa7,
+	0x04, 0xb1, 0x29, 0x39, 0xcb, 0xed, 0x47, 0xda, 0x84, 0x38, 0x5a, 0x41, 0x01, 0x3d, 0x9f, 0xaa,
+	0x7e, 0x56, 0x60, 0xed, 0x75, 0xc4, 0x42, 0xc6, 0x49, 0x74, 0x92, 0x66, 0xe9, 0xe4, 0x43, 0x8f,
+	0x70, 0x81, 0xde, 0x40, 0x45, 0x4a, 0x65, 0xb8, 0x04, 0xdb, 0x24, 0xaa, 0x29, 0x5b, 0x4a, 0xa3,
+	0xdc, 0xd6, 0x46, 0x34, 0x44, 0xb8, 0x5a, 0x26, 0xae, 0x76, 0x42, 0x9d, 0x80, 0xd8, 0x1d, 0x29,
+	0x71, 0x27, 0x4e, 0x3b, 0x92, 0x59, 0x7a, 0xd9, 0x1c, 0x19, 0x68, 0x1b, 0x96, 0xae, 0xb2, 0x96,
+	0x0c, 0x1a, 0xd8, 0xa4, 0x2f, 0x45, 0x29, 0xe9, 0x8b, 0x43, 0x77, 0x37, 0xf6, 0xaa, 0x21, 0xd4,
+	0x26, 0xdb, 0xe2, 0x21, 0x0b, 0x38, 0x41, 0xa7, 0xb0, 0x1c, 0xa6, 0x31, 0x23, 0xbb, 0x69, 0xaa,
+	0xc1, 0xf6, 0x94, 0xe6, 0x26, 0x6a, 0x55, 0xc3, 0x31, 0x4f, 0xcc, 0x78, 0x20, 0x04, 0xe1, 0xa2,
+	0x98, 0x11, 0xa7, 0xb1, 0xdb, 0x32, 0x4e, 0xd4, 0xaa, 0xe2, 0x31, 0x8f, 0xda, 0x80, 0x6a, 0x76,
+	0xe6, 0x99, 0xe6, 0x2b, 0x30, 0x47, 0x42, 0x66, 0xb9, 0x52, 0xec, 0x92, 0x9e, 0x18, 0xea, 0x7b,
+	0x58, 0x3a, 0xa6, 0xc1, 0x31, 0xee, 0x1f, 0xc6, 0xe6, 0x49, 0x88, 0x03, 0xf4, 0x10, 0x16, 0x7d,
+	0x1a, 0x18, 0x32, 0x6e, 0xf0, 0x10, 0x07, 0x32, 0xe3, 0x1f, 0xbd, 0xe2, 0xd3, 0xe0, 0x26, 0x0a,
+	0xf7, 0xf3, 0xa8, 0x99, 0x14, 0x95, 0xab, 0xa5, 0x7e, 0x55, 0xa0, 0x32, 0xb4, 0x8e, 0x71, 0x88,
+	0xce, 0x60, 0x71, 0x94, 0x62, 0xf8, 0x38, 0x4c, 0x2f, 0xbb, 0x57, 0xf0, 0xc4, 0xf2, 0x89, 0x37,
+	0x8c, 0xc3, 0x40, 0x44, 0x03, 0xbd, 0x42, 0x72, 0xae, 0xba, 0x05, 0xcb, 0x13, 0x10, 0x54, 0x85,
+	0xd9, 0x4b, 0x32, 0x48, 0x6f, 0x1c, 0x1f, 0xd1, 0x3e, 0xcc, 0x5d, 0x61, 0xaf, 0x47, 0x64, 0xb7,
+	0xe5, 0xb6, 0x5a, 0x40, 0x3b, 0xa6, 0x87, 0x9e, 0x24, 0x3c, 0x9f, 0xd9, 0x57, 0xd4, 0x4f, 0x0a,
+	0x2c, 0x25, 0x03, 0xc7, 0xde, 0x11, 0xe5, 0x82, 0x45, 0x03, 0xf4, 0x0a, 0x20, 0xb9, 0x91, 0x49,
+	0x05, 0x97, 0x54, 0x95, 0x4e, 0xeb, 0xd7, 0xf7, 0xcd, 0xa7, 0xb9, 0xff, 0x1f, 0x46, 0x03, 0xee,
+	0x63, 0x41, 0x2d, 0x0f, 0x9b, 0xbc, 0xe9, 0xb0, 0x5d, 0x93, 0x8a, 0x73, 0x4a, 0x3c, 0x5b, 0xeb,
+	0x50, 0xe1, 0x51, 0x2e, 0xf4, 0x05, 0x59, 0xa3, 0x43, 0x05, 0x47, 0x2d, 0x58, 0xf1, 0x70, 0x3c,
+	0xd0, 0x54, 0xdc, 0x8f, 0x11, 0x15, 0x82, 0x04, 0xe9, 0x73, 0x46, 0x49, 0x4c, 0xb6, 0x77, 0x96,
+	0x44, 0xda, 0x3f, 0x4b, 0x30, 0x2f, 0xe7, 0x4d, 0x22, 0x14, 0xc2, 0x6a, 0x97, 0x4b, 0x03, 0x9b,
+	0x1e, 0x49, 0xde, 0x0a, 0x16, 0x94, 0x05, 0xe8, 0xf1, 0x94, 0xf7, 0x24, 0xbf, 0x05, 0xb1, 0x73,
+	0xd0, 0xfa, 0x4e, 0x81, 0x2c, 0x53, 0x9f, 0xf0, 0x25, 0x54, 0x73, 0x8c, 0xf2, 0x83, 0xa2, 0x27,
+	0x05, 0x05, 0xa6, 0x2c, 0x83, 0xfa, 0xce, 0xad, 0xb0, 0x29, 0xd9, 0x19, 0xa0, 0x21, 0x55, 0x36,
+	0x09, 0x8e, 0x56, 0xb5, 0x64, 0x55, 0x6b, 0xd9, 0x8e, 0xd5, 0x0e, 0xe3, 0x55, 0x5d, 0xbf, 0xed,
+	0xa7, 0x6d, 0x29, 0xe8, 0x1d, 0xfc, 0x57, 0xa4, 0xda, 0xdd, 0x6b, 0x8f, 0xeb, 0xd4, 0x52, 0x90,
+	0x0f, 0xff, 0xc7, 0x2b, 0xb1, 0xb8, 0xfe, 0x83, 0x82, 0xeb, 0x8f, 0x7f, 0xde, 0xbb, 0x0d, 0xe4,
+	0x02, 0x56, 0x6f, 0xd0, 0x8d, 0x74, 0xfa, 0x63, 0xae, 0x69, 0xf3, 0xe8, 0x54, 0xbe, 0x5c, 0x6f,
+	0x28, 0xdf, 0xae, 0x37, 0x94, 0x1f, 0xd7, 0x1b, 0x8a, 0xf9, 0x97, 0xd4, 0xe8, 0xd9, 0xef, 0x01,
+	0x00, 0x75, 0xe2, 0x17, 0x63, 0x6c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -522,10 +587,20 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type SlasherClient interface {
+	// Gets AttesterSlashing container if the attestation that
+	// was received produces a slashable event.
 	IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
+	// Gets ProposerSlashing container if the block header that
+	// was received produces a slashable event.
 	IsSlashableBlock(ctx context.Context, in *ProposerSlashingRequest, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
+	// Subscription to receive all slashable proposer slashing events found by the watchtower.
 	SlashableProposals(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_SlashableProposalsClient, error)
+	// Subscription to receive all slashable attester slashing events found by the watchtower.
 	SlashableAttestations(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_SlashableAttestationsClient, error)
+	// Lists the attester slashings detected for the requested epoch.
+	ListSlashableAttestations(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
+	// Lists the proposer slashings detected for the requested epoch.
+	ListSlashableProposals(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
 }
 
 type slasherClient struct {
@@ -618,12 +693,40 @@ func (x *slasherSlashableAttestationsClient) Recv() (*v1alpha1.AttesterSlashing,
 	return m, nil
 }
 
+func (c *slasherClient) ListSlashableAttestations(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*AttesterSlashingResponse, error) {
+	out := new(AttesterSlashingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/ListSlashableAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) ListSlashableProposals(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*ProposerSlashingResponse, error) {
+	out := new(ProposerSlashingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/ListSlashableProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SlasherServer is the server API for Slasher service.
 type SlasherServer interface {
+	// Gets AttesterSlashing container if the attestation that
+	// was received produces a slashable event.
 	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
+	// Gets ProposerSlashing container if the block header that
+	// was received produces a slashable event.
 	IsSlashableBlock(context.Context, *ProposerSlashingRequest) (*ProposerSlashingResponse, error)
+	// Subscription to receive all slashable proposer slashing events found by the watchtower.
 	SlashableProposals(*types.Empty, Slasher_SlashableProposalsServer) error
+	// Subscription to receive all slashable attester slashing events found by the watchtower.
 	SlashableAttestations(*types.Empty, Slasher_SlashableAttestationsServer) error
+	// Lists the attester slashings detected for the requested epoch.
+	ListSlashableAttestations(context.Context, *SlashingsRequest) (*AttesterSlashingResponse, error)
+	// Lists the proposer slashings detected for the requested epoch.
+	ListSlashableProposals(context.Context, *SlashingsRequest) (*ProposerSlashingResponse, error)
 }
 
 // UnimplementedSlasherServer can be embedded to have forward compatible implementations.
@@ -642,6 +745,12 @@ func (*UnimplementedSlasherServer) SlashableProposals(req *types.Empty, srv Slas
 func (*UnimplementedSlasherServer) SlashableAttestations(req *types.Empty, srv Slasher_SlashableAttestationsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SlashableAttestations not implemented")
 }
+func (*UnimplementedSlasherServer) ListSlashableAttestations(ctx context.Context, req *SlashingsRequest) (*AttesterSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlashableAttestations not implemented")
+}
+func (*UnimplementedSlasherServer) ListSlashableProposals(ctx context.Context, req *SlashingsRequest) (*ProposerSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlashableProposals not implemented")
+}
 
 func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
 	s.RegisterService(&_Slasher_serviceDesc, srv)
@@ -725,6 +834,42 @@ func (x *slasherSlashableAttestationsServer) Send(m *v1alpha1.AttesterSlashing)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Slasher_ListSlashableAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ListSlashableAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/ListSlashableAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ListSlashableAttestations(ctx, req.(*SlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_ListSlashableProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ListSlashableProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/ListSlashableProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ListSlashableProposals(ctx, req.(*SlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Slasher_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.slashing.Slasher",
 	HandlerType: (*SlasherServer)(nil),
@@ -737,6 +882,14 @@ var _Slasher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsSlashableBlock",
 			Handler:    _Slasher_IsSlashableBlock_Handler,
 		},
+		{
+			MethodName: "ListSlashableAttestations",
+			Handler:    _Slasher_ListSlashableAttestations_Handler,
+		},
+		{
+			MethodName: "ListSlashableProposals",
+			Handler:    _Slasher_ListSlashableProposals_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -979,6 +1132,38 @@ func (m *AttesterSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MinMaxEpochSpan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1217,6 +1402,21 @@ func (m *AttesterSlashingResponse) Size() (n int) {
 	return n
 }
 
+func (m *SlashingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovSlashing(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *MinMaxEpochSpan) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1630,7 +1830,7 @@ func (m *ProposerSlashingRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.BlockHeader == nil {
-				m.BlockHeader = &v1alpha1.BeaconBlockHeader{}
+				m.BlockHeader = &v1alpha1.SignedBeaconBlockHeader{}
 			}
 			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1856,6 +2056,79 @@ func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MinMaxEpochSpan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2227,6 +2500,7 @@ func (m *ProposalHistory) Unmarshal(dAtA []byte) error {
 func skipSlashing(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2258,10 +2532,8 @@ func skipSlashing(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -2282,55 +2554,30 @@ func skipSlashing(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthSlashing
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthSlashing
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowSlashing
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipSlashing(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthSlashing
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthSlashing = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowSlashing   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
 )
@@ -4,6 +4,7 @@
 package bls
 
 import (
+	"crypto/rand"
 	"encoding/binary"
 	"fmt"
 
@@ -221,6 +222,72 @@ func (s *Signature) VerifyAggregateCommon(pubKeys []*PublicKey, msg [32]byte, do
 	return s.s.VerifyHashWithDomain(aggregated.p, concatMsgAndDomain(msg[:], domain))
 }
 
+// VerifyMultipleSignatures verifies a batch of signatures, each one over its own message and
+// domain by its own public key, with a single aggregate pairing check. Every signature and its
+// public key are weighted by the same independent random scalar before being aggregated, so
+// invalid signatures can't be crafted to cancel each other out in the aggregate. Public keys
+// signing the same message under the same domain are aggregated first, so duplicate messages are
+// allowed. A failing batch needs to be verified signature by signature to find out which one is
+// invalid.
+func VerifyMultipleSignatures(sigs []*Signature, msgs [][32]byte, pubKeys []*PublicKey, domains []uint64) (bool, error) {
+	if featureconfig.Get().SkipBLSVerify {
+		return true, nil
+	}
+	size := len(sigs)
+	if size == 0 {
+		return false, nil
+	}
+	if size != len(msgs) || size != len(pubKeys) || size != len(domains) {
+		return false, fmt.Errorf("provided %d signatures, %d messages, %d public keys and %d domains", size, len(msgs), len(pubKeys), len(domains))
+	}
+	keyIndices := make(map[[concatMsgDomainSize]byte]int, size)
+	hashWithDomains := make([]byte, 0, size*concatMsgDomainSize)
+	var rawKeys []bls12.PublicKey
+	var aggregated bls12.Sign
+	for i := 0; i < size; i++ {
+		r, err := batchScalar()
+		if err != nil {
+			return false, errors.Wrap(err, "could not generate random scalar")
+		}
+		var sig bls12.Sign
+		bls12.G2Mul(bls12.CastFromSign(&sig), bls12.CastFromSign(sigs[i].s), r)
+		var pub bls12.PublicKey
+		bls12.G1Mul(bls12.CastFromPublicKey(&pub), bls12.CastFromPublicKey(pubKeys[i].p), r)
+		if i == 0 {
+			aggregated = sig
+		} else {
+			aggregated.Add(&sig)
+		}
+
+		var hashWithDomain [concatMsgDomainSize]byte
+		copy(hashWithDomain[:], concatMsgAndDomain(msgs[i][:], domains[i]))
+		if j, ok := keyIndices[hashWithDomain]; ok {
+			rawKeys[j].Add(&pub)
+			continue
+		}
+		keyIndices[hashWithDomain] = len(rawKeys)
+		hashWithDomains = append(hashWithDomains, hashWithDomain[:]...)
+		rawKeys = append(rawKeys, pub)
+	}
+	return aggregated.VerifyAggregateHashWithDomain(rawKeys, hashWithDomains), nil
+}
+
+// batchScalar returns a random non-zero 64 bit scalar to weight a signature of a batch with.
+func batchScalar() (*bls12.Fr, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	if binary.LittleEndian.Uint64(b[:]) == 0 {
+		b[0] = 1
+	}
+	r := &bls12.Fr{}
+	if err := r.SetLittleEndian(b[:]); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
 // NewAggregateSignature creates a blank aggregate signature.
 func NewAggregateSignature() *Signature {
 	return &Signature{s: bls12.HashAndMapToSignature([]byte{'m', 'o', 'c', 'k'})}
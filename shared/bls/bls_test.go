@@ -83,6 +83,63 @@ func TestVerifyAggregate_ReturnsFalseOnEmptyPubKeyList(t *testing.T) {
 	}
 }
 
+func TestVerifyMultipleSignatures(t *testing.T) {
+	pubkeys := make([]*bls.PublicKey, 0, 10)
+	sigs := make([]*bls.Signature, 0, 10)
+	var msgs [][32]byte
+	var domains []uint64
+	for i := 0; i < 10; i++ {
+		// Every other pair of signatures shares the same message and domain.
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i / 2)}
+		domain := uint64(i / 2)
+		priv := bls.RandKey()
+		pubkeys = append(pubkeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg[:], domain))
+		msgs = append(msgs, msg)
+		domains = append(domains, domain)
+	}
+	verified, err := bls.VerifyMultipleSignatures(sigs, msgs, pubkeys, domains)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified {
+		t.Error("Signatures did not verify")
+	}
+
+	sigs[3] = bls.RandKey().Sign(msgs[3][:], domains[3])
+	verified, err = bls.VerifyMultipleSignatures(sigs, msgs, pubkeys, domains)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified {
+		t.Error("Expected signatures with an invalid one not to verify")
+	}
+
+	if _, err := bls.VerifyMultipleSignatures(sigs, msgs[1:], pubkeys, domains); err == nil {
+		t.Error("Expected an error for mismatched lengths")
+	}
+}
+
+func TestVerifyMultipleSignatures_OffsettingSignatures(t *testing.T) {
+	priv := bls.RandKey()
+	msgA := [32]byte{'a'}
+	msgB := [32]byte{'b'}
+	sigA := priv.Sign(msgA[:], 0)
+	sigB := priv.Sign(msgB[:], 0)
+	pubkeys := []*bls.PublicKey{priv.PublicKey(), priv.PublicKey()}
+	msgs := [][32]byte{msgA, msgB}
+	domains := []uint64{0, 0}
+
+	// Swapped signatures are both invalid, yet their plain sum is the sum of the valid ones.
+	verified, err := bls.VerifyMultipleSignatures([]*bls.Signature{sigB, sigA}, msgs, pubkeys, domains)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified {
+		t.Error("Expected invalid signatures offsetting each other not to verify")
+	}
+}
+
 func TestComputeDomain_OK(t *testing.T) {
 	tests := []struct {
 		epoch      uint64
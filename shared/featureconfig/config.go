@@ -36,15 +36,23 @@ type Flags struct {
 	InitSyncCacheState        bool   // InitSyncCacheState caches state during initial sync.
 	KafkaBootstrapServers     string // KafkaBootstrapServers to find kafka servers to stream blocks, attestations, etc.
 	EnableSavingOfDepositData bool   // EnableSavingOfDepositData allows the saving of eth1 related data such as deposits,chain data to be saved.
+	BoundedDepositTrie        bool   // BoundedDepositTrie keeps only the deposit trie nodes of recent deposits in memory.
 	BlockDoubleProposals      bool   // BlockDoubleProposals prevents the validator client from signing any proposals that would be considered a slashable offense.
 
 	// Cache toggles.
-	EnableAttestationCache   bool // EnableAttestationCache; see https://github.com/prysmaticlabs/prysm/issues/3106.
-	EnableEth1DataVoteCache  bool // EnableEth1DataVoteCache; see https://github.com/prysmaticlabs/prysm/issues/3106.
-	EnableSkipSlotsCache     bool // EnableSkipSlotsCache caches the state in skipped slots.
-	EnableSlasherConnection  bool // EnableSlasher enable retrieval of slashing events from a slasher instance.
-	EnableBlockTreeCache     bool // EnableBlockTreeCache enable fork choice service to maintain latest filtered block tree.
-	EnableProposerIndexCache bool // EnableProposerIndexCache enable caching of proposer index.
+	EnableAttestationCache     bool // EnableAttestationCache; see https://github.com/prysmaticlabs/prysm/issues/3106.
+	EnableEth1DataVoteCache    bool // EnableEth1DataVoteCache; see https://github.com/prysmaticlabs/prysm/issues/3106.
+	EnableSkipSlotsCache       bool // EnableSkipSlotsCache caches the state in skipped slots.
+	EnableSlasherConnection    bool // EnableSlasher enable retrieval of slashing events from a slasher instance.
+	EnableBlockTreeCache       bool // EnableBlockTreeCache enable fork choice service to maintain latest filtered block tree.
+	EnableProposerIndexCache   bool // EnableProposerIndexCache enable caching of proposer index.
+	EnableEpochTransitionCache bool // EnableEpochTransitionCache caches the post state of epoch transitions by pre state root.
+
+	// Block processing toggles.
+	BatchVerifyBlockSignatures bool // BatchVerifyBlockSignatures verifies the signatures of a block in one aggregate check.
+
+	// Upcoming fork toggles.
+	EnableSyncCommitteeDuties bool // EnableSyncCommitteeDuties reports sync committee membership in validator duties.
 }
 
 var featureConfig *Flags
@@ -105,6 +113,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Enabled skip slots cache.")
 		cfg.EnableSkipSlotsCache = true
 	}
+	if ctx.GlobalBool(enableEpochTransitionCacheFlag.Name) {
+		log.Warn("Enabled epoch transition cache.")
+		cfg.EnableEpochTransitionCache = true
+	}
 	if ctx.GlobalString(kafkaBootstrapServersFlag.Name) != "" {
 		log.Warn("Enabling experimental kafka streaming.")
 		cfg.KafkaBootstrapServers = ctx.GlobalString(kafkaBootstrapServersFlag.Name)
@@ -117,6 +129,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Enabled saving of eth1 related chain/deposit data.")
 		cfg.EnableSavingOfDepositData = true
 	}
+	if ctx.GlobalBool(boundedDepositTrieFlag.Name) {
+		log.Warn("Enabled bounded-memory deposit trie.")
+		cfg.BoundedDepositTrie = true
+	}
 	if ctx.GlobalBool(enableSlasherFlag.Name) {
 		log.Warn("Enable slasher connection.")
 		cfg.EnableSlasherConnection = true
@@ -129,6 +145,14 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Enabled proposer index caching.")
 		cfg.EnableProposerIndexCache = true
 	}
+	if ctx.GlobalBool(enableSyncCommitteeDutiesFlag.Name) {
+		log.Warn("Enabled sync committee membership in validator duties.")
+		cfg.EnableSyncCommitteeDuties = true
+	}
+	if ctx.GlobalBool(batchVerifyBlockSignaturesFlag.Name) {
+		log.Warn("Enabled batch verification of block signatures.")
+		cfg.BatchVerifyBlockSignatures = true
+	}
 	Init(cfg)
 }
 
@@ -35,6 +35,10 @@ var (
 		Name:  "enable-skip-slots-cache",
 		Usage: "Enables the skip slot cache to be used in the event of skipped slots.",
 	}
+	enableEpochTransitionCacheFlag = cli.BoolFlag{
+		Name:  "enable-epoch-transition-cache",
+		Usage: "Enables caching the result of epoch transitions by pre state root, so the same epoch boundary is only processed once.",
+	}
 	kafkaBootstrapServersFlag = cli.StringFlag{
 		Name:  "kafka-url",
 		Usage: "Stream attestations and blocks to specified kafka servers. This field is used for bootstrap.servers kafka config field.",
@@ -60,6 +64,11 @@ var (
 		Name:  "save-deposit-data",
 		Usage: "Enable of the saving of deposit related data",
 	}
+	boundedDepositTrieFlag = cli.BoolFlag{
+		Name: "bounded-deposit-trie",
+		Usage: "Keep in memory only the deposit trie nodes needed for recent deposits, moving older " +
+			"nodes to the database and loading them back to prove old deposits.",
+	}
 	noGenesisDelayFlag = cli.BoolFlag{
 		Name: "no-genesis-delay",
 		Usage: "Start the genesis event right away using the eth1 block timestamp which " +
@@ -80,6 +89,16 @@ var (
 		Usage: "Prevent the validator client from signing and broadcasting 2 different block " +
 			"proposals in the same epoch. Protects from slashing.",
 	}
+	enableSyncCommitteeDutiesFlag = cli.BoolFlag{
+		Name: "enable-sync-committee-duties",
+		Usage: "Report the current and next sync committee membership of validators in their duties, " +
+			"for beacon states carrying sync committees.",
+	}
+	batchVerifyBlockSignaturesFlag = cli.BoolFlag{
+		Name: "batch-verify-block-signatures",
+		Usage: "Verify the proposer signature, RANDAO reveal and attestation signatures of a block in " +
+			"one aggregate check when processing it, falling back to individual checks if it fails.",
+	}
 )
 
 // Deprecated flags list.
@@ -201,8 +220,12 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	kafkaBootstrapServersFlag,
 	enableBackupWebhookFlag,
 	enableSkipSlotsCacheFlag,
+	enableEpochTransitionCacheFlag,
 	saveDepositDataFlag,
+	boundedDepositTrieFlag,
 	enableSlasherFlag,
 	cacheFilteredBlockTreeFlag,
 	cacheProposerIndicesFlag,
+	enableSyncCommitteeDutiesFlag,
+	batchVerifyBlockSignaturesFlag,
 }...)
@@ -26,6 +26,7 @@ type BeaconChainConfig struct {
 	MinGenesisActiveValidatorCount uint64 `yaml:"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"` // MinGenesisActiveValidatorCount defines how many validator deposits needed to kick off beacon chain.
 	MinGenesisTime                 uint64 `yaml:"MIN_GENESIS_TIME"`                   // MinGenesisTime is the time that needed to pass before kicking off beacon chain.
 	TargetAggregatorsPerCommittee  uint64 // TargetAggregatorsPerCommittee defines the number of aggregators inside one committee.
+	AttestationSubnetCount         uint64 // AttestationSubnetCount is the number of gossip subnets attestations are published on.
 
 	// Gwei value constants.
 	MinDepositAmount          uint64 `yaml:"MIN_DEPOSIT_AMOUNT"`          // MinDepositAmount is the maximal amount of Gwei a validator can send to the deposit contract at once.
@@ -92,6 +93,8 @@ type BeaconChainConfig struct {
 	TestnetContractEndpoint   string        // TestnetContractEndpoint to fetch the contract address of the Prysmatic Labs testnet.
 	GoerliBlockTime           uint64        // GoerliBlockTime is the number of seconds on avg a Goerli block is created.
 	GenesisForkVersion        []byte        `yaml:"GENESIS_FORK_VERSION"` // GenesisForkVersion is used to track fork version between state transitions.
+	NextForkVersion           []byte        `yaml:"NEXT_FORK_VERSION"`    // NextForkVersion is the fork version the chain transitions to at NextForkEpoch.
+	NextForkEpoch             uint64        `yaml:"NEXT_FORK_EPOCH"`      // NextForkEpoch is the epoch of the next planned fork, FarFutureEpoch when none is scheduled.
 	EmptySignature            [96]byte      // EmptySignature is used to represent a zeroed out BLS Signature.
 	DefaultPageSize           int           // DefaultPageSize defines the default page size for RPC server request.
 	MaxPageSize               int           // MaxPageSize defines the max page size for RPC server respond.
@@ -119,6 +122,7 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	MinGenesisActiveValidatorCount: 16384,
 	MinGenesisTime:                 0, // Zero until a proper time is decided.
 	TargetAggregatorsPerCommittee:  16,
+	AttestationSubnetCount:         64,
 
 	// Gwei value constants.
 	MinDepositAmount:          1 * 1e9,
@@ -184,6 +188,8 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	RPCSyncCheck:              1,
 	GoerliBlockTime:           14, // 14 seconds on average for a goerli block to be created.
 	GenesisForkVersion:        []byte{0, 0, 0, 0},
+	NextForkVersion:           []byte{0, 0, 0, 0},
+	NextForkEpoch:             1<<64 - 1,
 	EmptySignature:            [96]byte{},
 	DefaultPageSize:           250,
 	MaxPageSize:               500,
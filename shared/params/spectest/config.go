@@ -7,20 +7,26 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
-// SetConfig sets the global params for spec tests depending on the option chosen.
-func SetConfig(config string) error {
+// SetConfig sets the global params for spec tests depending on the option chosen. It returns a
+// function restoring the params in use before the call, so that a spec test run with one preset
+// does not leak its params into the tests run after it.
+func SetConfig(config string) (func(), error) {
+	prevConfig := params.BeaconConfig()
+	restore := func() {
+		params.OverrideBeaconConfig(prevConfig)
+	}
 	switch config {
 	case "minimal":
 		newConfig := params.MinimalSpecConfig()
 		params.OverrideBeaconConfig(newConfig)
-		return nil
+		return restore, nil
 	case "mainnet":
 		newConfig := params.MainnetConfig()
 		params.OverrideBeaconConfig(newConfig)
-		return nil
+		return restore, nil
 	case "":
-		return errors.New("no config provided")
+		return nil, errors.New("no config provided")
 	default:
-		return fmt.Errorf("did not receive a valid config, instead received this %s", config)
+		return nil, fmt.Errorf("did not receive a valid config, instead received this %s", config)
 	}
 }
@@ -7,13 +7,36 @@ import (
 )
 
 func TestConfig(t *testing.T) {
-	SetConfig("minimal")
+	if _, err := SetConfig("minimal"); err != nil {
+		t.Fatal(err)
+	}
 	if params.BeaconConfig().SlotsPerEpoch != 8 {
 		t.Errorf("Expected minimal config to be set, but got %d slots per epoch", params.BeaconConfig().SlotsPerEpoch)
 	}
 
-	SetConfig("mainnet")
+	if _, err := SetConfig("mainnet"); err != nil {
+		t.Fatal(err)
+	}
 	if params.BeaconConfig().SlotsPerEpoch != 32 {
 		t.Errorf("Expected mainnet config to be set, but got %d slots per epoch", params.BeaconConfig().SlotsPerEpoch)
 	}
 }
+
+func TestConfig_Restore(t *testing.T) {
+	params.UseMainnetConfig()
+	restore, err := SetConfig("minimal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.BeaconConfig().SlotsPerEpoch != params.MinimalSpecConfig().SlotsPerEpoch {
+		t.Errorf("Expected minimal config during the run, but got %d slots per epoch", params.BeaconConfig().SlotsPerEpoch)
+	}
+	restore()
+	if params.BeaconConfig().SlotsPerEpoch != params.MainnetConfig().SlotsPerEpoch {
+		t.Errorf("Expected mainnet config to be restored, but got %d slots per epoch", params.BeaconConfig().SlotsPerEpoch)
+	}
+
+	if _, err := SetConfig("unknown"); err == nil {
+		t.Error("Expected error for an unknown config, received nil")
+	}
+}
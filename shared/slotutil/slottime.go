@@ -1,6 +1,7 @@
 package slotutil
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -10,15 +11,28 @@ import (
 // SlotStartTime returns the start time in terms of its unix epoch
 // value.
 func SlotStartTime(genesis uint64, slot uint64) time.Time {
-	duration := time.Second * time.Duration(slot*params.BeaconConfig().SecondsPerSlot)
-	startTime := time.Unix(int64(genesis), 0).Add(duration)
-	return startTime
+	return time.Unix(int64(genesis+slot*params.BeaconConfig().SecondsPerSlot), 0)
+}
+
+// SlotFromTime returns the slot in progress at the given time for a chain
+// started at the provided genesis unix time. Times before genesis have no
+// slot and return an error.
+func SlotFromTime(genesis uint64, t time.Time) (uint64, error) {
+	genesisTime := time.Unix(int64(genesis), 0)
+	if t.Before(genesisTime) {
+		return 0, fmt.Errorf("time %v is before genesis time %v", t, genesisTime)
+	}
+	return uint64(t.Unix()-genesisTime.Unix()) / params.BeaconConfig().SecondsPerSlot, nil
 }
 
 // SlotsSinceGenesis returns the number of slots since
-// the provided genesis time.
+// the provided genesis time, or 0 before genesis.
 func SlotsSinceGenesis(genesis time.Time) uint64 {
-	return uint64(roughtime.Since(genesis).Seconds()) / params.BeaconConfig().SecondsPerSlot
+	slot, err := SlotFromTime(uint64(genesis.Unix()), roughtime.Now())
+	if err != nil {
+		return 0
+	}
+	return slot
 }
 
 // EpochsSinceGenesis returns the number of slots since
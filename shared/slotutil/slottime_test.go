@@ -0,0 +1,76 @@
+package slotutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSlotStartTime(t *testing.T) {
+	genesis := uint64(1578000000)
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	farFutureSlot := uint64(1 << 40)
+	tests := []struct {
+		slot uint64
+		want int64
+	}{
+		{slot: 0, want: int64(genesis)},
+		{slot: 1, want: int64(genesis + secondsPerSlot)},
+		{slot: farFutureSlot, want: int64(genesis + farFutureSlot*secondsPerSlot)},
+	}
+	for _, tt := range tests {
+		if got := SlotStartTime(genesis, tt.slot).Unix(); got != tt.want {
+			t.Errorf("SlotStartTime(%d, %d) = %d, wanted %d", genesis, tt.slot, got, tt.want)
+		}
+	}
+}
+
+func TestSlotFromTime(t *testing.T) {
+	genesis := uint64(1578000000)
+	genesisTime := time.Unix(int64(genesis), 0)
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	farFutureSlot := uint64(1 << 40)
+	tests := []struct {
+		name string
+		time time.Time
+		slot uint64
+	}{
+		{name: "genesis", time: genesisTime, slot: 0},
+		{name: "within slot 0", time: genesisTime.Add(secondsPerSlot - time.Nanosecond), slot: 0},
+		{name: "start of slot 1", time: genesisTime.Add(secondsPerSlot), slot: 1},
+		{name: "far future", time: SlotStartTime(genesis, farFutureSlot).Add(time.Second), slot: farFutureSlot},
+	}
+	for _, tt := range tests {
+		slot, err := SlotFromTime(genesis, tt.time)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if slot != tt.slot {
+			t.Errorf("%s: wanted slot %d, received %d", tt.name, tt.slot, slot)
+		}
+	}
+
+	if _, err := SlotFromTime(genesis, genesisTime.Add(-time.Second)); err == nil {
+		t.Error("Expected an error for a time before genesis")
+	}
+}
+
+func TestSlotFromTime_RoundTrip(t *testing.T) {
+	genesis := uint64(1578000000)
+	for _, slot := range []uint64{0, 1, 31, 32, 1 << 20} {
+		got, err := SlotFromTime(genesis, SlotStartTime(genesis, slot))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != slot {
+			t.Errorf("Wanted slot %d, received %d", slot, got)
+		}
+	}
+}
+
+func TestSlotsSinceGenesis_BeforeGenesis(t *testing.T) {
+	if slots := SlotsSinceGenesis(time.Now().Add(time.Hour)); slots != 0 {
+		t.Errorf("Wanted 0 slots before genesis, received %d", slots)
+	}
+}
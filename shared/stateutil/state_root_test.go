@@ -44,6 +44,25 @@ func TestHashTreeRootEquality(t *testing.T) {
 	}
 }
 
+func TestValidatorRegistryRootEquality(t *testing.T) {
+	genesisState := setupGenesisState(t, 512)
+	// A container with a single field has the root of that field.
+	type registry struct {
+		Validators []*ethpb.Validator `ssz-max:"1099511627776"`
+	}
+	r1, err := ssz.HashTreeRoot(&registry{Validators: genesisState.Validators})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := stateutil.ValidatorRegistryRoot(genesisState.Validators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r1 != r2 {
+		t.Errorf("Wanted %#x, got %#x", r1, r2)
+	}
+}
+
 func BenchmarkHashTreeRootState_Custom_512(b *testing.B) {
 	b.StopTimer()
 	genesisState := setupGenesisState(b, 512)
@@ -45,6 +45,12 @@ func validatorBalancesRoot(balances []uint64) ([32]byte, error) {
 	return mixInLength(balancesRootsRoot, balancesRootsBufRoot), nil
 }
 
+// ValidatorRegistryRoot computes the hash tree root of a validator registry, as the validators field
+// of a BeaconState. At genesis, this is the genesis validators root.
+func ValidatorRegistryRoot(validators []*ethpb.Validator) ([32]byte, error) {
+	return globalHasher.validatorRegistryRoot(validators)
+}
+
 func (h *stateRootHasher) validatorRegistryRoot(validators []*ethpb.Validator) ([32]byte, error) {
 	hashKeyElements := make([]byte, len(validators)*32)
 	roots := make([][]byte, len(validators))
@@ -0,0 +1,165 @@
+package trieutil
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// NodeStore persists the nodes a BoundedTrie evicts from memory, such as the beacon database
+// for the deposit trie.
+type NodeStore interface {
+	SaveDepositTrieNodes(ctx context.Context, layer uint64, startIndex uint64, nodes [][]byte) error
+	DepositTrieNode(ctx context.Context, layer uint64, index uint64) ([]byte, error)
+}
+
+// BoundedTrie is an append-only sparse Merkle trie which computes the same roots and proofs as
+// SparseMerkleTrie while keeping a bounded number of nodes in memory. Only the nodes needed to
+// update the trie and to prove the most recent items are kept, older nodes are moved to a
+// NodeStore once their subtree is full and proofs of old items load them from there.
+type BoundedTrie struct {
+	depth  uint
+	recent uint64
+	count  uint64
+	store  NodeStore
+	// layers holds the in-memory nodes of every layer, starting at the node index of the
+	// same layer in offsets.
+	layers  [][][]byte
+	offsets []uint64
+}
+
+// NewBoundedTrie returns an empty trie of the given depth which keeps in memory the nodes needed
+// to prove the given number of most recent items, moving the older ones to the store.
+func NewBoundedTrie(depth int, recent uint64, store NodeStore) (*BoundedTrie, error) {
+	if depth <= 0 || depth >= len(zeroHashes) {
+		return nil, fmt.Errorf("invalid trie depth %d", depth)
+	}
+	if recent == 0 {
+		return nil, errors.New("bounded trie must keep at least one recent item")
+	}
+	if store == nil {
+		return nil, errors.New("nil node store")
+	}
+	return &BoundedTrie{
+		depth:   uint(depth),
+		recent:  recent,
+		store:   store,
+		layers:  make([][][]byte, depth+1),
+		offsets: make([]uint64, depth+1),
+	}, nil
+}
+
+// NumOfItems returns the number of items inserted in the trie.
+func (m *BoundedTrie) NumOfItems() uint64 {
+	return m.count
+}
+
+// Insert appends an item to the trie, updating the nodes on its path to the root.
+func (m *BoundedTrie) Insert(ctx context.Context, item []byte) error {
+	if m.count >= 1<<m.depth {
+		return errors.New("trie is full")
+	}
+	node := bytesutil.ToBytes32(item)
+	index := m.count
+	m.count++
+	for i := uint(0); i <= m.depth; i++ {
+		stored := node
+		m.setNode(i, index, stored[:])
+		if i == m.depth {
+			break
+		}
+		if index%2 == 0 {
+			node = hashutil.Hash(append(node[:], zeroHashes[i]...))
+		} else {
+			node = hashutil.Hash(append(m.node(i, index-1), node[:]...))
+		}
+		index /= 2
+	}
+	return m.evict(ctx)
+}
+
+// HashTreeRoot of the Merkle trie as defined in the deposit contract, matching the one of a
+// SparseMerkleTrie with the same items.
+func (m *BoundedTrie) HashTreeRoot() [32]byte {
+	var zeroBytes [32]byte
+	root := zeroHashes[m.depth]
+	if m.count > 0 {
+		root = m.layers[m.depth][0]
+	}
+	newNode := append(append([]byte{}, root...), bytesutil.Bytes8(m.count)...)
+	newNode = append(newNode, zeroBytes[:24]...)
+	return hashutil.Hash(newNode)
+}
+
+// MerkleProof computes the proof of the item at the given index against the current root of
+// the trie, in the format of SparseMerkleTrie.MerkleProof. The nodes which were moved out of
+// memory are loaded from the store.
+func (m *BoundedTrie) MerkleProof(ctx context.Context, index uint64) ([][]byte, error) {
+	if index >= m.count {
+		return nil, fmt.Errorf("merkle index out of range in trie, max range: %d, received: %d", m.count, index)
+	}
+	proof := make([][]byte, m.depth+1)
+	for i := uint(0); i < m.depth; i++ {
+		subIndex := (index >> i) ^ 1
+		switch {
+		case subIndex >= m.offsets[i]+uint64(len(m.layers[i])):
+			proof[i] = zeroHashes[i]
+		case subIndex >= m.offsets[i]:
+			proof[i] = m.node(i, subIndex)
+		default:
+			node, err := m.store.DepositTrieNode(ctx, uint64(i), subIndex)
+			if err != nil {
+				return nil, err
+			}
+			if node == nil {
+				return nil, fmt.Errorf("missing trie node %d of layer %d", subIndex, i)
+			}
+			proof[i] = node
+		}
+	}
+	enc := [32]byte{}
+	binary.LittleEndian.PutUint64(enc[:], m.count)
+	proof[len(proof)-1] = enc[:]
+	return proof, nil
+}
+
+func (m *BoundedTrie) node(layer uint, index uint64) []byte {
+	return m.layers[layer][index-m.offsets[layer]]
+}
+
+func (m *BoundedTrie) setNode(layer uint, index uint64, node []byte) {
+	pos := index - m.offsets[layer]
+	if pos == uint64(len(m.layers[layer])) {
+		m.layers[layer] = append(m.layers[layer], node)
+		return
+	}
+	m.layers[layer][pos] = node
+}
+
+// evict moves the nodes which are no longer needed in memory to the store. The nodes of a layer
+// below the first node covering a recent item are evicted by pairs, so the left neighbor of an
+// in-memory node is always in memory. As these nodes only cover items before the recent ones,
+// their subtrees are full and they no longer change.
+func (m *BoundedTrie) evict(ctx context.Context) error {
+	if m.count <= m.recent {
+		return nil
+	}
+	firstRecent := m.count - m.recent
+	for i := uint(0); i <= m.depth; i++ {
+		cutoff := (firstRecent >> i) &^ 1
+		if cutoff <= m.offsets[i] {
+			continue
+		}
+		n := cutoff - m.offsets[i]
+		if err := m.store.SaveDepositTrieNodes(ctx, uint64(i), m.offsets[i], m.layers[i][:n]); err != nil {
+			return err
+		}
+		m.layers[i] = append([][]byte{}, m.layers[i][n:]...)
+		m.offsets[i] = cutoff
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package trieutil
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type mapNodeStore struct {
+	nodes map[[2]uint64][]byte
+}
+
+func (s *mapNodeStore) SaveDepositTrieNodes(_ context.Context, layer uint64, startIndex uint64, nodes [][]byte) error {
+	for i, node := range nodes {
+		s.nodes[[2]uint64{layer, startIndex + uint64(i)}] = node
+	}
+	return nil
+}
+
+func (s *mapNodeStore) DepositTrieNode(_ context.Context, layer uint64, index uint64) ([]byte, error) {
+	return s.nodes[[2]uint64{layer, index}], nil
+}
+
+func TestBoundedTrie_MatchesSparseMerkleTrie(t *testing.T) {
+	ctx := context.Background()
+	depth := 32
+	store := &mapNodeStore{nodes: make(map[[2]uint64][]byte)}
+	recent := uint64(5)
+	m, err := NewBoundedTrie(depth, recent, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var items [][]byte
+	for i := 0; i < 37; i++ {
+		item := []byte("item" + strconv.Itoa(i))
+		items = append(items, item)
+		if err := m.Insert(ctx, item); err != nil {
+			t.Fatal(err)
+		}
+		want, err := GenerateTrieFromItems(items, depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.HashTreeRoot() != want.HashTreeRoot() {
+			t.Fatalf("Wanted root %#x after %d items, received %#x", want.HashTreeRoot(), len(items), m.HashTreeRoot())
+		}
+
+		// Proofs of the recent items come from memory, the ones of older items partly from the
+		// store.
+		for j := range items {
+			wantProof, err := want.MerkleProof(j)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proof, err := m.MerkleProof(ctx, uint64(j))
+			if err != nil {
+				t.Fatalf("Could not generate proof of item %d: %v", j, err)
+			}
+			if !reflect.DeepEqual(proof, wantProof) {
+				t.Fatalf("Proof of item %d after %d items does not match the in-memory trie", j, len(items))
+			}
+			root := want.HashTreeRoot()
+			if !VerifyMerkleProof(root[:], items[j], j, proof) {
+				t.Fatalf("Proof of item %d after %d items does not verify", j, len(items))
+			}
+		}
+	}
+
+	// Only the nodes covering the recent items remain in memory.
+	for i, layer := range m.layers {
+		if uint64(len(layer)) > recent+2 {
+			t.Errorf("Layer %d keeps %d nodes in memory", i, len(layer))
+		}
+	}
+	if len(store.nodes) == 0 {
+		t.Error("Expected old nodes to be moved to the store")
+	}
+}
+
+func TestBoundedTrie_EmptyRoot(t *testing.T) {
+	m, err := NewBoundedTrie(32, 1, &mapNodeStore{nodes: make(map[[2]uint64][]byte)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := NewTrie(32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.HashTreeRoot() != want.HashTreeRoot() {
+		t.Errorf("Wanted empty root %#x, received %#x", want.HashTreeRoot(), m.HashTreeRoot())
+	}
+	if _, err := m.MerkleProof(context.Background(), 0); err == nil {
+		t.Error("Expected an error for a proof of a missing item")
+	}
+}
@@ -80,15 +80,18 @@ func NewKVStore(dirPath string) (*Store, error) {
 	kv := &Store{db: boltDB, databasePath: dirPath}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
-		return createBuckets(
+		if err := createBuckets(
 			tx,
 			historicIndexedAttestationsBucket,
 			historicBlockHeadersBucket,
 			indexedAttestationsIndicesBucket,
 			validatorsPublicKeysBucket,
-			validatorsMinMaxSpanBucket,
+			validatorsSpansBucket,
 			slashingBucket,
-		)
+		); err != nil {
+			return err
+		}
+		return migrateSpanMaps(tx)
 	}); err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"math"
+
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
@@ -17,57 +19,44 @@ func createEpochSpanMap(enc []byte) (*slashpb.EpochSpanMap, error) {
 	return epochSpanMap, nil
 }
 
-// ValidatorSpansMap accepts validator index and returns the corresponding spans
-// map for slashing detection.
-// Returns nil if the span map for this validator index does not exist.
-func (db *Store) ValidatorSpansMap(validatorIdx uint64) (*slashpb.EpochSpanMap, error) {
-	var sm *slashpb.EpochSpanMap
-	err := db.view(func(tx *bolt.Tx) error {
-		b := tx.Bucket(validatorsMinMaxSpanBucket)
-		enc := b.Get(bytesutil.Bytes4(validatorIdx))
-		var err error
-		sm, err = createEpochSpanMap(enc)
-		if err != nil {
-			return err
-		}
+// migrateSpanMaps moves the span maps saved per validator in the validators min max span bucket
+// by older versions into the spans saved per validator epoch, then removes the old bucket. Spans
+// already saved per validator epoch are kept as they are more recent.
+func migrateSpanMaps(tx *bolt.Tx) error {
+	oldBucket := tx.Bucket(validatorsMinMaxSpanBucket)
+	if oldBucket == nil {
 		return nil
-	})
-	if sm.EpochSpanMap == nil {
-		sm.EpochSpanMap = make(map[uint64]*slashpb.MinMaxEpochSpan)
 	}
-	return sm, err
-}
-
-// SaveValidatorSpansMap accepts a validator index and span map and writes it to disk.
-func (db *Store) SaveValidatorSpansMap(validatorIdx uint64, spanMap *slashpb.EpochSpanMap) error {
-	err := db.batch(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(validatorsMinMaxSpanBucket)
-		key := bytesutil.Bytes4(validatorIdx)
-		val, err := proto.Marshal(spanMap)
-		if err != nil {
-			return errors.Wrap(err, "failed to marshal span map")
-		}
-		if err := bucket.Put(key, val); err != nil {
-			return errors.Wrapf(err, "failed to delete validator id: %d from validators min max span bucket", validatorIdx)
+	bucket := tx.Bucket(validatorsSpansBucket)
+	migrated := 0
+	if err := oldBucket.ForEach(func(k []byte, v []byte) error {
+		if len(k) != 4 {
+			return errors.Errorf("invalid validator index key of length %d", len(k))
 		}
-		return err
-	})
-	return err
-}
-
-// DeleteValidatorSpanMap deletes a validator span map using a validator index as bucket key.
-func (db *Store) DeleteValidatorSpanMap(validatorIdx uint64) error {
-	return db.update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(validatorsMinMaxSpanBucket)
-		key := bytesutil.Bytes4(validatorIdx)
-		enc := bucket.Get(key)
-		if enc == nil {
-			return nil
+		validatorIdx := bytesutil.FromBytes4(k)
+		spanMap, err := createEpochSpanMap(v)
+		if err != nil {
+			return err
 		}
-		if err := bucket.Delete(key); err != nil {
-			tx.Rollback()
-			return errors.Wrapf(err, "failed to delete the span map for validator idx: %v from validators min max span bucket", validatorIdx)
+		for epoch, spans := range spanMap.EpochSpanMap {
+			key := encodeValidatorIDEpoch(validatorIdx, epoch)
+			if bucket.Get(key) != nil {
+				continue
+			}
+			if spans.MinEpochSpan > math.MaxUint16 || spans.MaxEpochSpan > math.MaxUint16 {
+				return errors.Errorf("spans of validator %d at epoch %d do not fit in span storage", validatorIdx, epoch)
+			}
+			if err := bucket.Put(key, encodeSpans(uint16(spans.MinEpochSpan), uint16(spans.MaxEpochSpan))); err != nil {
+				return errors.Wrapf(err, "failed to migrate spans of validator %d at epoch %d", validatorIdx, epoch)
+			}
 		}
+		migrated++
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+	if migrated > 0 {
+		log.WithField("validators", migrated).Info("Migrated validator span maps to spans per epoch")
+	}
+	return tx.DeleteBucket(validatorsMinMaxSpanBucket)
 }
@@ -1,11 +1,13 @@
 package db
 
 import (
-	"reflect"
+	"context"
 	"testing"
 
+	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
 type spanMapTestStruct struct {
@@ -50,69 +52,62 @@ func init() {
 	}
 }
 
-func TestValidatorSpanMap_NilDB(t *testing.T) {
+func TestMigrateSpanMaps(t *testing.T) {
 	db := SetupSlasherDB(t)
 	defer TeardownSlasherDB(t, db)
+	ctx := context.Background()
 
-	validatorIdx := uint64(1)
-	vsm, err := db.ValidatorSpansMap(validatorIdx)
-	if err != nil {
-		t.Fatalf("Nil ValidatorSpansMap should not return error: %v", err)
+	// Spans saved per epoch take precedence over the migrated span map.
+	if err := db.SaveSpans(ctx, 1, 3, 5, 6); err != nil {
+		t.Fatal(err)
 	}
-	if !reflect.DeepEqual(vsm.EpochSpanMap, map[uint64]*slashpb.MinMaxEpochSpan{}) {
-		t.Fatal("ValidatorSpansMap should return nil")
-	}
-}
-
-func TestValidatorSpanMap_Save(t *testing.T) {
-	db := SetupSlasherDB(t)
-	defer TeardownSlasherDB(t, db)
-
-	for _, tt := range spanTests {
-		err := db.SaveValidatorSpansMap(tt.validatorIdx, tt.spanMap)
-		if err != nil {
-			t.Fatalf("Save validator span map failed: %v", err)
-		}
-		sm, err := db.ValidatorSpansMap(tt.validatorIdx)
+	if err := db.update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(validatorsMinMaxSpanBucket)
 		if err != nil {
-			t.Fatalf("Failed to get validator span map: %v", err)
+			return err
 		}
-
-		if sm == nil || !proto.Equal(sm, tt.spanMap) {
-			t.Fatalf("Get should return validator span map: %v got: %v", tt.spanMap, sm)
+		for _, tt := range spanTests {
+			enc, err := proto.Marshal(tt.spanMap)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(bytesutil.Bytes4(tt.validatorIdx), enc); err != nil {
+				return err
+			}
 		}
+		return migrateSpanMaps(tx)
+	}); err != nil {
+		t.Fatal(err)
 	}
-}
-
-func TestValidatorSpanMap_Delete(t *testing.T) {
-	db := SetupSlasherDB(t)
-	defer TeardownSlasherDB(t, db)
 
 	for _, tt := range spanTests {
-		err := db.SaveValidatorSpansMap(tt.validatorIdx, tt.spanMap)
-		if err != nil {
-			t.Fatalf("Save validator span map failed: %v", err)
+		for epoch, want := range tt.spanMap.EpochSpanMap {
+			if tt.validatorIdx == 1 && epoch == 3 {
+				want = &slashpb.MinMaxEpochSpan{MinEpochSpan: 5, MaxEpochSpan: 6}
+			}
+			minSpan, maxSpan, err := db.Spans(ctx, tt.validatorIdx, epoch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if uint32(minSpan) != want.MinEpochSpan || uint32(maxSpan) != want.MaxEpochSpan {
+				t.Errorf(
+					"Validator %d at epoch %d: wanted min %d and max %d, received min %d and max %d",
+					tt.validatorIdx,
+					epoch,
+					want.MinEpochSpan,
+					want.MaxEpochSpan,
+					minSpan,
+					maxSpan,
+				)
+			}
 		}
 	}
-
-	for _, tt := range spanTests {
-		sm, err := db.ValidatorSpansMap(tt.validatorIdx)
-		if err != nil {
-			t.Fatalf("Failed to get validator span map: %v", err)
-		}
-		if sm == nil || !proto.Equal(sm, tt.spanMap) {
-			t.Fatalf("Get should return validator span map: %v got: %v", tt.spanMap, sm)
-		}
-		err = db.DeleteValidatorSpanMap(tt.validatorIdx)
-		if err != nil {
-			t.Fatalf("Delete validator span map error: %v", err)
-		}
-		sm, err = db.ValidatorSpansMap(tt.validatorIdx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if !reflect.DeepEqual(sm.EpochSpanMap, map[uint64]*slashpb.MinMaxEpochSpan{}) {
-			t.Errorf("Expected validator span map to be deleted, received: %v", sm)
+	if err := db.view(func(tx *bolt.Tx) error {
+		if tx.Bucket(validatorsMinMaxSpanBucket) != nil {
+			t.Error("Expected the validators min max span bucket to be deleted")
 		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
 	}
 }
@@ -14,6 +14,11 @@ var (
 	// In order to quickly detect surround and surrounded attestations we need to store
 	// the min and max span for each validator for each epoch.
 	// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
+	// The spans are stored under a key per validator and epoch, so the spans of a single epoch
+	// are read and written without the rest of the span map.
+	validatorsSpansBucket = []byte("validators-spans-bucket")
+	// Older versions stored the whole span map of a validator under a single key. It is only
+	// read to migrate the span maps to validatorsSpansBucket, then deleted.
 	validatorsMinMaxSpanBucket = []byte("validators-min-max-span-bucket")
 )
 
@@ -21,6 +26,10 @@ func encodeEpochValidatorID(epoch uint64, validatorID uint64) []byte {
 	return append(bytesutil.Bytes8(epoch), bytesutil.Bytes8(validatorID)...)
 }
 
+func encodeValidatorIDEpoch(validatorID uint64, epoch uint64) []byte {
+	return append(bytesutil.Bytes8(validatorID), bytesutil.Bytes8(epoch)...)
+}
+
 func encodeEpochValidatorIDSig(epoch uint64, validatorID uint64, sig []byte) []byte {
 	return append(append(bytesutil.Bytes8(epoch), bytesutil.Bytes8(validatorID)...), sig...)
 }
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+)
+
+// Spans returns the min and max spans of a validator at the given epoch. The spans are zero if
+// none were saved for the epoch.
+func (db *Store) Spans(ctx context.Context, validatorIdx uint64, epoch uint64) (uint16, uint16, error) {
+	ctx, span := trace.StartSpan(ctx, "SlasherDB.Spans")
+	defer span.End()
+	var minSpan, maxSpan uint16
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(validatorsSpansBucket)
+		enc := b.Get(encodeValidatorIDEpoch(validatorIdx, epoch))
+		if enc == nil {
+			return nil
+		}
+		if len(enc) != 4 {
+			return errors.Errorf("invalid span encoding of length %d", len(enc))
+		}
+		minSpan = binary.LittleEndian.Uint16(enc[:2])
+		maxSpan = binary.LittleEndian.Uint16(enc[2:])
+		return nil
+	})
+	return minSpan, maxSpan, err
+}
+
+// SaveSpans saves the min and max spans of a validator at the given epoch.
+func (db *Store) SaveSpans(ctx context.Context, validatorIdx uint64, epoch uint64, minSpan uint16, maxSpan uint16) error {
+	ctx, span := trace.StartSpan(ctx, "SlasherDB.SaveSpans")
+	defer span.End()
+	return db.batch(func(tx *bolt.Tx) error {
+		b := tx.Bucket(validatorsSpansBucket)
+		if err := b.Put(encodeValidatorIDEpoch(validatorIdx, epoch), encodeSpans(minSpan, maxSpan)); err != nil {
+			return errors.Wrapf(err, "failed to save spans of validator %d at epoch %d", validatorIdx, epoch)
+		}
+		return nil
+	})
+}
+
+func encodeSpans(minSpan uint16, maxSpan uint16) []byte {
+	enc := make([]byte, 4)
+	binary.LittleEndian.PutUint16(enc[:2], minSpan)
+	binary.LittleEndian.PutUint16(enc[2:], maxSpan)
+	return enc
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStore_SaveSpans(t *testing.T) {
+	db := SetupSlasherDB(t)
+	defer TeardownSlasherDB(t, db)
+	ctx := context.Background()
+
+	minSpan, maxSpan, err := db.Spans(ctx, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if minSpan != 0 || maxSpan != 0 {
+		t.Errorf("Expected zero spans for an unsaved epoch, received min %d and max %d", minSpan, maxSpan)
+	}
+
+	tests := []struct {
+		validatorIdx uint64
+		epoch        uint64
+		minSpan      uint16
+		maxSpan      uint16
+	}{
+		{validatorIdx: 1, epoch: 10, minSpan: 3, maxSpan: 7},
+		{validatorIdx: 1, epoch: 11, minSpan: 2, maxSpan: 65535},
+		{validatorIdx: 2, epoch: 10, minSpan: 9, maxSpan: 0},
+	}
+	for _, tt := range tests {
+		if err := db.SaveSpans(ctx, tt.validatorIdx, tt.epoch, tt.minSpan, tt.maxSpan); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tt := range tests {
+		minSpan, maxSpan, err := db.Spans(ctx, tt.validatorIdx, tt.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if minSpan != tt.minSpan || maxSpan != tt.maxSpan {
+			t.Errorf(
+				"Validator %d at epoch %d: wanted min %d and max %d, received min %d and max %d",
+				tt.validatorIdx,
+				tt.epoch,
+				tt.minSpan,
+				tt.maxSpan,
+				minSpan,
+				maxSpan,
+			)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// GenerateAttesterSlashing packages two conflicting attestations into an attester slashing ready
+// to be broadcast. The attestations must be a double vote or a surround vote and share at least
+// one attesting validator, the validators slashed once the slashing is included in a block. The
+// surrounding attestation of a surround vote is put first as required by the spec, regardless of
+// the order of the arguments.
+func GenerateAttesterSlashing(att1 *ethpb.IndexedAttestation, att2 *ethpb.IndexedAttestation) (*ethpb.AttesterSlashing, error) {
+	if !isCompleteIndexedAttestation(att1) || !isCompleteIndexedAttestation(att2) {
+		return nil, errors.New("nil indexed attestation or attestation data")
+	}
+	if len(sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices)) == 0 {
+		return nil, errors.New("attestations have no attesting validator in common")
+	}
+	switch {
+	case blocks.IsSlashableAttestationData(att1.Data, att2.Data):
+		return &ethpb.AttesterSlashing{Attestation_1: att1, Attestation_2: att2}, nil
+	case blocks.IsSlashableAttestationData(att2.Data, att1.Data):
+		return &ethpb.AttesterSlashing{Attestation_1: att2, Attestation_2: att1}, nil
+	default:
+		return nil, errors.New("attestations are not slashable")
+	}
+}
+
+func isCompleteIndexedAttestation(att *ethpb.IndexedAttestation) bool {
+	return att != nil && att.Data != nil && att.Data.Source != nil && att.Data.Target != nil
+}
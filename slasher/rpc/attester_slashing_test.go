@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func indexedAttestation(indices []uint64, source uint64, target uint64, root string) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: indices,
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: []byte(root),
+			Source:          &ethpb.Checkpoint{Epoch: source},
+			Target:          &ethpb.Checkpoint{Epoch: target},
+		},
+		Signature: []byte("sig"),
+	}
+}
+
+func TestGenerateAttesterSlashing_DoubleVote(t *testing.T) {
+	att1 := indexedAttestation([]uint64{1, 2, 3}, 2, 3, "block1")
+	att2 := indexedAttestation([]uint64{3, 4}, 2, 3, "block2")
+
+	slashing, err := GenerateAttesterSlashing(att1, att2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ethpb.AttesterSlashing{Attestation_1: att1, Attestation_2: att2}
+	if !proto.Equal(slashing, want) {
+		t.Errorf("Wanted slashing %v, received %v", want, slashing)
+	}
+}
+
+func TestGenerateAttesterSlashing_SurroundVoteOrdered(t *testing.T) {
+	surrounding := indexedAttestation([]uint64{1}, 1, 4, "block1")
+	surrounded := indexedAttestation([]uint64{1}, 2, 3, "block2")
+
+	slashing, err := GenerateAttesterSlashing(surrounded, surrounding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ethpb.AttesterSlashing{Attestation_1: surrounding, Attestation_2: surrounded}
+	if !proto.Equal(slashing, want) {
+		t.Errorf("Wanted the surrounding attestation first %v, received %v", want, slashing)
+	}
+}
+
+func TestGenerateAttesterSlashing_RejectsNonSlashable(t *testing.T) {
+	tests := []struct {
+		name string
+		att1 *ethpb.IndexedAttestation
+		att2 *ethpb.IndexedAttestation
+	}{
+		{
+			name: "same attestation data",
+			att1: indexedAttestation([]uint64{1}, 2, 3, "block1"),
+			att2: indexedAttestation([]uint64{1, 2}, 2, 3, "block1"),
+		},
+		{
+			name: "different targets",
+			att1: indexedAttestation([]uint64{1}, 2, 3, "block1"),
+			att2: indexedAttestation([]uint64{1}, 3, 4, "block2"),
+		},
+		{
+			name: "no common validator",
+			att1: indexedAttestation([]uint64{1, 2}, 2, 3, "block1"),
+			att2: indexedAttestation([]uint64{3}, 2, 3, "block2"),
+		},
+		{
+			name: "nil attestation data",
+			att1: indexedAttestation([]uint64{1}, 2, 3, "block1"),
+			att2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if slashing, err := GenerateAttesterSlashing(tt.att1, tt.att2); err == nil {
+				t.Errorf("Expected error, received slashing %v", slashing)
+			}
+		})
+	}
+}
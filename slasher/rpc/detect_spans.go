@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// DetectAndUpdateSpans detects whether an attestation of the validator with the given source and
+// target epochs surrounds or is surrounded by an attestation of the validator seen before, using
+// the min and max spans saved per epoch. The detection reads the spans of the source epoch only.
+// It returns the target epoch of a surrounded attestation and of a surrounding attestation, zero
+// if there is none. If no surround vote is detected, the spans of the epochs covered by the
+// attestation are updated.
+//
+// The min span of an epoch is the smallest distance from the epoch to the target of an
+// attestation with a later source, and the max span the largest distance from the epoch to the
+// target of an attestation with an earlier source.
+// Detailed here: https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
+func (ss *Server) DetectAndUpdateSpans(
+	ctx context.Context,
+	validatorIdx uint64,
+	source uint64,
+	target uint64,
+) (uint64, uint64, error) {
+	if target < source {
+		return 0, 0, fmt.Errorf(
+			"target: %d < source: %d ",
+			target,
+			source,
+		)
+	}
+	span := target - source
+	if span > math.MaxUint16 {
+		return 0, 0, fmt.Errorf("span %d between source %d and target %d does not fit in span storage", span, source, target)
+	}
+	minSpan, maxSpan, err := ss.SlasherDB.Spans(ctx, validatorIdx, source)
+	if err != nil {
+		return 0, 0, err
+	}
+	var minTargetEpoch, maxTargetEpoch uint64
+	if minSpan > 0 && uint64(minSpan) < span {
+		minTargetEpoch = source + uint64(minSpan)
+	}
+	if uint64(maxSpan) > span {
+		maxTargetEpoch = source + uint64(maxSpan)
+	}
+	if minTargetEpoch > 0 || maxTargetEpoch > 0 {
+		return minTargetEpoch, maxTargetEpoch, nil
+	}
+
+	// The min spans of the epochs before the source are lowered until one is already lower.
+	for epoch := source; epoch > 0; epoch-- {
+		e := epoch - 1
+		val := target - e
+		if val > math.MaxUint16 {
+			break
+		}
+		minSpan, maxSpan, err := ss.SlasherDB.Spans(ctx, validatorIdx, e)
+		if err != nil {
+			return 0, 0, err
+		}
+		if minSpan != 0 && uint64(minSpan) <= val {
+			break
+		}
+		if err := ss.SlasherDB.SaveSpans(ctx, validatorIdx, e, uint16(val), maxSpan); err != nil {
+			return 0, 0, err
+		}
+	}
+	// The max spans of the epochs between the source and the target are raised until one is
+	// already higher.
+	for e := source + 1; e < target; e++ {
+		val := target - e
+		minSpan, maxSpan, err := ss.SlasherDB.Spans(ctx, validatorIdx, e)
+		if err != nil {
+			return 0, 0, err
+		}
+		if uint64(maxSpan) >= val {
+			break
+		}
+		if err := ss.SlasherDB.SaveSpans(ctx, validatorIdx, e, minSpan, uint16(val)); err != nil {
+			return 0, 0, err
+		}
+	}
+	return 0, 0, nil
+}
@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/slasher/db"
+)
+
+func TestServer_DetectAndUpdateSpans(t *testing.T) {
+	dbs := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, dbs)
+	ctx := context.Background()
+	slasherServer := &Server{
+		SlasherDB: dbs,
+	}
+
+	// An attestation from epoch 3 to 6 sets the min spans of the epochs before its source and
+	// the max spans of the epochs between its source and target.
+	minTarget, maxTarget, err := slasherServer.DetectAndUpdateSpans(ctx, 0, 3, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if minTarget != 0 || maxTarget != 0 {
+		t.Fatalf("Expected no surround vote for the first attestation, received targets %d and %d", minTarget, maxTarget)
+	}
+	wantSpans := map[uint64][2]uint16{
+		0: {6, 0},
+		1: {5, 0},
+		2: {4, 0},
+		3: {0, 0},
+		4: {0, 2},
+		5: {0, 1},
+		6: {0, 0},
+	}
+	for epoch, want := range wantSpans {
+		minSpan, maxSpan, err := dbs.Spans(ctx, 0, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if minSpan != want[0] || maxSpan != want[1] {
+			t.Errorf("Epoch %d: wanted spans %v, received [%d %d]", epoch, want, minSpan, maxSpan)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		source    uint64
+		target    uint64
+		minTarget uint64
+		maxTarget uint64
+	}{
+		{name: "surrounded", source: 4, target: 5, maxTarget: 6},
+		{name: "surrounding", source: 2, target: 7, minTarget: 6},
+		{name: "overlapping", source: 4, target: 7},
+		{name: "same target", source: 4, target: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minTarget, maxTarget, err := slasherServer.DetectAndUpdateSpans(ctx, 0, tt.source, tt.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if minTarget != tt.minTarget || maxTarget != tt.maxTarget {
+				t.Errorf(
+					"Wanted surrounded target %d and surrounding target %d, received %d and %d",
+					tt.minTarget,
+					tt.maxTarget,
+					minTarget,
+					maxTarget,
+				)
+			}
+		})
+	}
+
+	if _, _, err := slasherServer.DetectAndUpdateSpans(ctx, 0, 6, 3); err == nil {
+		t.Error("Expected error for a target before the source")
+	}
+}
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+)
+
+// GenerateProposerSlashing packages two block headers signed by the same proposer into a proposer
+// slashing ready to be broadcast. The headers must be at the same slot and have different roots.
+// Block headers do not carry the index of their proposer, so the caller, which found both headers
+// under the proposer, provides it.
+func GenerateProposerSlashing(
+	proposerIdx uint64,
+	header1 *ethpb.SignedBeaconBlockHeader,
+	header2 *ethpb.SignedBeaconBlockHeader,
+) (*ethpb.ProposerSlashing, error) {
+	if header1 == nil || header1.Header == nil || header2 == nil || header2.Header == nil {
+		return nil, errors.New("nil block header")
+	}
+	if header1.Header.Slot != header2.Header.Slot {
+		return nil, errors.Errorf(
+			"headers are at different slots %d and %d",
+			header1.Header.Slot,
+			header2.Header.Slot,
+		)
+	}
+	root1, err := ssz.HashTreeRoot(header1.Header)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash block header")
+	}
+	root2, err := ssz.HashTreeRoot(header2.Header)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash block header")
+	}
+	if root1 == root2 {
+		return nil, errors.New("headers have the same root")
+	}
+	return &ethpb.ProposerSlashing{
+		ProposerIndex: proposerIdx,
+		Header_1:      header1,
+		Header_2:      header2,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func signedHeader(slot uint64, bodyRoot string) *ethpb.SignedBeaconBlockHeader {
+	return &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   []byte(bodyRoot),
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestGenerateProposerSlashing_DoubleProposal(t *testing.T) {
+	header1 := signedHeader(10, "body-root-of-the-first-block-xx1")
+	header2 := signedHeader(10, "body-root-of-the-second-block-x2")
+
+	slashing, err := GenerateProposerSlashing(5, header1, header2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ethpb.ProposerSlashing{ProposerIndex: 5, Header_1: header1, Header_2: header2}
+	if !proto.Equal(slashing, want) {
+		t.Errorf("Wanted slashing %v, received %v", want, slashing)
+	}
+}
+
+func TestGenerateProposerSlashing_RejectsNonSlashable(t *testing.T) {
+	tests := []struct {
+		name    string
+		header1 *ethpb.SignedBeaconBlockHeader
+		header2 *ethpb.SignedBeaconBlockHeader
+	}{
+		{
+			name:    "different slots",
+			header1: signedHeader(10, "body-root-of-the-first-block-xx1"),
+			header2: signedHeader(11, "body-root-of-the-second-block-x2"),
+		},
+		{
+			name:    "same header",
+			header1: signedHeader(10, "body-root-of-the-first-block-xx1"),
+			header2: signedHeader(10, "body-root-of-the-first-block-xx1"),
+		},
+		{
+			name:    "nil header",
+			header1: signedHeader(10, "body-root-of-the-first-block-xx1"),
+			header2: &ethpb.SignedBeaconBlockHeader{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if slashing, err := GenerateProposerSlashing(5, tt.header1, tt.header2); err == nil {
+				t.Errorf("Expected error, received slashing %v", slashing)
+			}
+		})
+	}
+}
@@ -79,6 +79,11 @@ func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.Indexed
 	for atts := range at {
 		atsSlashinngRes.AttesterSlashing = append(atsSlashinngRes.AttesterSlashing, atts...)
 	}
+	for _, as := range atsSlashinngRes.AttesterSlashing {
+		if err := ss.recordAttesterSlashing(as); err != nil {
+			return nil, err
+		}
+	}
 	return atsSlashinngRes, err
 }
 
@@ -98,7 +103,11 @@ func (ss *Server) IsSlashableBlock(ctx context.Context, psr *slashpb.ProposerSla
 			presentInDb = true
 			continue
 		}
-		pSlashingsResponse.ProposerSlashing = append(pSlashingsResponse.ProposerSlashing, &ethpb.ProposerSlashing{ProposerIndex: psr.ValidatorIndex, Header_1: psr.BlockHeader, Header_2: bh})
+		ps := &ethpb.ProposerSlashing{ProposerIndex: psr.ValidatorIndex, Header_1: psr.BlockHeader, Header_2: bh}
+		if err := ss.recordProposerSlashing(ps); err != nil {
+			return nil, err
+		}
+		pSlashingsResponse.ProposerSlashing = append(pSlashingsResponse.ProposerSlashing, ps)
 	}
 	if len(pSlashingsResponse.ProposerSlashing) == 0 && !presentInDb {
 		err = ss.SlasherDB.SaveBlockHeader(epoch, psr.ValidatorIndex, psr.BlockHeader)
@@ -122,23 +131,12 @@ func (ss *Server) SlashableAttestations(req *types.Empty, server slashpb.Slasher
 }
 
 // DetectSurroundVotes is a method used to return the attestation that were detected
-// by min max surround detection method.
+// by min max surround detection method, using the spans saved per validator epoch.
 func (ss *Server) DetectSurroundVotes(ctx context.Context, validatorIdx uint64, req *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error) {
-	spanMap, err := ss.SlasherDB.ValidatorSpansMap(validatorIdx)
+	minTargetEpoch, maxTargetEpoch, err := ss.DetectAndUpdateSpans(ctx, validatorIdx, req.Data.Source.Epoch, req.Data.Target.Epoch)
 	if err != nil {
 		return nil, err
 	}
-	minTargetEpoch, spanMap, err := ss.DetectAndUpdateMinEpochSpan(ctx, req.Data.Source.Epoch, req.Data.Target.Epoch, validatorIdx, spanMap)
-	if err != nil {
-		return nil, err
-	}
-	maxTargetEpoch, spanMap, err := ss.DetectAndUpdateMaxEpochSpan(ctx, req.Data.Source.Epoch, req.Data.Target.Epoch, validatorIdx, spanMap)
-	if err != nil {
-		return nil, err
-	}
-	if err := ss.SlasherDB.SaveValidatorSpansMap(validatorIdx, spanMap); err != nil {
-		return nil, err
-	}
 	var as []*ethpb.AttesterSlashing
 	if minTargetEpoch > 0 {
 		attestations, err := ss.SlasherDB.IndexedAttestation(minTargetEpoch, validatorIdx)
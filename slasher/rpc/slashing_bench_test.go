@@ -11,7 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/db"
 )
 
-func BenchmarkMinSpan(b *testing.B) {
+func BenchmarkDetectAndUpdateSpans(b *testing.B) {
 	diffs := []uint64{2, 10, 100, 1000, 10000, 53999}
 	dbs := db.SetupSlasherDB(b)
 	defer db.TeardownSlasherDB(b, dbs)
@@ -21,76 +21,9 @@ func BenchmarkMinSpan(b *testing.B) {
 		SlasherDB: dbs,
 	}
 	for _, diff := range diffs {
-		b.Run(fmt.Sprintf("MinSpan_diff_%d", diff), func(ib *testing.B) {
+		b.Run(fmt.Sprintf("Spans_diff_%d", diff), func(ib *testing.B) {
 			for i := uint64(0); i < uint64(ib.N); i++ {
-				spanMap, err := slasherServer.SlasherDB.ValidatorSpansMap(i % 10)
-				if err != nil {
-					b.Fatal(err)
-				}
-				_, _, err = slasherServer.DetectAndUpdateMinEpochSpan(ctx, i, i+diff, i%10, spanMap)
-				if err != nil {
-					b.Fatal(err)
-				}
-			}
-		})
-	}
-}
-
-func BenchmarkMaxSpan(b *testing.B) {
-	diffs := []uint64{2, 10, 100, 1000, 10000, 53999}
-	dbs := db.SetupSlasherDB(b)
-	defer db.TeardownSlasherDB(b, dbs)
-
-	ctx := context.Background()
-	slasherServer := &Server{
-		SlasherDB: dbs,
-	}
-	for _, diff := range diffs {
-		b.Run(fmt.Sprintf("MaxSpan_diff_%d", diff), func(ib *testing.B) {
-			for i := uint64(0); i < uint64(ib.N); i++ {
-				spanMap, err := slasherServer.SlasherDB.ValidatorSpansMap(i % 10)
-				if err != nil {
-					b.Fatal(err)
-				}
-				_, _, err = slasherServer.DetectAndUpdateMaxEpochSpan(ctx, diff, diff+i, i%10, spanMap)
-				if err != nil {
-					b.Fatal(err)
-				}
-			}
-		})
-	}
-}
-
-func BenchmarkDetectSpan(b *testing.B) {
-	diffs := []uint64{2, 10, 100, 1000, 10000, 53999}
-	dbs := db.SetupSlasherDB(b)
-	defer db.TeardownSlasherDB(b, dbs)
-
-	slasherServer := &Server{
-		SlasherDB: dbs,
-	}
-	for _, diff := range diffs {
-		b.Run(fmt.Sprintf("Detect_MaxSpan_diff_%d", diff), func(ib *testing.B) {
-			for i := uint64(0); i < uint64(ib.N); i++ {
-				spanMap, err := slasherServer.SlasherDB.ValidatorSpansMap(i % 10)
-				if err != nil {
-					b.Fatal(err)
-				}
-				_, _, _, err = slasherServer.detectSlashingByEpochSpan(i, i+diff, spanMap, detectMax)
-				if err != nil {
-					b.Fatal(err)
-				}
-			}
-		})
-	}
-	for _, diff := range diffs {
-		b.Run(fmt.Sprintf("Detect_MinSpan_diff_%d", diff), func(ib *testing.B) {
-			for i := uint64(0); i < uint64(ib.N); i++ {
-				spanMap, err := slasherServer.SlasherDB.ValidatorSpansMap(i % 10)
-				if err != nil {
-					b.Fatal(err)
-				}
-				_, _, _, err = slasherServer.detectSlashingByEpochSpan(i, i+diff, spanMap, detectMin)
+				_, _, err := slasherServer.DetectAndUpdateSpans(ctx, i%10, i, i+diff)
 				if err != nil {
 					b.Fatal(err)
 				}
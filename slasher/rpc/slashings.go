@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// detectedStatuses are the statuses of the slashings the slasher detected, whether or not they
+// were included in a block since.
+var detectedStatuses = []db.SlashingStatus{db.Active, db.Included, db.Reverted}
+
+// ListSlashableAttestations returns the attester slashings detected for the requested epoch. An
+// attester slashing belongs to the later target epoch of its two attestations, the epoch by
+// which both conflicting votes were cast.
+func (ss *Server) ListSlashableAttestations(ctx context.Context, req *slashpb.SlashingsRequest) (*slashpb.AttesterSlashingResponse, error) {
+	res := &slashpb.AttesterSlashingResponse{}
+	for _, st := range detectedStatuses {
+		slashings, err := ss.SlasherDB.AttesterSlashings(st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve attester slashings: %v", err)
+		}
+		for _, as := range slashings {
+			if attesterSlashingEpoch(as) == req.Epoch {
+				res.AttesterSlashing = append(res.AttesterSlashing, as)
+			}
+		}
+	}
+	return res, nil
+}
+
+// ListSlashableProposals returns the proposer slashings detected for the requested epoch, the
+// epoch of the slot of the conflicting block headers.
+func (ss *Server) ListSlashableProposals(ctx context.Context, req *slashpb.SlashingsRequest) (*slashpb.ProposerSlashingResponse, error) {
+	res := &slashpb.ProposerSlashingResponse{}
+	for _, st := range detectedStatuses {
+		slashings, err := ss.SlasherDB.ProposalSlashingsByStatus(st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve proposer slashings: %v", err)
+		}
+		for _, ps := range slashings {
+			if ps.Header_1 != nil && ps.Header_1.Header != nil && helpers.SlotToEpoch(ps.Header_1.Header.Slot) == req.Epoch {
+				res.ProposerSlashing = append(res.ProposerSlashing, ps)
+			}
+		}
+	}
+	return res, nil
+}
+
+func attesterSlashingEpoch(as *ethpb.AttesterSlashing) uint64 {
+	var epoch uint64
+	for _, att := range []*ethpb.IndexedAttestation{as.Attestation_1, as.Attestation_2} {
+		if att != nil && att.Data != nil && att.Data.Target != nil && att.Data.Target.Epoch > epoch {
+			epoch = att.Data.Target.Epoch
+		}
+	}
+	return epoch
+}
+
+// recordAttesterSlashing saves a detected attester slashing as active, unless it was recorded
+// before, in which case its status is kept.
+func (ss *Server) recordAttesterSlashing(as *ethpb.AttesterSlashing) error {
+	found, _, err := ss.SlasherDB.HasAttesterSlashing(as)
+	if err != nil || found {
+		return err
+	}
+	return ss.SlasherDB.SaveAttesterSlashing(db.Active, as)
+}
+
+// recordProposerSlashing saves a detected proposer slashing as active, unless it was recorded
+// before, in which case its status is kept.
+func (ss *Server) recordProposerSlashing(ps *ethpb.ProposerSlashing) error {
+	found, _, err := ss.SlasherDB.HasProposerSlashing(ps)
+	if err != nil || found {
+		return err
+	}
+	return ss.SlasherDB.SaveProposerSlashing(db.Active, ps)
+}
@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+)
+
+func TestServer_ListSlashableAttestations(t *testing.T) {
+	dbs := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, dbs)
+	ctx := context.Background()
+	slasherServer := &Server{
+		ctx:       ctx,
+		SlasherDB: dbs,
+	}
+	ia1 := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{0},
+		Signature:        []byte("sig2"),
+		Data: &ethpb.AttestationData{
+			Slot:            3*params.BeaconConfig().SlotsPerEpoch + 1,
+			BeaconBlockRoot: []byte("block1"),
+			Source:          &ethpb.Checkpoint{Epoch: 2},
+			Target:          &ethpb.Checkpoint{Epoch: 3},
+		},
+	}
+	ia2 := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{0},
+		Signature:        []byte("sig1"),
+		Data: &ethpb.AttestationData{
+			Slot:            3*params.BeaconConfig().SlotsPerEpoch + 1,
+			BeaconBlockRoot: []byte("block2"),
+			Source:          &ethpb.Checkpoint{Epoch: 2},
+			Target:          &ethpb.Checkpoint{Epoch: 3},
+		},
+	}
+	want := &ethpb.AttesterSlashing{
+		Attestation_1: ia2,
+		Attestation_2: ia1,
+	}
+
+	if _, err := slasherServer.IsSlashableAttestation(ctx, ia1); err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if _, err := slasherServer.IsSlashableAttestation(ctx, ia2); err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	// Detecting the same slashing again should not record it twice.
+	if _, err := slasherServer.IsSlashableAttestation(ctx, ia2); err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+
+	res, err := slasherServer.ListSlashableAttestations(ctx, &slashpb.SlashingsRequest{Epoch: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.AttesterSlashing) != 1 {
+		t.Fatalf("Wanted 1 attester slashing, received %d", len(res.AttesterSlashing))
+	}
+	if !proto.Equal(res.AttesterSlashing[0], want) {
+		t.Errorf("Wanted slashing proof: %v got: %v", want, res.AttesterSlashing[0])
+	}
+
+	res, err = slasherServer.ListSlashableAttestations(ctx, &slashpb.SlashingsRequest{Epoch: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.AttesterSlashing) != 0 {
+		t.Errorf("Wanted no attester slashing for epoch 2, received %v", res.AttesterSlashing)
+	}
+}
+
+func TestServer_ListSlashableProposals(t *testing.T) {
+	dbs := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, dbs)
+	ctx := context.Background()
+	slasherServer := &Server{
+		ctx:       ctx,
+		SlasherDB: dbs,
+	}
+	slot := 2*params.BeaconConfig().SlotsPerEpoch + 1
+	psr := &slashpb.ProposerSlashingRequest{
+		BlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:      slot,
+				StateRoot: []byte("A"),
+			},
+		},
+		ValidatorIndex: 1,
+	}
+	psr2 := &slashpb.ProposerSlashingRequest{
+		BlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:      slot,
+				StateRoot: []byte("B"),
+			},
+		},
+		ValidatorIndex: 1,
+	}
+	want := &ethpb.ProposerSlashing{
+		ProposerIndex: psr.ValidatorIndex,
+		Header_1:      psr2.BlockHeader,
+		Header_2:      psr.BlockHeader,
+	}
+
+	if _, err := slasherServer.IsSlashableBlock(ctx, psr); err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if _, err := slasherServer.IsSlashableBlock(ctx, psr2); err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+
+	res, err := slasherServer.ListSlashableProposals(ctx, &slashpb.SlashingsRequest{Epoch: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ProposerSlashing) != 1 {
+		t.Fatalf("Wanted 1 proposer slashing, received %d", len(res.ProposerSlashing))
+	}
+	if !proto.Equal(res.ProposerSlashing[0], want) {
+		t.Errorf("Wanted slashing proof: %v got: %v", want, res.ProposerSlashing[0])
+	}
+
+	res, err = slasherServer.ListSlashableProposals(ctx, &slashpb.SlashingsRequest{Epoch: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ProposerSlashing) != 0 {
+		t.Errorf("Wanted no proposer slashing for epoch 1, received %v", res.ProposerSlashing)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
@@ -42,7 +43,7 @@ func main() {
 	params.UseDemoBeaconConfig()
 
 	flag.Parse()
-	db, err := db.NewDB(*datadir)
+	db, err := db.NewDB(*datadir, uint64(flags.SlotsPerArchivedPoint.Value))
 	if err != nil {
 		panic(err)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 )
 
@@ -27,7 +28,7 @@ func init() {
 func main() {
 	flag.Parse()
 	fmt.Println("Starting process...")
-	d, err := db.NewDB(*datadir)
+	d, err := db.NewDB(*datadir, uint64(flags.SlotsPerArchivedPoint.Value))
 	if err != nil {
 		panic(err)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 )
 
 // A basic tool to extract genesis.ssz from existing beaconchain.db.
@@ -21,7 +22,7 @@ func main() {
 
 	fmt.Printf("Reading db at %s and writing ssz output to %s.\n", os.Args[1], os.Args[2])
 
-	d, err := db.NewDB(os.Args[1])
+	d, err := db.NewDB(os.Args[1], uint64(flags.SlotsPerArchivedPoint.Value))
 	if err != nil {
 		panic(err)
 	}
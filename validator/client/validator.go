@@ -276,7 +276,9 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 			}
 
 			if duty.Status == ethpb.ValidatorStatus_ACTIVE {
-				if duty.ProposerSlot > 0 {
+				if len(duty.ProposerSlots) > 0 {
+					lFields["proposerSlots"] = duty.ProposerSlots
+				} else if duty.ProposerSlot > 0 {
 					lFields["proposerSlot"] = duty.ProposerSlot
 				}
 				lFields["attesterSlot"] = duty.AttesterSlot
@@ -300,7 +302,7 @@ func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]pb
 		if duty == nil {
 			continue
 		}
-		if duty.ProposerSlot == slot {
+		if isProposerSlot(duty, slot) {
 			roles = append(roles, pb.ValidatorRole_PROPOSER)
 		}
 		if duty.AttesterSlot == slot {
@@ -326,6 +328,19 @@ func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]pb
 	return rolesAt, nil
 }
 
+// isProposerSlot returns true if the duty assigns the validator to propose a block at the given slot.
+func isProposerSlot(duty *ethpb.DutiesResponse_Duty, slot uint64) bool {
+	if len(duty.ProposerSlots) == 0 {
+		return duty.ProposerSlot == slot
+	}
+	for _, s := range duty.ProposerSlots {
+		if s == slot {
+			return true
+		}
+	}
+	return false
+}
+
 // isAggregator checks if a validator is an aggregator of a given slot, it uses the selection algorithm outlined in:
 // https://github.com/ethereum/eth2.0-specs/blob/v0.9.0/specs/validator/0_beacon-chain-validator.md#aggregation-selection
 func (v *validator) isAggregator(ctx context.Context, committee []uint64, slot uint64, pubKey [48]byte) (bool, error) {
@@ -630,3 +630,40 @@ func TestRolesAt_OK(t *testing.T) {
 		t.Errorf("Unexpected validator role. want: ValidatorRole_AGGREGATOR")
 	}
 }
+
+func TestRolesAt_MultipleProposerSlots(t *testing.T) {
+	v, _, finish := setup(t)
+	defer finish()
+
+	sks := make([]*bls.SecretKey, 2)
+	sks[0] = bls.RandKey()
+	sks[1] = bls.RandKey()
+	v.keyManager = keymanager.NewDirect(sks)
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex: 1,
+				ProposerSlot:   1,
+				ProposerSlots:  []uint64{1, 5},
+				PublicKey:      sks[0].PublicKey().Marshal(),
+			},
+			{
+				CommitteeIndex: 2,
+				ProposerSlot:   2,
+				ProposerSlots:  []uint64{2},
+				PublicKey:      sks[1].PublicKey().Marshal(),
+			},
+		},
+	}
+
+	roleMap, err := v.RolesAt(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roleMap[bytesutil.ToBytes48(sks[0].PublicKey().Marshal())][0] != pb.ValidatorRole_PROPOSER {
+		t.Errorf("Unexpected validator role. want: ValidatorRole_PROPOSER")
+	}
+	if roleMap[bytesutil.ToBytes48(sks[1].PublicKey().Marshal())][0] != pb.ValidatorRole_UNKNOWN {
+		t.Errorf("Unexpected validator role. want: UNKNOWN")
+	}
+}